@@ -33,6 +33,7 @@ func init() {
 	var err error
 	if debug, _ := util.IsDebugEnabled(); debug {
 		logger, err = zap.NewDevelopment()
+		cmdutil.EnableHTTPDebugLogging()
 	} else {
 		logger, err = zap.NewProduction()
 	}
@@ -67,7 +68,14 @@ func mainRun() exitCode {
 		return exitError
 	}
 
-	if cmd, err := rootCmd.ExecuteC(); err != nil {
+	cmd, err := rootCmd.ExecuteC()
+	if err == nil {
+		// apply --json-path to the output of the command
+		err = iostrms.StopOutputFilter()
+	} else {
+		iostrms.CancelOutputFilter()
+	}
+	if err != nil {
 		var pagerPipeError *iostreams.ErrClosedPagerPipe
 		var noResultsError cmdutil.NoResultsError
 		var extError *cmdutil.ExternalCommandExitError
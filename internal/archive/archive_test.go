@@ -0,0 +1,109 @@
+package archive
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractZip(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:  "files",
+			files: map[string]string{"a.txt": "a", "dir/b.txt": "b"},
+		},
+		{
+			name:    "path outside of directory",
+			files:   map[string]string{"../evil.txt": "x"},
+			wantErr: "invalid file path in archive: ../evil.txt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			zw := zip.NewWriter(&buf)
+			for name, content := range tt.files {
+				w, err := zw.Create(name)
+				require.NoError(t, err)
+				_, err = w.Write([]byte(content))
+				require.NoError(t, err)
+			}
+			require.NoError(t, zw.Close())
+
+			dir := t.TempDir()
+			err := ExtractZip(bytes.NewReader(buf.Bytes()), int64(buf.Len()), dir)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assertFiles(t, dir, tt.files)
+		})
+	}
+}
+
+func TestExtractTarGz(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		wantErr string
+	}{
+		{
+			name:  "files",
+			files: map[string]string{"package/package.json": "{}", "package/index.js": "module.exports = 1"},
+		},
+		{
+			name:    "path outside of directory",
+			files:   map[string]string{"../../evil.txt": "x"},
+			wantErr: "invalid file path in archive: ../../evil.txt",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			gw := gzip.NewWriter(&buf)
+			tw := tar.NewWriter(gw)
+			for name, content := range tt.files {
+				require.NoError(t, tw.WriteHeader(&tar.Header{
+					Name:     name,
+					Mode:     0o644,
+					Size:     int64(len(content)),
+					Typeflag: tar.TypeReg,
+				}))
+				_, err := tw.Write([]byte(content))
+				require.NoError(t, err)
+			}
+			require.NoError(t, tw.Close())
+			require.NoError(t, gw.Close())
+
+			dir := t.TempDir()
+			err := ExtractTarGz(&buf, dir)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assertFiles(t, dir, tt.files)
+		})
+	}
+}
+
+func assertFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		b, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		require.NoError(t, err)
+		assert.Equal(t, content, string(b))
+	}
+}
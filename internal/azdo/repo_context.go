@@ -0,0 +1,52 @@
+package azdo
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/tmeckel/azdo-cli/internal/git"
+)
+
+// ErrNoAzureDevOpsRemote is returned when none of the remotes of the git repository in the current
+// directory references an Azure DevOps repository.
+var ErrNoAzureDevOpsRemote = errors.New("no git remote references an Azure DevOps repository")
+
+// RepoContext determines the Azure DevOps repository of the git repository in the current directory.
+type RepoContext interface {
+	Repo() (Repository, error)
+}
+
+type repoContext struct {
+	ctx    context.Context
+	client *git.Client
+}
+
+// NewRepoContext returns a RepoContext which inspects the git remotes using client.
+func NewRepoContext(ctx context.Context, client *git.Client) RepoContext {
+	return &repoContext{
+		ctx:    ctx,
+		client: client,
+	}
+}
+
+// Repo returns the Azure DevOps repository of the first remote referencing one. Remotes are checked
+// in the order "upstream", "origin" and then all others.
+func (r *repoContext) Repo() (Repository, error) {
+	remotes, err := r.client.Remotes(r.ctx)
+	if err != nil {
+		return Repository{}, err
+	}
+	return repositoryFromRemotes(remotes)
+}
+
+func repositoryFromRemotes(remotes git.RemoteSet) (Repository, error) {
+	for _, remote := range remotes {
+		for _, u := range []*url.URL{remote.FetchURL, remote.PushURL} {
+			if repo, err := RepositoryFromURL(u); err == nil {
+				return repo, nil
+			}
+		}
+	}
+	return Repository{}, ErrNoAzureDevOpsRemote
+}
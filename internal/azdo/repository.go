@@ -0,0 +1,91 @@
+package azdo
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrNotAzureDevOpsURL is returned when a URL doesn't reference an Azure DevOps Git repository.
+var ErrNotAzureDevOpsURL = errors.New("not an Azure DevOps repository URL")
+
+// Repository identifies an Azure DevOps Git repository.
+type Repository struct {
+	organization string
+	project      string
+	name         string
+}
+
+// NewRepository returns a repository identified by organization, project and name.
+func NewRepository(organization, project, name string) Repository {
+	return Repository{
+		organization: strings.ToLower(organization),
+		project:      project,
+		name:         name,
+	}
+}
+
+// Organization returns the name of the organization of the repository in lower case.
+func (r Repository) Organization() string {
+	return r.organization
+}
+
+// Project returns the name of the project of the repository.
+func (r Repository) Project() string {
+	return r.project
+}
+
+// Name returns the name of the repository.
+func (r Repository) Name() string {
+	return r.name
+}
+
+func (r Repository) String() string {
+	return fmt.Sprintf("%s/%s/%s", r.organization, r.project, r.name)
+}
+
+// RepositoryFromURL extracts the repository from a git remote URL. The following formats are supported:
+//   - https://[user@]dev.azure.com/{organization}/{project}/_git/{repository}
+//   - https://{organization}.visualstudio.com/[DefaultCollection/]{project}/_git/{repository}
+//   - ssh://git@ssh.dev.azure.com/v3/{organization}/{project}/{repository}
+//   - ssh://{organization}@vs-ssh.visualstudio.com/v3/{organization}/{project}/{repository}
+//
+// URLs in scp-like syntax must be normalized with git.ParseURL first.
+func RepositoryFromURL(u *url.URL) (Repository, error) {
+	if u == nil {
+		return Repository{}, ErrNotAzureDevOpsURL
+	}
+	host := strings.ToLower(u.Hostname())
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	switch {
+	case host == "dev.azure.com":
+		// {organization}/{project}/_git/{repository}
+		if len(parts) == 4 && parts[2] == "_git" {
+			return newRepositoryFromParts(parts[0], parts[1], parts[3])
+		}
+	case host == "ssh.dev.azure.com" || host == "vs-ssh.visualstudio.com":
+		// v3/{organization}/{project}/{repository}
+		if len(parts) == 4 && parts[0] == "v3" {
+			return newRepositoryFromParts(parts[1], parts[2], parts[3])
+		}
+	case strings.HasSuffix(host, ".visualstudio.com"):
+		organization := strings.TrimSuffix(host, ".visualstudio.com")
+		if len(parts) == 4 && strings.EqualFold(parts[0], "DefaultCollection") {
+			parts = parts[1:]
+		}
+		// {project}/_git/{repository}
+		if len(parts) == 3 && parts[1] == "_git" {
+			return newRepositoryFromParts(organization, parts[0], parts[2])
+		}
+	}
+	return Repository{}, ErrNotAzureDevOpsURL
+}
+
+func newRepositoryFromParts(organization, project, name string) (Repository, error) {
+	if organization == "" || project == "" || name == "" {
+		return Repository{}, ErrNotAzureDevOpsURL
+	}
+	return NewRepository(organization, project, strings.TrimSuffix(name, ".git")), nil
+}
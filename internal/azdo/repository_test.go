@@ -0,0 +1,97 @@
+package azdo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmeckel/azdo-cli/internal/git"
+)
+
+func TestRepositoryFromURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		want    Repository
+		wantErr bool
+	}{
+		{
+			name: "https",
+			url:  "https://dev.azure.com/MyOrg/MyProject/_git/my-repo",
+			want: NewRepository("myorg", "MyProject", "my-repo"),
+		},
+		{
+			name: "https with user",
+			url:  "https://MyOrg@dev.azure.com/MyOrg/MyProject/_git/my-repo",
+			want: NewRepository("myorg", "MyProject", "my-repo"),
+		},
+		{
+			name: "https with escaped project name",
+			url:  "https://dev.azure.com/myorg/My%20Project/_git/my-repo",
+			want: NewRepository("myorg", "My Project", "my-repo"),
+		},
+		{
+			name: "visualstudio.com",
+			url:  "https://myorg.visualstudio.com/MyProject/_git/my-repo",
+			want: NewRepository("myorg", "MyProject", "my-repo"),
+		},
+		{
+			name: "visualstudio.com with default collection",
+			url:  "https://myorg.visualstudio.com/DefaultCollection/MyProject/_git/my-repo",
+			want: NewRepository("myorg", "MyProject", "my-repo"),
+		},
+		{
+			name: "ssh",
+			url:  "ssh://git@ssh.dev.azure.com/v3/myorg/MyProject/my-repo",
+			want: NewRepository("myorg", "MyProject", "my-repo"),
+		},
+		{
+			name: "scp-like ssh",
+			url:  "git@ssh.dev.azure.com:v3/myorg/MyProject/my-repo",
+			want: NewRepository("myorg", "MyProject", "my-repo"),
+		},
+		{
+			name: "scp-like visualstudio.com ssh",
+			url:  "myorg@vs-ssh.visualstudio.com:v3/myorg/MyProject/my-repo",
+			want: NewRepository("myorg", "MyProject", "my-repo"),
+		},
+		{
+			name:    "GitHub",
+			url:     "https://github.com/tmeckel/azdo-cli.git",
+			wantErr: true,
+		},
+		{
+			name:    "missing repository",
+			url:     "https://dev.azure.com/myorg/MyProject",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := git.ParseURL(tt.url)
+			require.NoError(t, err)
+
+			got, err := RepositoryFromURL(u)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrNotAzureDevOpsURL)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRepositoryFromRemotes(t *testing.T) {
+	remotes := git.RemoteSet{
+		git.NewRemote("github", "https://github.com/tmeckel/azdo-cli.git"),
+		git.NewRemote("origin", "https://dev.azure.com/myorg/MyProject/_git/my-repo"),
+	}
+	repo, err := repositoryFromRemotes(remotes)
+	require.NoError(t, err)
+	assert.Equal(t, "myorg", repo.Organization())
+
+	_, err = repositoryFromRemotes(remotes[:1])
+	assert.ErrorIs(t, err, ErrNoAzureDevOpsRemote)
+}
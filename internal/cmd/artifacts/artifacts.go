@@ -0,0 +1,27 @@
+package artifacts
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/artifacts/download"
+	"github.com/tmeckel/azdo-cli/internal/cmd/artifacts/feed"
+	packagecmd "github.com/tmeckel/azdo-cli/internal/cmd/artifacts/package"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdArtifacts(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "artifacts <command>",
+		Short: "Work with Azure Artifacts",
+		Long:  `Work with Azure Artifacts feeds and packages.`,
+		Example: heredoc.Doc(`
+			$ azdo artifacts feed list myorg --project myproject
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(download.NewCmdDownload(ctx))
+	cmd.AddCommand(feed.NewCmdFeed(ctx))
+	cmd.AddCommand(packagecmd.NewCmdPackage(ctx))
+	return cmd
+}
@@ -0,0 +1,287 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/npm"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/nuget"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/archive"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// Types of packages which can be downloaded
+const (
+	packageTypeNpm   = "npm"
+	packageTypeNuGet = "nuget"
+)
+
+type downloadOptions struct {
+	organizationName string
+	feed             string
+	project          string
+	name             string
+	version          string
+	packageType      string
+	outputDir        string
+	output           string
+	extract          bool
+}
+
+func NewCmdDownload(ctx util.CmdContext) *cobra.Command {
+	opts := &downloadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "download [organization]",
+		Short: "Download a package from an artifact feed",
+		Long: heredoc.Doc(`
+			Download a version of a package from an artifact feed.
+
+			npm and NuGet packages are supported. If --package-type is not given, the type is looked
+			up in the feed. npm packages are downloaded as tarball, NuGet packages as nupkg file.
+			With --extract the package is extracted into the output directory.
+		`),
+		Example: heredoc.Doc(`
+			# download version 1.2.0 of the NuGet package My.Client
+			azdo artifacts download --feed shared --name My.Client --version 1.2.0
+
+			# download and extract a scoped npm package of a project feed
+			azdo artifacts download myorg --feed packages --project myproject --name @myorg/client --version 2.0.1 --package-type npm --extract --output-dir client
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.organizationName = args[0]
+			}
+			return runDownload(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.feed, "feed", "", "Name or ID of the feed")
+	cmd.Flags().StringVarP(&opts.project, "project", "p", "", "Project of the feed, if it is not a feed of the organization")
+	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "Name of the package")
+	cmd.Flags().StringVar(&opts.version, "version", "", "Version of the package")
+	util.StringEnumFlag(cmd, &opts.packageType, "package-type", "", "", []string{packageTypeNpm, packageTypeNuGet}, "Type of the package")
+	cmd.Flags().StringVarP(&opts.outputDir, "output-dir", "D", ".", "Directory to write the package to")
+	cmd.Flags().StringVarP(&opts.output, "output", "O", "", "Name of the downloaded file")
+	cmd.Flags().BoolVarP(&opts.extract, "extract", "x", false, "Extract the package into the output directory")
+	cmd.MarkFlagsMutuallyExclusive("output", "extract")
+	_ = cmd.MarkFlagRequired("feed")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("version")
+
+	return cmd
+}
+
+func runDownload(ctx util.CmdContext, opts *downloadOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	packageType := opts.packageType
+	if packageType == "" {
+		packageType, err = lookupPackageType(rctx, conn, opts)
+		if err != nil {
+			return
+		}
+	}
+
+	var content io.ReadCloser
+	switch packageType {
+	case packageTypeNpm:
+		content, err = openNpmPackage(rctx, conn, opts)
+	default:
+		content, err = openNuGetPackage(rctx, conn, opts)
+	}
+	if err != nil {
+		return
+	}
+	defer content.Close()
+
+	if err := os.MkdirAll(opts.outputDir, 0o755); err != nil {
+		return err
+	}
+
+	var f *os.File
+	if opts.extract {
+		f, err = os.CreateTemp("", "azdo-package-*")
+		if err != nil {
+			return
+		}
+		defer os.Remove(f.Name())
+	} else {
+		name := opts.output
+		if name == "" {
+			name = fileName(packageType, opts.name, opts.version)
+		}
+		f, err = os.Create(filepath.Join(opts.outputDir, name))
+		if err != nil {
+			return
+		}
+	}
+	defer f.Close()
+
+	label := fmt.Sprintf("%s@%s", opts.name, opts.version)
+	progress := util.NewProgressWriter(iostrms, "Downloading "+label)
+	size, err := progress.Copy(f, content)
+	if err != nil {
+		return fmt.Errorf("failed to download package %s: %w", label, err)
+	}
+
+	path := f.Name()
+	if opts.extract {
+		iostrms.StartProgressIndicatorWithLabel("Extracting " + label)
+		if err := extract(f, size, packageType, opts.outputDir); err != nil {
+			return fmt.Errorf("failed to extract package %s: %w", label, err)
+		}
+		path = opts.outputDir
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.ErrOut, "%s Downloaded package %s (%s)\n", cs.SuccessIcon(), cs.Bold(label), text.FormatBytes(size))
+	}
+	fmt.Fprintln(iostrms.Out, path)
+	return
+}
+
+// lookupPackageType returns the type of the package in the feed.
+func lookupPackageType(ctx context.Context, conn *azuredevops.Connection, opts *downloadOptions) (string, error) {
+	client, err := feed.NewClient(ctx, conn)
+	if err != nil {
+		return "", err
+	}
+	args := feed.GetPackagesArgs{
+		FeedId:           &opts.feed,
+		PackageNameQuery: &opts.name,
+		IncludeUrls:      lo.ToPtr(false),
+	}
+	if opts.project != "" {
+		args.Project = &opts.project
+	}
+	res, err := client.GetPackages(ctx, args)
+	if err != nil {
+		return "", fmt.Errorf("failed to get packages of feed %s: %w", opts.feed, err)
+	}
+	pkg, ok := lo.Find(lo.FromPtr(res), func(p feed.Package) bool { return strings.EqualFold(lo.FromPtr(p.Name), opts.name) })
+	if !ok {
+		return "", fmt.Errorf("package %s not found in feed %s", opts.name, opts.feed)
+	}
+	protocol := strings.ToLower(lo.FromPtr(pkg.ProtocolType))
+	if protocol != packageTypeNpm && protocol != packageTypeNuGet {
+		return "", fmt.Errorf("downloading packages of type %s is not supported", lo.FromPtr(pkg.ProtocolType))
+	}
+	return protocol, nil
+}
+
+func openNpmPackage(ctx context.Context, conn *azuredevops.Connection, opts *downloadOptions) (io.ReadCloser, error) {
+	client, err := npm.NewClient(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	var project *string
+	if opts.project != "" {
+		project = &opts.project
+	}
+	scope, name := splitNpmName(opts.name)
+	var r io.ReadCloser
+	if scope != "" {
+		r, err = client.GetContentScopedPackage(ctx, npm.GetContentScopedPackageArgs{
+			FeedId:              &opts.feed,
+			PackageScope:        &scope,
+			UnscopedPackageName: &name,
+			PackageVersion:      &opts.version,
+			Project:             project,
+		})
+	} else {
+		r, err = client.GetContentUnscopedPackage(ctx, npm.GetContentUnscopedPackageArgs{
+			FeedId:         &opts.feed,
+			PackageName:    &name,
+			PackageVersion: &opts.version,
+			Project:        project,
+		})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get package %s@%s: %w", opts.name, opts.version, err)
+	}
+	return r, nil
+}
+
+func openNuGetPackage(ctx context.Context, conn *azuredevops.Connection, opts *downloadOptions) (io.ReadCloser, error) {
+	client, err := nuget.NewClient(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	args := nuget.DownloadPackageArgs{
+		FeedId:         &opts.feed,
+		PackageName:    &opts.name,
+		PackageVersion: &opts.version,
+	}
+	if opts.project != "" {
+		args.Project = &opts.project
+	}
+	r, err := client.DownloadPackage(ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get package %s@%s: %w", opts.name, opts.version, err)
+	}
+	return r, nil
+}
+
+// splitNpmName splits the name of a scoped npm package like @scope/name into scope and name.
+func splitNpmName(fullName string) (scope, name string) {
+	if s, n, ok := strings.Cut(strings.TrimPrefix(fullName, "@"), "/"); ok && strings.HasPrefix(fullName, "@") {
+		return s, n
+	}
+	return "", fullName
+}
+
+// fileName returns the name of the file a package is written to, following the naming of the
+// package managers.
+func fileName(packageType, name, version string) string {
+	if packageType == packageTypeNpm {
+		scope, name := splitNpmName(name)
+		if scope != "" {
+			name = scope + "-" + name
+		}
+		return fmt.Sprintf("%s-%s.tgz", name, version)
+	}
+	return fmt.Sprintf("%s.%s.nupkg", strings.ToLower(name), strings.ToLower(version))
+}
+
+// extract extracts the downloaded package into dir. npm packages are tarballs, NuGet packages zip archives.
+func extract(f *os.File, size int64, packageType, dir string) error {
+	if packageType == packageTypeNpm {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		return archive.ExtractTarGz(f, dir)
+	}
+	return archive.ExtractZip(f, size, dir)
+}
@@ -0,0 +1,32 @@
+package download
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitNpmName(t *testing.T) {
+	tests := []struct {
+		fullName  string
+		wantScope string
+		wantName  string
+	}{
+		{fullName: "@myorg/client", wantScope: "myorg", wantName: "client"},
+		{fullName: "client", wantName: "client"},
+		{fullName: "client/sub", wantName: "client/sub"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fullName, func(t *testing.T) {
+			scope, name := splitNpmName(tt.fullName)
+			assert.Equal(t, tt.wantScope, scope)
+			assert.Equal(t, tt.wantName, name)
+		})
+	}
+}
+
+func TestFileName(t *testing.T) {
+	assert.Equal(t, "client-2.0.1.tgz", fileName(packageTypeNpm, "client", "2.0.1"))
+	assert.Equal(t, "myorg-client-2.0.1.tgz", fileName(packageTypeNpm, "@myorg/client", "2.0.1"))
+	assert.Equal(t, "my.client.1.2.0-beta.nupkg", fileName(packageTypeNuGet, "My.Client", "1.2.0-Beta"))
+}
@@ -0,0 +1,17 @@
+package feed
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/artifacts/feed/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdFeed(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feed <command>",
+		Short: "Manage artifact feeds",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	return cmd
+}
@@ -0,0 +1,177 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// Visibilities of a feed
+const (
+	visibilityOrganization = "organization"
+	visibilityPrivate      = "private"
+	visibilityPublic       = "public"
+)
+
+type listOptions struct {
+	organizationName string
+	project          string
+	publicOnly       bool
+	privateOnly      bool
+	format           string
+}
+
+type feedResult struct {
+	ID              string                `json:"id"`
+	Name            string                `json:"name"`
+	Project         string                `json:"project,omitempty"`
+	Visibility      string                `json:"visibility"`
+	URL             string                `json:"url"`
+	Capabilities    string                `json:"capabilities,omitempty"`
+	UpstreamSources []feed.UpstreamSource `json:"upstreamSources"`
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization]",
+		Short: "List artifact feeds",
+		Long: heredoc.Doc(`
+			List the artifact feeds of an organization or, with --project, of a project.
+
+			Feeds of the organization are always private. Feeds of a project have the visibility of
+			the project.
+		`),
+		Example: heredoc.Doc(`
+			# list the feeds of the default organization
+			azdo artifacts feed list
+
+			# list the public feeds of a project
+			azdo artifacts feed list myorg --project myproject --public-only
+		`),
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.organizationName = args[0]
+			}
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.project, "project", "p", "", "List the feeds of this project instead of the organization")
+	cmd.Flags().BoolVar(&opts.publicOnly, "public-only", false, "Only list public feeds")
+	cmd.Flags().BoolVar(&opts.privateOnly, "private-only", false, "Only list private feeds")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+	cmd.MarkFlagsMutuallyExclusive("public-only", "private-only")
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := feed.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	args := feed.GetFeedsArgs{}
+	if opts.project != "" {
+		args.Project = &opts.project
+	}
+	res, err := client.GetFeeds(rctx, args)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get feeds: %w", err)
+	}
+
+	feeds := lo.FilterMap(lo.FromPtr(res), func(f feed.Feed, _ int) (feedResult, bool) {
+		r := newFeedResult(conn.BaseUrl, &f)
+		switch {
+		case opts.publicOnly:
+			return r, r.Visibility == visibilityPublic
+		case opts.privateOnly:
+			return r, r.Visibility != visibilityPublic
+		}
+		return r, true
+	})
+	if len(feeds) == 0 {
+		scope := "organization " + organizationName
+		if opts.project != "" {
+			scope = "project " + opts.project
+		}
+		return util.NewNoResultsError(fmt.Sprintf("No feeds found in %s", scope))
+	}
+	sort.Slice(feeds, func(i, j int) bool { return strings.ToLower(feeds[i].Name) < strings.ToLower(feeds[j].Name) })
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(feeds)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Name", "Project", "Visibility", "URL")
+	for _, f := range feeds {
+		tp.AddField(f.ID, printer.WithTruncate(nil))
+		tp.AddField(f.Name)
+		tp.AddField(f.Project)
+		tp.AddField(f.Visibility)
+		tp.AddField(f.URL, printer.WithTruncate(nil))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// newFeedResult returns the feed with its visibility and the URL of the feed in the web UI of the
+// organization at baseURL.
+func newFeedResult(baseURL string, f *feed.Feed) feedResult {
+	r := feedResult{
+		Name:            lo.FromPtr(f.Name),
+		Visibility:      visibilityOrganization,
+		Capabilities:    string(lo.FromPtr(f.Capabilities)),
+		UpstreamSources: lo.FromPtr(f.UpstreamSources),
+	}
+	if f.Id != nil {
+		r.ID = f.Id.String()
+	}
+	webURL := strings.TrimSuffix(baseURL, "/")
+	if f.Project != nil {
+		r.Project = lo.FromPtr(f.Project.Name)
+		r.Visibility = visibilityPrivate
+		if strings.EqualFold(lo.FromPtr(f.Project.Visibility), visibilityPublic) {
+			r.Visibility = visibilityPublic
+		}
+		webURL += "/" + url.PathEscape(r.Project)
+	}
+	r.URL = fmt.Sprintf("%s/_artifacts/feed/%s", webURL, url.PathEscape(r.Name))
+	return r
+}
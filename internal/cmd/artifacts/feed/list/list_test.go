@@ -0,0 +1,59 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFeedResult(t *testing.T) {
+	id := uuid.MustParse("4c7a8e4b-1b3f-4a5e-9b0e-2f6d1c3a9e11")
+	tests := []struct {
+		name           string
+		feed           feed.Feed
+		wantProject    string
+		wantVisibility string
+		wantURL        string
+	}{
+		{
+			name:           "organization feed",
+			feed:           feed.Feed{Id: &id, Name: lo.ToPtr("shared")},
+			wantVisibility: "organization",
+			wantURL:        "https://dev.azure.com/myorg/_artifacts/feed/shared",
+		},
+		{
+			name: "private project feed",
+			feed: feed.Feed{
+				Id:      &id,
+				Name:    lo.ToPtr("packages"),
+				Project: &feed.ProjectReference{Name: lo.ToPtr("my project"), Visibility: lo.ToPtr("private")},
+			},
+			wantProject:    "my project",
+			wantVisibility: "private",
+			wantURL:        "https://dev.azure.com/myorg/my%20project/_artifacts/feed/packages",
+		},
+		{
+			name: "public project feed",
+			feed: feed.Feed{
+				Id:      &id,
+				Name:    lo.ToPtr("oss"),
+				Project: &feed.ProjectReference{Name: lo.ToPtr("oss"), Visibility: lo.ToPtr("Public")},
+			},
+			wantProject:    "oss",
+			wantVisibility: "public",
+			wantURL:        "https://dev.azure.com/myorg/oss/_artifacts/feed/oss",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newFeedResult("https://dev.azure.com/myorg/", &tt.feed)
+			assert.Equal(t, id.String(), r.ID)
+			assert.Equal(t, tt.wantProject, r.Project)
+			assert.Equal(t, tt.wantVisibility, r.Visibility)
+			assert.Equal(t, tt.wantURL, r.URL)
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// protocolTypes maps the values of --package-type to the protocol types of the Artifacts API.
+var protocolTypes = map[string]string{
+	"cargo":     "Cargo",
+	"maven":     "Maven",
+	"npm":       "Npm",
+	"nuget":     "NuGet",
+	"pypi":      "PyPi",
+	"universal": "UPack",
+}
+
+// packageTypes returns the values of --package-type in alphabetical order.
+func packageTypes() []string {
+	types := lo.Keys(protocolTypes)
+	sort.Strings(types)
+	return types
+}
+
+type listOptions struct {
+	organizationName string
+	feed             string
+	project          string
+	packageType      string
+	nameFilter       string
+	limit            int
+	format           string
+}
+
+type packageResult struct {
+	Name           string     `json:"name"`
+	LatestVersion  string     `json:"latestVersion"`
+	NormalizedName string     `json:"normalizedName"`
+	ProtocolType   string     `json:"protocolType"`
+	PublishedDate  *time.Time `json:"publishedDate,omitempty"`
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization]",
+		Short: "List the packages of an artifact feed",
+		Long: heredoc.Doc(`
+			List the packages of an artifact feed together with their latest version.
+
+			The feed is specified by its name or ID. Feeds of a project additionally require --project.
+		`),
+		Example: heredoc.Doc(`
+			# list the packages of the feed shared
+			azdo artifacts package list --feed shared
+
+			# list the npm packages containing "client" of a project feed
+			azdo artifacts package list myorg --feed packages --project myproject --package-type npm --name-filter client
+		`),
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.organizationName = args[0]
+			}
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.feed, "feed", "", "Name or ID of the feed")
+	cmd.Flags().StringVarP(&opts.project, "project", "p", "", "Project of the feed, if it is not a feed of the organization")
+	util.StringEnumFlag(cmd, &opts.packageType, "package-type", "", "", packageTypes(), "Only list packages of this type")
+	cmd.Flags().StringVar(&opts.nameFilter, "name-filter", "", "Only list packages whose name contains this text")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 50, "Maximum number of packages to list")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+	_ = cmd.MarkFlagRequired("feed")
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	if opts.limit < 1 {
+		return util.FlagErrorf("invalid value for --limit: %d", opts.limit)
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := feed.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	args := feed.GetPackagesArgs{
+		FeedId:      &opts.feed,
+		IncludeUrls: lo.ToPtr(false),
+		Top:         &opts.limit,
+	}
+	if opts.project != "" {
+		args.Project = &opts.project
+	}
+	if opts.packageType != "" {
+		args.ProtocolType = lo.ToPtr(protocolTypes[opts.packageType])
+	}
+	if opts.nameFilter != "" {
+		args.PackageNameQuery = &opts.nameFilter
+	}
+
+	iostrms.StartProgressIndicator()
+	res, err := client.GetPackages(rctx, args)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get packages of feed %s: %w", opts.feed, err)
+	}
+	if len(lo.FromPtr(res)) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No packages found in feed %s", opts.feed))
+	}
+
+	packages := lo.Map(*res, func(p feed.Package, _ int) packageResult {
+		return newPackageResult(&p)
+	})
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(packages)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	tp.AddColumns("Name", "Latest Version", "Published", "Protocol")
+	for _, p := range packages {
+		tp.AddField(p.Name)
+		tp.AddField(p.LatestVersion)
+		switch {
+		case p.PublishedDate == nil:
+			tp.AddField("")
+		case iostrms.IsStdoutTTY():
+			tp.AddField(text.FuzzyAgo(now, *p.PublishedDate))
+		default:
+			tp.AddField(p.PublishedDate.Format(time.RFC3339))
+		}
+		tp.AddField(p.ProtocolType)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// newPackageResult returns the package with its latest version. If no version is flagged as the
+// latest one, the first version returned by the API is used.
+func newPackageResult(p *feed.Package) packageResult {
+	r := packageResult{
+		Name:           lo.FromPtr(p.Name),
+		NormalizedName: lo.FromPtr(p.NormalizedName),
+		ProtocolType:   lo.FromPtr(p.ProtocolType),
+	}
+	versions := lo.FromPtr(p.Versions)
+	if len(versions) == 0 {
+		return r
+	}
+	latest, ok := lo.Find(versions, func(v feed.MinimalPackageVersion) bool { return lo.FromPtr(v.IsLatest) })
+	if !ok {
+		latest = versions[0]
+	}
+	r.LatestVersion = lo.FromPtr(latest.Version)
+	if latest.PublishDate != nil {
+		r.PublishedDate = &latest.PublishDate.Time
+	}
+	return r
+}
@@ -0,0 +1,58 @@
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/feed"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPackageResult(t *testing.T) {
+	published := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		pkg  feed.Package
+		want packageResult
+	}{
+		{
+			name: "latest version",
+			pkg: feed.Package{
+				Name:           lo.ToPtr("My.Client"),
+				NormalizedName: lo.ToPtr("my.client"),
+				ProtocolType:   lo.ToPtr("NuGet"),
+				Versions: &[]feed.MinimalPackageVersion{
+					{Version: lo.ToPtr("1.0.0")},
+					{Version: lo.ToPtr("1.1.0"), IsLatest: lo.ToPtr(true), PublishDate: &azuredevops.Time{Time: published}},
+				},
+			},
+			want: packageResult{
+				Name:           "My.Client",
+				LatestVersion:  "1.1.0",
+				NormalizedName: "my.client",
+				ProtocolType:   "NuGet",
+				PublishedDate:  &published,
+			},
+		},
+		{
+			name: "no latest flag",
+			pkg: feed.Package{
+				Name:     lo.ToPtr("client"),
+				Versions: &[]feed.MinimalPackageVersion{{Version: lo.ToPtr("2.0.0")}},
+			},
+			want: packageResult{Name: "client", LatestVersion: "2.0.0"},
+		},
+		{
+			name: "no versions",
+			pkg:  feed.Package{Name: lo.ToPtr("client")},
+			want: packageResult{Name: "client"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newPackageResult(&tt.pkg))
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package packagecmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/artifacts/package/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdPackage(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package <command>",
+		Short: "Manage the packages of artifact feeds",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	return cmd
+}
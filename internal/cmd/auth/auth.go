@@ -5,6 +5,7 @@ import (
 	"github.com/tmeckel/azdo-cli/internal/cmd/auth/gitcredential"
 	"github.com/tmeckel/azdo-cli/internal/cmd/auth/login"
 	"github.com/tmeckel/azdo-cli/internal/cmd/auth/logout"
+	"github.com/tmeckel/azdo-cli/internal/cmd/auth/rotate"
 	"github.com/tmeckel/azdo-cli/internal/cmd/auth/setupgit"
 	"github.com/tmeckel/azdo-cli/internal/cmd/auth/status"
 	"github.com/tmeckel/azdo-cli/internal/cmd/util"
@@ -22,6 +23,7 @@ func NewCmdAuth(ctx util.CmdContext) *cobra.Command {
 	cmd.AddCommand(gitcredential.NewCmdGitCredential(ctx))
 	cmd.AddCommand(login.NewCmdLogin(ctx))
 	cmd.AddCommand(logout.NewCmdLogout(ctx))
+	cmd.AddCommand(rotate.NewCmdRotateToken(ctx))
 	cmd.AddCommand(status.NewCmdStatus(ctx))
 	cmd.AddCommand(setupgit.NewCmdSetupGit(ctx))
 
@@ -3,12 +3,17 @@ package login
 import (
 	"io"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
 	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/config"
 )
 
+// tokenExpiresLayout is the layout of --token-expires
+const tokenExpiresLayout = "2006-01-02"
+
 type loginOptions struct {
 	MainExecutable  string
 	Interactive     bool
@@ -16,10 +21,13 @@ type loginOptions struct {
 	Token           string
 	GitProtocol     string
 	InsecureStorage bool
+	TokenName       string
+	TokenExpires    *time.Time
 }
 
 func NewCmdLogin(ctx util.CmdContext) *cobra.Command {
 	var tokenStdin bool
+	var tokenExpires string
 
 	opts := &loginOptions{}
 
@@ -44,6 +52,9 @@ func NewCmdLogin(ctx util.CmdContext) *cobra.Command {
 			%[1]sazdo help environment%[1]s for more info.
 
 			To use azdo in Azure DevOps Pipeline Tasks (or other automation environments), add %[1]sAZDO_TOKEN: ${{ azdo.token }}%[1]s to "env".
+
+			When managing multiple tokens, use %[1]s--token-name%[1]s and %[1]s--token-expires%[1]s to record the name and
+			the expiry date the token was created with. Both are shown by %[1]sazdo auth status%[1]s.
 		`, "`"),
 		Example: heredoc.Doc(`
 		# start interactive setup
@@ -54,6 +65,9 @@ func NewCmdLogin(ctx util.CmdContext) *cobra.Command {
 
 		# authenticate with a specific Azure DevOps Organization
 		$ azdo auth login --organizationUrl https://dev.azure.com/myorg
+
+		# record the name and expiry date of the token
+		$ azdo auth login --with-token --token-name ci-readonly --token-expires 2025-06-30 < mytoken.txt
 	`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			iostreams, err := ctx.IOStreams()
@@ -61,6 +75,14 @@ func NewCmdLogin(ctx util.CmdContext) *cobra.Command {
 				return util.FlagErrorf("error getting io streams: %w", err)
 			}
 
+			if tokenExpires != "" {
+				t, err := time.ParseInLocation(tokenExpiresLayout, tokenExpires, time.Local)
+				if err != nil {
+					return util.FlagErrorf("invalid value for --token-expires: %q is not a date, expected e.g. \"2006-01-02\"", tokenExpires)
+				}
+				opts.TokenExpires = &t
+			}
+
 			if tokenStdin {
 				defer iostreams.In.Close()
 				token, err := io.ReadAll(iostreams.In)
@@ -82,6 +104,8 @@ func NewCmdLogin(ctx util.CmdContext) *cobra.Command {
 	cmd.Flags().BoolVar(&tokenStdin, "with-token", false, "Read token from standard input")
 	util.StringEnumFlag(cmd, &opts.GitProtocol, "git-protocol", "p", "", []string{"ssh", "https"}, "The protocol to use for git operations")
 	cmd.Flags().BoolVar(&opts.InsecureStorage, "insecure-storage", false, "Save authentication credentials in plain text instead of credential store")
+	cmd.Flags().StringVar(&opts.TokenName, "token-name", "", "Name of the token, shown by \"auth status\"")
+	cmd.Flags().StringVar(&tokenExpires, "token-expires", "", "Expiry date of the token in the form YYYY-MM-DD, shown by \"auth status\"")
 
 	return cmd
 }
@@ -132,6 +156,10 @@ func loginRun(ctx util.CmdContext, opts *loginOptions) (err error) {
 	}
 
 	authCfg := cfg.Authentication()
+	authCfg.SetTokenInfo(organizationName, config.TokenInfo{
+		Name:    opts.TokenName,
+		Expires: opts.TokenExpires,
+	})
 	if err = authCfg.Login(organizationName, organizationURL, authToken, gitProtocol, !opts.InsecureStorage); err != nil {
 		return
 	}
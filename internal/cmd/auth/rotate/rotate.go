@@ -0,0 +1,223 @@
+package rotate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/config"
+)
+
+// expiryLayout is the layout of --new-expiry
+const expiryLayout = "2006-01-02"
+
+type rotateOptions struct {
+	organizationName string
+	newExpiry        string
+	newExpiryDays    int
+	tokenStdin       bool
+	insecureStorage  bool
+	yes              bool
+}
+
+func NewCmdRotateToken(ctx util.CmdContext) *cobra.Command {
+	opts := &rotateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rotate-token [organization]",
+		Short: "Replace the personal access token of an organization",
+		Long: heredoc.Docf(`
+			Replace the personal access token (PAT) of an organization with a new one.
+
+			Azure DevOps does not allow to renew a PAT with the PAT itself, so the new token has to be
+			regenerated or created in the web UI. The command shows where to do that, reads the new
+			token from a prompt or with %[1]s--with-token%[1]s from standard input, verifies that it
+			works and stores it in place of the old token.
+
+			The name of the token recorded at login is kept. Pass the expiry date of the new token
+			with %[1]s--new-expiry%[1]s or %[1]s--new-expiry-days%[1]s to have it shown by %[1]sazdo auth status%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# rotate the token of the default organization interactively
+			$ azdo auth rotate-token
+
+			# rotate the token of an organization in a script
+			$ azdo auth rotate-token myorg --with-token --new-expiry-days 90 --yes < newtoken.txt
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.organizationName = args[0]
+			}
+			if opts.newExpiryDays < 0 {
+				return util.FlagErrorf("invalid value for --new-expiry-days: %d", opts.newExpiryDays)
+			}
+			return runRotate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.newExpiry, "new-expiry", "", "Expiry date of the new token in the form YYYY-MM-DD")
+	cmd.Flags().IntVar(&opts.newExpiryDays, "new-expiry-days", 0, "Number of days the new token is valid")
+	cmd.Flags().BoolVar(&opts.tokenStdin, "with-token", false, "Read the new token from standard input")
+	cmd.Flags().BoolVar(&opts.insecureStorage, "insecure-storage", false, "Save the new token in plain text instead of credential store")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	cmd.MarkFlagsMutuallyExclusive("new-expiry", "new-expiry-days")
+
+	return cmd
+}
+
+func runRotate(ctx util.CmdContext, opts *rotateOptions) (err error) {
+	expires, err := newExpiry(opts.newExpiry, opts.newExpiryDays, time.Now())
+	if err != nil {
+		return
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	if !opts.tokenStdin && !iostrms.CanPrompt() {
+		return util.FlagErrorf("--with-token required when not running interactively")
+	}
+	if !opts.yes && !iostrms.CanPrompt() {
+		return util.FlagErrorf("--yes required when not running interactively")
+	}
+
+	cfg, err := ctx.Config()
+	if err != nil {
+		return
+	}
+	authCfg := cfg.Authentication()
+
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	organizationName = strings.ToLower(organizationName)
+	if !lo.Contains(authCfg.GetOrganizations(), organizationName) {
+		return fmt.Errorf("not logged into organization %s; run azdo auth login", organizationName)
+	}
+	organizationURL, err := authCfg.GetURL(organizationName)
+	if err != nil {
+		return
+	}
+
+	cs := iostrms.ColorScheme()
+	if _, ok := os.LookupEnv("AZDO_TOKEN"); ok {
+		fmt.Fprintf(iostrms.ErrOut, "%s AZDO_TOKEN is set and takes precedence over the stored token\n", cs.WarningIcon())
+	}
+
+	var token string
+	if opts.tokenStdin {
+		defer iostrms.In.Close()
+		b, err := io.ReadAll(iostrms.In)
+		if err != nil {
+			return fmt.Errorf("failed to read token from standard input: %w", err)
+		}
+		token = strings.TrimSpace(string(b))
+		if token == "" {
+			return util.FlagErrorf("no token on standard input")
+		}
+	}
+
+	p, err := ctx.Prompter()
+	if err != nil {
+		return
+	}
+	if !opts.yes {
+		confirmed, err := p.Confirm(fmt.Sprintf("Replace the token of organization %s?", organizationName), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return util.ErrCancel
+		}
+	}
+
+	if token == "" {
+		fmt.Fprint(iostrms.ErrOut, rotationInstructions(organizationURL))
+		token, err = p.AuthToken()
+		if err != nil {
+			return
+		}
+	}
+
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+	iostrms.StartProgressIndicator()
+	user, err := util.GetAuthenticatedUser(rctx, azuredevops.NewPatConnection(organizationURL, token))
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("the new token does not work for organization %s; the old token was kept: %w", organizationName, err)
+	}
+
+	if expires == nil && !opts.tokenStdin {
+		date, err := p.Input("Expiry date of the new token (YYYY-MM-DD, empty if unknown):", "")
+		if err != nil {
+			return err
+		}
+		if expires, err = newExpiry(strings.TrimSpace(date), 0, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	// GetTokenInfo returns nothing when AZDO_TOKEN is set, but the name belongs to the stored token
+	// which is replaced.
+	authCfg.SetTokenInfo(organizationName, config.TokenInfo{
+		Name:    authCfg.GetTokenName(organizationName),
+		Expires: expires,
+	})
+
+	gitProtocol, err := authCfg.GetGitProtocol(organizationName)
+	if err != nil {
+		return
+	}
+	if err = authCfg.Login(organizationName, organizationURL, token, gitProtocol, !opts.insecureStorage); err != nil {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		fmt.Fprintf(iostrms.Out, "%s Rotated token of organization %s for %s\n", cs.SuccessIcon(), cs.Bold(organizationName), lo.FromPtr(user.ProviderDisplayName))
+	}
+	if expires != nil {
+		fmt.Fprintf(iostrms.Out, "New token expires %s\n", expires.Format(expiryLayout))
+	}
+	return
+}
+
+// newExpiry returns the expiry date given as date or as number of days from now, or nil if neither is given.
+func newExpiry(date string, days int, now time.Time) (*time.Time, error) {
+	switch {
+	case date != "":
+		t, err := time.ParseInLocation(expiryLayout, date, time.Local)
+		if err != nil {
+			return nil, util.FlagErrorf("invalid value for --new-expiry: %q is not a date, expected e.g. \"2006-01-02\"", date)
+		}
+		return &t, nil
+	case days > 0:
+		y, m, d := now.AddDate(0, 0, days).Date()
+		t := time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+		return &t, nil
+	}
+	return nil, nil
+}
+
+// rotationInstructions describes how to create a new token for the organization.
+func rotationInstructions(organizationURL string) string {
+	return heredoc.Docf(`
+		Azure DevOps does not allow to renew a personal access token with the token itself.
+		To get a new token:
+		  1. Open %s/_usersSettings/tokens
+		  2. Regenerate the current token, or create a new token with the same scopes
+		  3. Paste the new token below
+	`, strings.TrimRight(organizationURL, "/"))
+}
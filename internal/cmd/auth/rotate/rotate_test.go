@@ -0,0 +1,28 @@
+package rotate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExpiry(t *testing.T) {
+	now := time.Date(2024, 6, 20, 15, 30, 0, 0, time.Local)
+
+	expires, err := newExpiry("", 0, now)
+	require.NoError(t, err)
+	assert.Nil(t, expires)
+
+	expires, err = newExpiry("2024-09-30", 0, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 9, 30, 0, 0, 0, 0, time.Local), *expires)
+
+	expires, err = newExpiry("", 90, now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 9, 18, 0, 0, 0, 0, time.Local), *expires)
+
+	_, err = newExpiry("30.09.2024", 0, now)
+	assert.EqualError(t, err, `invalid value for --new-expiry: "30.09.2024" is not a date, expected e.g. "2006-01-02"`)
+}
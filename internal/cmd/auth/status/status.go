@@ -2,6 +2,9 @@ package status
 
 import (
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/google/uuid"
@@ -9,6 +12,8 @@ import (
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/config"
+	"github.com/tmeckel/azdo-cli/internal/text"
 )
 
 type statusOptions struct {
@@ -137,6 +142,7 @@ func statusRun(ctx util.CmdContext, opts *statusOptions) (err error) {
 
 	iostrms.StopProgressIndicator()
 
+	now := time.Now()
 	for _, v := range organizationStatusResults {
 		if v.err != nil {
 			fmt.Fprintf(iostrms.Out,
@@ -145,6 +151,40 @@ func statusRun(ctx util.CmdContext, opts *statusOptions) (err error) {
 			fmt.Fprintf(iostrms.Out,
 				"%s %s: successfully checked authentication status\n", cs.GreenBold("X"), cs.Bold(v.organizationName))
 		}
+		info, err := authCfg.GetTokenInfo(v.organizationName)
+		if err != nil {
+			return err
+		}
+		if line := tokenInfoLine(info, now); line != "" {
+			switch {
+			case info.Expires != nil && !now.Before(*info.Expires):
+				line = cs.Red(line)
+			case info.Expires != nil && info.Expires.Sub(now) < expiryWarning:
+				line = cs.Yellow(line)
+			}
+			fmt.Fprintf(iostrms.Out, "  - %s\n", line)
+		}
 	}
 	return nil
 }
+
+// expiryWarning is the remaining validity of a token below which its expiry is highlighted
+const expiryWarning = 7 * 24 * time.Hour
+
+// tokenInfoLine describes the name and expiry of a token. It returns an empty string if neither is known.
+func tokenInfoLine(info config.TokenInfo, now time.Time) string {
+	var parts []string
+	if info.Name != "" {
+		parts = append(parts, fmt.Sprintf("Token: %s", info.Name))
+	}
+	if info.Expires != nil {
+		date := info.Expires.Format("2006-01-02")
+		if now.Before(*info.Expires) {
+			days := int(math.Ceil(info.Expires.Sub(now).Hours() / 24))
+			parts = append(parts, fmt.Sprintf("expires %s (in %s)", date, text.Pluralize(days, "day")))
+		} else {
+			parts = append(parts, fmt.Sprintf("expired %s", date))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
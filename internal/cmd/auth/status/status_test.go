@@ -0,0 +1,23 @@
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmeckel/azdo-cli/internal/config"
+)
+
+func TestTokenInfoLine(t *testing.T) {
+	now := time.Date(2024, 6, 20, 15, 0, 0, 0, time.Local)
+	date := func(s string) *time.Time {
+		t, _ := time.ParseInLocation("2006-01-02", s, time.Local)
+		return &t
+	}
+
+	assert.Equal(t, "", tokenInfoLine(config.TokenInfo{}, now))
+	assert.Equal(t, "Token: ci-readonly", tokenInfoLine(config.TokenInfo{Name: "ci-readonly"}, now))
+	assert.Equal(t, "Token: ci-readonly, expires 2024-06-30 (in 10 days)", tokenInfoLine(config.TokenInfo{Name: "ci-readonly", Expires: date("2024-06-30")}, now))
+	assert.Equal(t, "expires 2024-06-21 (in 1 day)", tokenInfoLine(config.TokenInfo{Expires: date("2024-06-21")}, now))
+	assert.Equal(t, "Token: old, expired 2024-06-01", tokenInfoLine(config.TokenInfo{Name: "old", Expires: date("2024-06-01")}, now))
+}
@@ -0,0 +1,17 @@
+package area
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/area/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdArea(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "area <command>",
+		Short: "Manage area paths",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	return cmd
+}
@@ -0,0 +1,36 @@
+package list
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &shared.ClassificationListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List the area paths of a project",
+		Long: heredoc.Doc(`
+			List the area paths of a project as a tree.
+
+			The --depth flag controls how many levels below the root area are fetched.
+		`),
+		Example: heredoc.Doc(`
+			azdo boards area list myorg/myproject --depth 3
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list areas: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Scope = args[0]
+			return shared.RunClassificationList(ctx, opts, workitemtracking.TreeStructureGroupValues.Areas)
+		},
+	}
+
+	shared.AddClassificationListFlags(cmd, opts)
+
+	return cmd
+}
@@ -0,0 +1,32 @@
+package boards
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/area"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/iteration"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/sprint"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdBoards(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "boards <command>",
+		Short: "Work with Azure Boards",
+		Long:  `Work with Azure Boards work items, areas, iterations, sprints and teams.`,
+		Example: heredoc.Doc(`
+			$ azdo boards area list myorg/myproject
+			$ azdo boards work-item attachment upload 42 ./screenshot.png myorg/myproject
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(area.NewCmdArea(ctx))
+	cmd.AddCommand(iteration.NewCmdIteration(ctx))
+	cmd.AddCommand(sprint.NewCmdSprint(ctx))
+	cmd.AddCommand(team.NewCmdTeam(ctx))
+	cmd.AddCommand(workitem.NewCmdWorkItem(ctx))
+	return cmd
+}
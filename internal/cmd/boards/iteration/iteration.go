@@ -0,0 +1,17 @@
+package iteration
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/iteration/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdIteration(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iteration <command>",
+		Short: "Manage iteration paths",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	return cmd
+}
@@ -0,0 +1,36 @@
+package list
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &shared.ClassificationListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List the iteration paths of a project",
+		Long: heredoc.Doc(`
+			List the iteration paths of a project as a tree.
+
+			The --depth flag controls how many levels below the root iteration are fetched.
+		`),
+		Example: heredoc.Doc(`
+			azdo boards iteration list myproject
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list iterations: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Scope = args[0]
+			return shared.RunClassificationList(ctx, opts, workitemtracking.TreeStructureGroupValues.Iterations)
+		},
+	}
+
+	shared.AddClassificationListFlags(cmd, opts)
+
+	return cmd
+}
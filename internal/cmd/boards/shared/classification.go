@@ -0,0 +1,117 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// ClassificationListOptions holds the options of the area and iteration list commands.
+type ClassificationListOptions struct {
+	Scope  string
+	Depth  int
+	Format string
+}
+
+// AddClassificationListFlags registers the flags shared by the area and iteration list commands.
+func AddClassificationListFlags(cmd *cobra.Command, opts *ClassificationListOptions) {
+	cmd.Flags().IntVar(&opts.Depth, "depth", 2, "Depth of child nodes to fetch")
+	util.AddOutputFormatFlag(cmd, &opts.Format)
+}
+
+// classificationNode is the JSON representation of a classification node.
+type classificationNode struct {
+	ID          int                  `json:"id"`
+	Name        string               `json:"name"`
+	Path        string               `json:"path"`
+	HasChildren bool                 `json:"hasChildren"`
+	Children    []classificationNode `json:"children,omitempty"`
+}
+
+func newClassificationNode(n *workitemtracking.WorkItemClassificationNode) classificationNode {
+	node := classificationNode{
+		ID:          lo.FromPtr(n.Id),
+		Name:        lo.FromPtr(n.Name),
+		Path:        lo.FromPtr(n.Path),
+		HasChildren: lo.FromPtr(n.HasChildren),
+	}
+	if n.Children != nil {
+		for i := range *n.Children {
+			node.Children = append(node.Children, newClassificationNode(&(*n.Children)[i]))
+		}
+	}
+	return node
+}
+
+// RunClassificationList fetches the classification node tree of the structure group (areas or iterations)
+// and renders it as an indented tree, as TSV or as JSON.
+func RunClassificationList(ctx util.CmdContext, opts *ClassificationListOptions, group workitemtracking.TreeStructureGroup) (err error) {
+	if opts.Depth < 0 {
+		return util.FlagErrorf("--depth must not be negative")
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.Scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	root, err := client.GetClassificationNode(rctx, workitemtracking.GetClassificationNodeArgs{
+		Project:        &project,
+		StructureGroup: &group,
+		Depth:          &opts.Depth,
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get %s of project %s: %w", group, project, err)
+	}
+
+	node := newClassificationNode(root)
+	if opts.Format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(node)
+	}
+
+	tp, err := ctx.Printer(opts.Format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Name", "ID", "Path")
+	// only the table shows the tree by indentation, TSV output keeps the names as they are
+	indent := lo.Ternary(opts.Format == "table", "  ", "")
+	var addNode func(n classificationNode, level int)
+	addNode = func(n classificationNode, level int) {
+		tp.AddField(strings.Repeat(indent, level) + n.Name)
+		tp.AddField(strconv.Itoa(n.ID), printer.WithTruncate(nil))
+		tp.AddField(n.Path)
+		tp.EndRow()
+		for _, c := range n.Children {
+			addNode(c, level+1)
+		}
+	}
+	addNode(node, 0)
+	return tp.Render()
+}
@@ -0,0 +1,182 @@
+package capacity
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type capacityOptions struct {
+	scope  string
+	sprint string
+	team   string
+	format string
+}
+
+// memberCapacity is the capacity of a team member for one activity in a sprint.
+type memberCapacity struct {
+	DisplayName    string  `json:"displayName"`
+	UniqueName     string  `json:"uniqueName"`
+	Activity       string  `json:"activity"`
+	CapacityPerDay float64 `json:"capacityPerDay"`
+	DaysOff        int     `json:"daysOff"`
+	TotalHours     float64 `json:"totalHours"`
+}
+
+func NewCmdCapacity(ctx util.CmdContext) *cobra.Command {
+	opts := &capacityOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "capacity [organization/]project",
+		Short: "Show the capacity of a team in a sprint",
+		Long: heredoc.Doc(`
+			Show the capacity of the members of a team in a sprint of the team, which defaults to the
+			current sprint. A sprint is an iteration of the team and is given by its path or name.
+			When --team is not given, the default team of the project is used.
+
+			The days off are the working days in the sprint on which the member or the whole team is
+			off. The total hours are the capacity per day multiplied by all working days of the sprint,
+			not only the remaining ones, without the days off.
+		`),
+		Example: heredoc.Doc(`
+			# show the capacity of the default team in the current sprint
+			azdo boards sprint capacity myproject
+
+			# show the capacity of a team in a sprint as JSON
+			azdo boards sprint capacity myorg/myproject --team "Team A" --sprint "myproject\Sprint 12" --format json
+		`),
+		Args: util.ExactArgs(1, "cannot show capacity: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			return runCapacity(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.sprint, "sprint", "", "Path or name of the sprint (default: current sprint of the team)")
+	cmd.Flags().StringVar(&opts.team, "team", "", "Name or ID of the team (default: default team of the project)")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runCapacity(ctx util.CmdContext, opts *capacityOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := work.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	iteration, err := shared.FindTeamIteration(rctx, client, project, opts.team, opts.sprint)
+	if err != nil {
+		return
+	}
+	if iteration.Attributes == nil || iteration.Attributes.StartDate == nil || iteration.Attributes.FinishDate == nil {
+		return fmt.Errorf("sprint %s has no start and finish date", lo.FromPtr(iteration.Path))
+	}
+	capacities, err := client.GetCapacitiesWithIdentityRefAndTotals(rctx, work.GetCapacitiesWithIdentityRefAndTotalsArgs{
+		Project:     &project,
+		Team:        &opts.team,
+		IterationId: iteration.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get capacity of sprint %s: %w", lo.FromPtr(iteration.Path), err)
+	}
+	teamDaysOff, err := client.GetTeamDaysOff(rctx, work.GetTeamDaysOffArgs{
+		Project:     &project,
+		IterationId: iteration.Id,
+		Team:        &opts.team,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get days off of sprint %s: %w", lo.FromPtr(iteration.Path), err)
+	}
+	iostrms.StopProgressIndicator()
+
+	rows := memberCapacities(lo.FromPtr(capacities.TeamMembers), lo.FromPtr(teamDaysOff.DaysOff),
+		iteration.Attributes.StartDate.Time, iteration.Attributes.FinishDate.Time)
+	if len(rows) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No capacity set in sprint %s", lo.FromPtr(iteration.Path)))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(rows)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Team Member", "Activity", "Capacity Per Day", "Days Off", "Total Hours")
+	for _, r := range rows {
+		tp.AddField(r.DisplayName)
+		tp.AddField(r.Activity)
+		tp.AddField(strconv.FormatFloat(r.CapacityPerDay, 'f', -1, 64))
+		tp.AddField(strconv.Itoa(r.DaysOff))
+		tp.AddField(strconv.FormatFloat(r.TotalHours, 'f', -1, 64))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// memberCapacities returns a row per team member and activity, sorted by member name. Members
+// without activities are returned with an empty activity and no capacity.
+func memberCapacities(members []work.TeamMemberCapacityIdentityRef, teamDaysOff []work.DateRange, start, finish time.Time) []memberCapacity {
+	workingDays := shared.RemainingWorkingDays(start, finish, start, nil)
+
+	var rows []memberCapacity
+	for _, m := range members {
+		if m.TeamMember == nil {
+			continue
+		}
+		daysOff := append(append([]work.DateRange{}, lo.FromPtr(m.DaysOff)...), teamDaysOff...)
+		availableDays := shared.RemainingWorkingDays(start, finish, start, daysOff)
+		activities := lo.FromPtr(m.Activities)
+		if len(activities) == 0 {
+			activities = []work.Activity{{}}
+		}
+		for _, a := range activities {
+			perDay := float64(lo.FromPtr(a.CapacityPerDay))
+			rows = append(rows, memberCapacity{
+				DisplayName:    lo.FromPtr(m.TeamMember.DisplayName),
+				UniqueName:     lo.FromPtr(m.TeamMember.UniqueName),
+				Activity:       lo.FromPtr(a.Name),
+				CapacityPerDay: perDay,
+				DaysOff:        workingDays - availableDays,
+				TotalHours:     perDay * float64(availableDays),
+			})
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return strings.ToLower(rows[i].DisplayName) < strings.ToLower(rows[j].DisplayName)
+	})
+	return rows
+}
@@ -0,0 +1,40 @@
+package capacity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberCapacities(t *testing.T) {
+	day := func(d int) *azuredevops.Time {
+		return &azuredevops.Time{Time: time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)}
+	}
+	// the sprint runs from Monday, March 4 to Friday, March 15
+	start, finish := day(4).Time, day(15).Time
+	members := []work.TeamMemberCapacityIdentityRef{
+		{
+			TeamMember: &webapi.IdentityRef{DisplayName: lo.ToPtr("Zoe"), UniqueName: lo.ToPtr("zoe@example.com")},
+			Activities: &[]work.Activity{
+				{Name: lo.ToPtr("Development"), CapacityPerDay: lo.ToPtr(float32(4))},
+				{Name: lo.ToPtr("Testing"), CapacityPerDay: lo.ToPtr(float32(2))},
+			},
+			DaysOff: &[]work.DateRange{{Start: day(6), End: day(8)}},
+		},
+		{
+			TeamMember: &webapi.IdentityRef{DisplayName: lo.ToPtr("Adam"), UniqueName: lo.ToPtr("adam@example.com")},
+		},
+	}
+	teamDaysOff := []work.DateRange{{Start: day(15), End: day(15)}}
+
+	assert.Equal(t, []memberCapacity{
+		{DisplayName: "Adam", UniqueName: "adam@example.com", DaysOff: 1},
+		{DisplayName: "Zoe", UniqueName: "zoe@example.com", Activity: "Development", CapacityPerDay: 4, DaysOff: 4, TotalHours: 24},
+		{DisplayName: "Zoe", UniqueName: "zoe@example.com", Activity: "Testing", CapacityPerDay: 2, DaysOff: 4, TotalHours: 12},
+	}, memberCapacities(members, teamDaysOff, start, finish))
+}
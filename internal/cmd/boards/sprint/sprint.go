@@ -0,0 +1,17 @@
+package sprint
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/sprint/capacity"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdSprint(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sprint <command>",
+		Short: "Plan the sprints of a team",
+	}
+
+	cmd.AddCommand(capacity.NewCmdCapacity(ctx))
+	return cmd
+}
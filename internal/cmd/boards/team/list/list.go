@@ -0,0 +1,152 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// pageSize is the number of teams fetched with a single request
+const pageSize = 100
+
+// maxConcurrentRequests is the maximum number of teams whose members are fetched concurrently
+const maxConcurrentRequests = 8
+
+type listOptions struct {
+	scope  string
+	format string
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List the teams of a project",
+		Example: heredoc.Doc(`
+			# list the teams of a project
+			azdo boards team list myproject
+
+			# list the teams as JSON
+			azdo boards team list myorg/myproject --format json
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list teams: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			return runList(ctx, opts)
+		},
+	}
+
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	var webTeams []core.WebApiTeam
+	for skip := 0; ; skip += pageSize {
+		res, err := client.GetTeams(rctx, core.GetTeamsArgs{
+			ProjectId: &project,
+			Top:       lo.ToPtr(pageSize),
+			Skip:      &skip,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get teams of project %s: %w", project, err)
+		}
+		webTeams = append(webTeams, lo.FromPtr(res)...)
+		if len(lo.FromPtr(res)) < pageSize {
+			break
+		}
+	}
+	if len(webTeams) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No teams found in project %s", project))
+	}
+
+	// the teams do not include their number of members, so the members of each team are fetched
+	teams := make([]shared.Team, len(webTeams))
+	errs := make([]error, len(webTeams))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentRequests)
+	for i := range webTeams {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			members, err := shared.GetMembers(rctx, client, project, webTeams[i].Id.String())
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			// only the number of members is listed
+			teams[i] = shared.NewTeam(&webTeams[i], nil)
+			teams[i].MemberCount = lo.ToPtr(len(members))
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	sort.SliceStable(teams, func(i, j int) bool {
+		return strings.ToLower(teams[i].Name) < strings.ToLower(teams[j].Name)
+	})
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(teams)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Name", "Description", "Members")
+	for _, t := range teams {
+		tp.AddField(t.ID, printer.WithTruncate(nil))
+		tp.AddField(t.Name)
+		tp.AddField(t.Description)
+		tp.AddField(strconv.Itoa(*t.MemberCount), printer.WithTruncate(nil))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,118 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type addOptions struct {
+	team   string
+	scope  string
+	member string
+}
+
+func NewCmdAdd(ctx util.CmdContext) *cobra.Command {
+	opts := &addOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add <team> [organization/]project",
+		Short: "Add a member to a team",
+		Long: heredoc.Doc(`
+			Add a user or group to a team. The member is given by email address, account name or
+			subject descriptor.
+		`),
+		Example: heredoc.Doc(`
+			# add a user to a team
+			azdo boards team member add "Team A" myproject --member jdoe@example.com
+
+			# add a group by its descriptor
+			azdo boards team member add "Team A" myorg/myproject --member vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5
+		`),
+		Args: util.ExactArgs(2, "cannot add team member: team and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.team = args[0]
+			opts.scope = args[1]
+
+			return runAdd(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.member, "member", "", "Email address, account name or descriptor of the member")
+	_ = cmd.MarkFlagRequired("member")
+
+	return cmd
+}
+
+func runAdd(ctx util.CmdContext, opts *addOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	identityClient, err := identity.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	graphClient, err := graph.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	team, err := coreClient.GetTeam(rctx, core.GetTeamArgs{
+		ProjectId: &project,
+		TeamId:    &opts.team,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get team %s: %w", opts.team, err)
+	}
+	member, err := util.ResolveIdentity(rctx, identityClient, opts.member)
+	if err != nil {
+		return
+	}
+	teamDescriptor, err := shared.GetTeamDescriptor(rctx, graphClient, *team.Id)
+	if err != nil {
+		return
+	}
+
+	_, err = graphClient.AddMembership(rctx, graph.AddMembershipArgs{
+		SubjectDescriptor:   member.SubjectDescriptor,
+		ContainerDescriptor: &teamDescriptor,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add %s to team %s: %w", opts.member, *team.Name, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Added %s to team %s\n", cs.SuccessIcon(), cs.Bold(lo.FromPtr(member.ProviderDisplayName)), cs.Bold(*team.Name))
+	}
+	return
+}
@@ -0,0 +1,19 @@
+package member
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/member/add"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/member/remove"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdMember(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "member <command>",
+		Short: "Manage the members of a team",
+	}
+
+	cmd.AddCommand(add.NewCmdAdd(ctx))
+	cmd.AddCommand(remove.NewCmdRemove(ctx))
+	return cmd
+}
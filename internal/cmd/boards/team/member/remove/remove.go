@@ -0,0 +1,158 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type removeOptions struct {
+	team   string
+	scope  string
+	member string
+	yes    bool
+	dryRun bool
+}
+
+func NewCmdRemove(ctx util.CmdContext) *cobra.Command {
+	opts := &removeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <team> [organization/]project",
+		Short: "Remove a member from a team",
+		Long: heredoc.Doc(`
+			Remove a user or group from a team. The member is given by email address, account name or
+			subject descriptor.
+		`),
+		Example: heredoc.Doc(`
+			# remove a user from a team
+			azdo boards team member remove "Team A" myproject --member jdoe@example.com
+
+			# remove a user without confirmation
+			azdo boards team member remove "Team A" myorg/myproject --member jdoe@example.com --yes
+
+			# show what would be removed without removing it
+			azdo boards team member remove "Team A" myproject --member jdoe@example.com --dry-run
+		`),
+		Aliases: []string{"rm"},
+		Args:    util.ExactArgs(2, "cannot remove team member: team and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.team = args[0]
+			opts.scope = args[1]
+
+			return runRemove(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.member, "member", "", "Email address, account name or descriptor of the member")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be removed without removing it")
+	_ = cmd.MarkFlagRequired("member")
+
+	return cmd
+}
+
+func runRemove(ctx util.CmdContext, opts *removeOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	identityClient, err := identity.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	graphClient, err := graph.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	team, err := coreClient.GetTeam(rctx, core.GetTeamArgs{
+		ProjectId: &project,
+		TeamId:    &opts.team,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get team %s: %w", opts.team, err)
+	}
+	member, err := util.ResolveIdentity(rctx, identityClient, opts.member)
+	if err != nil {
+		return
+	}
+	members, err := shared.GetMembers(rctx, coreClient, project, team.Id.String())
+	if err != nil {
+		return
+	}
+	name := lo.FromPtr(member.ProviderDisplayName)
+	if !shared.IsMember(members, member) {
+		return fmt.Errorf("%s is not a member of team %s", name, *team.Name)
+	}
+	teamDescriptor, err := shared.GetTeamDescriptor(rctx, graphClient, *team.Id)
+	if err != nil {
+		return
+	}
+	iostrms.StopProgressIndicator()
+
+	if !opts.yes && !opts.dryRun {
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--yes required when not running interactively")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		confirmed, err := p.Confirm(fmt.Sprintf("Remove %s from team %s?", name, *team.Name), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return util.ErrCancel
+		}
+	}
+
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("remove %s from team %s", name, *team.Name), func() error {
+		iostrms.StartProgressIndicator()
+		defer iostrms.StopProgressIndicator()
+		return graphClient.RemoveMembership(rctx, graph.RemoveMembershipArgs{
+			SubjectDescriptor:   member.SubjectDescriptor,
+			ContainerDescriptor: &teamDescriptor,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove %s from team %s: %w", name, *team.Name, err)
+	}
+	if opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Removed %s from team %s\n", cs.SuccessIcon(), cs.Bold(name), cs.Bold(*team.Name))
+	}
+	return
+}
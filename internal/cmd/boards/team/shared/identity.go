@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/samber/lo"
+)
+
+// GetTeamDescriptor returns the subject descriptor of the team, which is the container of the
+// memberships of the team.
+func GetTeamDescriptor(ctx context.Context, client graph.Client, teamID uuid.UUID) (string, error) {
+	res, err := client.GetDescriptor(ctx, graph.GetDescriptorArgs{
+		StorageKey: &teamID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get descriptor of team %s: %w", teamID, err)
+	}
+	return lo.FromPtr(res.Value), nil
+}
+
+// IsMember reports whether the identity is a direct member of the team.
+func IsMember(members []Member, id *identity.Identity) bool {
+	return lo.ContainsBy(members, func(m Member) bool {
+		return strings.EqualFold(m.ID, lo.FromPtr(id.Id).String())
+	})
+}
@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/samber/lo"
+)
+
+// pageSize is the number of teams or team members fetched with a single request
+const pageSize = 100
+
+// Team is a team of a project as printed by the team commands.
+type Team struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	IdentityURL string   `json:"identityUrl"`
+	ProjectID   string   `json:"projectId"`
+	MemberCount *int     `json:"memberCount,omitempty"`
+	Members     []Member `json:"members,omitempty"`
+}
+
+// Member is a member of a team.
+type Member struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+	IsTeamAdmin bool   `json:"isTeamAdmin"`
+}
+
+// NewTeam returns the team with the given members. The member count is only set when members
+// is not nil, as the teams returned by the API do not include their members.
+func NewTeam(t *core.WebApiTeam, members []Member) Team {
+	team := Team{
+		ID:          lo.FromPtr(t.Id).String(),
+		Name:        lo.FromPtr(t.Name),
+		Description: lo.FromPtr(t.Description),
+		IdentityURL: lo.FromPtr(t.IdentityUrl),
+		Members:     members,
+	}
+	if members != nil {
+		team.MemberCount = lo.ToPtr(len(members))
+	}
+	if t.ProjectId != nil {
+		team.ProjectID = t.ProjectId.String()
+	}
+	return team
+}
+
+// GetMembers returns the members of the team sorted by display name.
+func GetMembers(ctx context.Context, client core.Client, projectID, teamID string) ([]Member, error) {
+	var members []Member
+	for skip := 0; ; skip += pageSize {
+		res, err := client.GetTeamMembersWithExtendedProperties(ctx, core.GetTeamMembersWithExtendedPropertiesArgs{
+			ProjectId: &projectID,
+			TeamId:    &teamID,
+			Top:       lo.ToPtr(pageSize),
+			Skip:      &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get members of team %s: %w", teamID, err)
+		}
+		page := lo.FromPtr(res)
+		members = append(members, lo.FilterMap(page, func(m webapi.TeamMember, _ int) (Member, bool) {
+			if m.Identity == nil {
+				return Member{}, false
+			}
+			return Member{
+				ID:          lo.FromPtr(m.Identity.Id),
+				DisplayName: lo.FromPtr(m.Identity.DisplayName),
+				UniqueName:  lo.FromPtr(m.Identity.UniqueName),
+				IsTeamAdmin: lo.FromPtr(m.IsTeamAdmin),
+			}, true
+		})...)
+		if len(page) < pageSize {
+			break
+		}
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		return strings.ToLower(members[i].DisplayName) < strings.ToLower(members[j].DisplayName)
+	})
+	return members, nil
+}
@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"context"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	core.Client
+	members []webapi.TeamMember
+}
+
+func (c *fakeClient) GetTeamMembersWithExtendedProperties(_ context.Context, args core.GetTeamMembersWithExtendedPropertiesArgs) (*[]webapi.TeamMember, error) {
+	page := lo.Subset(c.members, *args.Skip, uint(*args.Top))
+	return &page, nil
+}
+
+func TestGetMembers(t *testing.T) {
+	client := &fakeClient{}
+	for i := 0; i < pageSize+1; i++ {
+		client.members = append(client.members, webapi.TeamMember{
+			Identity: &webapi.IdentityRef{DisplayName: lo.ToPtr("user")},
+		})
+	}
+	client.members[pageSize] = webapi.TeamMember{
+		Identity:    &webapi.IdentityRef{Id: lo.ToPtr("1"), DisplayName: lo.ToPtr("Admin"), UniqueName: lo.ToPtr("admin@example.com")},
+		IsTeamAdmin: lo.ToPtr(true),
+	}
+	client.members = append(client.members, webapi.TeamMember{})
+
+	members, err := GetMembers(context.Background(), client, "project", "team")
+	require.NoError(t, err)
+	assert.Len(t, members, pageSize+1)
+	assert.Equal(t, Member{ID: "1", DisplayName: "Admin", UniqueName: "admin@example.com", IsTeamAdmin: true}, members[0])
+}
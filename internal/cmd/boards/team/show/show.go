@@ -0,0 +1,137 @@
+package show
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+type showOptions struct {
+	team    string
+	scope   string
+	members bool
+	format  string
+}
+
+func NewCmdShow(ctx util.CmdContext) *cobra.Command {
+	opts := &showOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "show <team> [organization/]project",
+		Short: "Show a team of a project",
+		Long: heredoc.Doc(`
+			Show the name and description of a team, which is given by its name or ID. With --members
+			the members of the team are listed as well.
+		`),
+		Example: heredoc.Doc(`
+			# show a team
+			azdo boards team show "Team A" myproject
+
+			# list the members of a team
+			azdo boards team show "Team A" myorg/myproject --members
+		`),
+		Aliases: []string{"view"},
+		Args:    util.ExactArgs(2, "cannot show team: team and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.team = args[0]
+			opts.scope = args[1]
+
+			return runShow(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.members, "members", false, "List the members of the team")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runShow(ctx util.CmdContext, opts *showOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	webTeam, err := client.GetTeam(rctx, core.GetTeamArgs{
+		ProjectId: &project,
+		TeamId:    &opts.team,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get team %s: %w", opts.team, err)
+	}
+	var members []shared.Member
+	if opts.members {
+		members, err = shared.GetMembers(rctx, client, project, webTeam.Id.String())
+		if err != nil {
+			return
+		}
+		if members == nil {
+			members = []shared.Member{}
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	team := shared.NewTeam(webTeam, members)
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(team)
+	}
+
+	cs := iostrms.ColorScheme()
+	out := iostrms.Out
+	fmt.Fprintln(out, cs.Bold(team.Name))
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("ID"), team.ID)
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("Description"), team.Description)
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("Identity URL"), team.IdentityURL)
+
+	if !opts.members {
+		return nil
+	}
+	fmt.Fprintf(out, "%s: %s\n", cs.Bold("Members"), text.Pluralize(len(team.Members), "member"))
+	if len(team.Members) == 0 {
+		return nil
+	}
+	fmt.Fprintln(out)
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Name", "Unique Name", "Admin")
+	for _, m := range team.Members {
+		tp.AddField(m.DisplayName)
+		tp.AddField(m.UniqueName)
+		if m.IsTeamAdmin {
+			tp.AddField("yes")
+		} else {
+			tp.AddField("")
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,21 @@
+package team
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/member"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/team/show"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdTeam(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team <command>",
+		Short: "Manage the teams of a project",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(show.NewCmdShow(ctx))
+	cmd.AddCommand(member.NewCmdMember(ctx))
+	return cmd
+}
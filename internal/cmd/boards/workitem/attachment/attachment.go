@@ -0,0 +1,17 @@
+package attachment
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/attachment/upload"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdAttachment(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attachment <command>",
+		Short: "Manage work item attachments",
+	}
+
+	cmd.AddCommand(upload.NewCmdAttachmentUpload(ctx))
+	return cmd
+}
@@ -0,0 +1,173 @@
+package upload
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type uploadOptions struct {
+	workItemID int
+	files      []string
+	scope      string
+	comment    string
+	format     string
+}
+
+type uploadedAttachment struct {
+	name string
+	ref  *workitemtracking.AttachmentReference
+}
+
+func NewCmdAttachmentUpload(ctx util.CmdContext) *cobra.Command {
+	opts := &uploadOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "upload <id> <file> [organization/]project",
+		Short: "Upload files and attach them to a work item",
+		Long: heredoc.Doc(`
+			Upload one or more files and link them as attachments to a work item.
+
+			Additional files can be passed by repeating the --file flag.
+		`),
+		Example: heredoc.Doc(`
+			# attach a single file to work item 42
+			azdo boards work-item attachment upload 42 ./screenshot.png myproject
+
+			# attach multiple files with a comment
+			azdo boards work-item attachment upload 42 ./log.txt myorg/myproject --file ./trace.txt --comment "Logs from failed run"
+		`),
+		Args: util.ExactArgs(3, "cannot upload attachment: work item ID, file and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid work item ID: %s", args[0])
+			}
+			opts.workItemID = id
+			opts.files = append([]string{args[1]}, opts.files...)
+			opts.scope = args[2]
+
+			return runUpload(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.files, "file", nil, "Additional file to upload; can be repeated")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment to add to the attachment links")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runUpload(ctx util.CmdContext, opts *uploadOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	witClient, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	uploaded := make([]uploadedAttachment, 0, len(opts.files))
+	for _, fileName := range opts.files {
+		name := filepath.Base(fileName)
+		iostrms.StartProgressIndicatorWithLabel(fmt.Sprintf("Uploading %s", name))
+		ref, err := uploadFile(ctx, witClient, project, fileName)
+		iostrms.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+		uploaded = append(uploaded, uploadedAttachment{
+			name: name,
+			ref:  ref,
+		})
+	}
+
+	patch := make([]webapi.JsonPatchOperation, 0, len(uploaded))
+	for _, a := range uploaded {
+		relation := map[string]any{
+			"rel": "AttachedFile",
+			"url": *a.ref.Url,
+		}
+		if opts.comment != "" {
+			relation["attributes"] = map[string]any{
+				"comment": opts.comment,
+			}
+		}
+		patch = append(patch, webapi.JsonPatchOperation{
+			Op:    &webapi.OperationValues.Add,
+			Path:  lo.ToPtr("/relations/-"),
+			Value: relation,
+		})
+	}
+
+	err = iostrms.RunWithProgress(fmt.Sprintf("Linking attachments to work item %d", opts.workItemID), func() error {
+		_, err := witClient.UpdateWorkItem(rctx, workitemtracking.UpdateWorkItemArgs{
+			Id:       &opts.workItemID,
+			Project:  &project,
+			Document: &patch,
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to link attachments to work item %d: %w", opts.workItemID, err)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+
+	tp.AddColumns("ID", "Name", "URL")
+	for _, a := range uploaded {
+		tp.AddField(a.ref.Id.String(), printer.WithTruncate(nil))
+		tp.AddField(a.name)
+		tp.AddField(*a.ref.Url)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+func uploadFile(ctx util.CmdContext, client workitemtracking.Client, project, fileName string) (*workitemtracking.AttachmentReference, error) {
+	rctx, err := ctx.Context()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s: %w", fileName, err)
+	}
+	defer f.Close()
+
+	ref, err := client.CreateAttachment(rctx, workitemtracking.CreateAttachmentArgs{
+		UploadStream: f,
+		Project:      &project,
+		FileName:     lo.ToPtr(filepath.Base(fileName)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload file %s: %w", fileName, err)
+	}
+	return ref, nil
+}
@@ -0,0 +1,125 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type closeOptions struct {
+	workItemID int
+	scope      string
+	state      string
+	comment    string
+	dryRun     bool
+}
+
+func NewCmdClose(ctx util.CmdContext) *cobra.Command {
+	opts := &closeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "close <id> [organization/]project",
+		Short: "Close a work item",
+		Long: heredoc.Doc(`
+			Close a work item.
+
+			When --state is not given, the work item is moved to the first state of its type
+			which belongs to the "Completed" category, e.g. "Done" or "Closed".
+		`),
+		Example: heredoc.Doc(`
+			# close work item 42
+			azdo boards work-item close 42 myproject
+
+			# resolve work item 42 with a comment
+			azdo boards work-item close 42 myorg/myproject --state Resolved --comment "Fixed in !17"
+
+			# show the state work item 42 would be moved to
+			azdo boards work-item close 42 myproject --dry-run
+		`),
+		Args: util.ExactArgs(2, "cannot close work item: work item ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseWorkItemID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.workItemID = id
+			opts.scope = args[1]
+
+			return runClose(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.state, "state", "", "State to move the work item to")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment to add to the work item")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be changed without closing the work item")
+
+	return cmd
+}
+
+func runClose(ctx util.CmdContext, opts *closeOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	wi, err := client.GetWorkItem(rctx, workitemtracking.GetWorkItemArgs{
+		Id:      &opts.workItemID,
+		Project: &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get work item %d: %w", opts.workItemID, err)
+	}
+
+	state := opts.state
+	if state == "" {
+		states, err := shared.GetWorkItemTypeStates(rctx, client, project, wi)
+		if err != nil {
+			return err
+		}
+		var ok bool
+		state, ok = shared.FindStateByCategory(states, shared.StateCategoryCompleted)
+		if !ok {
+			return fmt.Errorf("work item type %s has no completed state; specify the state with --state", shared.FieldString(wi, shared.FieldWorkItemType))
+		}
+	}
+
+	iostrms.StartProgressIndicator()
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("set state of work item %d to %s", opts.workItemID, state), func() error {
+		wi, err = shared.UpdateState(rctx, client, project, opts.workItemID, state, opts.comment)
+		return err
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil || opts.dryRun {
+		return
+	}
+
+	finalState := shared.FieldString(wi, shared.FieldState)
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Closed work item %d %s (%s)\n", cs.SuccessIcon(), opts.workItemID, shared.FieldString(wi, shared.FieldTitle), cs.Bold(finalState))
+	} else {
+		fmt.Fprintln(iostrms.Out, finalState)
+	}
+	return
+}
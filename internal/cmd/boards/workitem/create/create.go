@@ -0,0 +1,305 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type createOptions struct {
+	scope         string
+	workItemType  string
+	title         string
+	description   string
+	assignedTo    string
+	area          string
+	iteration     string
+	fields        []string
+	parentID      int
+	checkCapacity bool
+	team          string
+	templateFile  string
+	fromTemplate  string
+	format        string
+}
+
+func NewCmdCreate(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create [organization/]project",
+		Short: "Create a work item",
+		Long: heredoc.Doc(`
+			Create a work item in a project.
+
+			Arbitrary fields can be set by passing --field with the reference name of the field
+			and the value separated by "=", e.g. "Microsoft.VSTS.Common.Priority=1".
+
+			With --check-capacity the sprint capacity of the assignee in the team given by --team is
+			compared with the remaining work already assigned to them in the iteration of the work
+			item, or the current iteration of the team if no --iteration is given. A warning is printed
+			if the work item would put the assignee over capacity; the work item is created anyway.
+
+			With --template-file default field values are read from a JSON file of the form
+			{"fields": {"System.Title": "...", ...}}, which matches the JSON output of "work-item show".
+			Fields which are read-only or not part of the work item type, like the ID, and fields set by
+			the workflow, like the state, are ignored. Values given by flags override the values of the
+			template. The type and title may be taken from the template as well.
+
+			With --from-template the default field values and the type are taken from a work item
+			template of the team given by --team instead. If the template does not exist, the
+			available templates of the team are listed.
+		`),
+		Example: heredoc.Doc(`
+			# create a bug
+			azdo boards work-item create myproject --type Bug --title "Login fails"
+
+			# create a task below user story 42
+			azdo boards work-item create myorg/myproject --type Task --title "Write tests" --parent-id 42
+
+			# create a task and warn if the assignee has no capacity left in the current sprint
+			azdo boards work-item create myproject --type Task --title "Fix build" --assigned-to jane@contoso.com --field Microsoft.VSTS.Scheduling.RemainingWork=4 --check-capacity --team "Team A"
+
+			# create a bug with the fields of an existing bug as defaults
+			azdo boards work-item show 42 myproject --format json > bug.json
+			azdo boards work-item create myproject --title "Crash on start" --template-file bug.json
+
+			# create a work item from the template "Triage bug" of a team
+			azdo boards work-item create myproject --title "Crash on start" --from-template "Triage bug" --team "Team A"
+		`),
+		Args: util.ExactArgs(1, "cannot create work item: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+			return runCreate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.workItemType, "type", "", "Type of the work item, e.g. Bug, Task or \"User Story\"")
+	cmd.Flags().StringVar(&opts.title, "title", "", "Title of the work item")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the work item")
+	cmd.Flags().StringVar(&opts.assignedTo, "assigned-to", "", "Name or email of the user the work item is assigned to")
+	cmd.Flags().StringVar(&opts.area, "area", "", "Area path of the work item")
+	cmd.Flags().StringVar(&opts.iteration, "iteration", "", "Iteration path of the work item")
+	cmd.Flags().StringArrayVar(&opts.fields, "field", nil, "Set a field in the form NAME=VALUE; can be repeated")
+	cmd.Flags().IntVar(&opts.parentID, "parent-id", 0, "ID of the parent work item")
+	cmd.Flags().BoolVar(&opts.checkCapacity, "check-capacity", false, "Warn if the assignee has not enough sprint capacity left")
+	cmd.Flags().StringVar(&opts.team, "team", "", "Team of --check-capacity and --from-template")
+	cmd.Flags().StringVar(&opts.templateFile, "template-file", "", "Read default field values from a JSON file")
+	cmd.Flags().StringVar(&opts.fromTemplate, "from-template", "", "Name or ID of a work item template of the team to take default field values from")
+	cmd.MarkFlagsMutuallyExclusive("template-file", "from-template")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runCreate(ctx util.CmdContext, opts *createOptions) (err error) {
+	if opts.parentID < 0 {
+		return util.FlagErrorf("invalid parent work item ID: %d", opts.parentID)
+	}
+	if opts.checkCapacity {
+		if opts.team == "" {
+			return util.FlagErrorf("--team required with --check-capacity")
+		}
+		if opts.assignedTo == "" {
+			return util.FlagErrorf("--assigned-to required with --check-capacity")
+		}
+	}
+	if opts.fromTemplate != "" && opts.team == "" {
+		return util.FlagErrorf("--team required with --from-template")
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	values := map[string]any{}
+	if opts.templateFile != "" {
+		data, err := iostrms.ReadUserFile(opts.templateFile)
+		if err != nil {
+			return fmt.Errorf("failed to read template %s: %w", opts.templateFile, err)
+		}
+		values, err = parseTemplate(data)
+		if err != nil {
+			return err
+		}
+	}
+	if opts.fromTemplate != "" {
+		iostrms.StartProgressIndicator()
+		values, err = loadTeamTemplate(rctx, client, project, opts.team, opts.fromTemplate)
+		iostrms.StopProgressIndicator()
+		if err != nil {
+			return
+		}
+	}
+	if opts.workItemType == "" {
+		opts.workItemType, _ = values[shared.FieldWorkItemType].(string)
+		if opts.workItemType == "" {
+			return util.FlagErrorf("--type required")
+		}
+	}
+	delete(values, shared.FieldWorkItemType)
+	if len(values) > 0 {
+		iostrms.StartProgressIndicator()
+		typeFields, fields, err := getFieldMetadata(rctx, client, project, opts.workItemType)
+		iostrms.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+		removeReadOnlyFields(values, typeFields, fields)
+	}
+	for _, f := range []struct{ name, value string }{
+		{shared.FieldTitle, opts.title},
+		{shared.FieldDescription, opts.description},
+		{shared.FieldAssignedTo, opts.assignedTo},
+		{shared.FieldAreaPath, opts.area},
+		{shared.FieldIterationPath, opts.iteration},
+	} {
+		if f.value != "" {
+			values[f.name] = f.value
+		}
+	}
+	for _, f := range opts.fields {
+		name, value, ok := strings.Cut(f, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return util.FlagErrorf("invalid field %q; expected NAME=VALUE", f)
+		}
+		values[strings.TrimSpace(name)] = value
+	}
+	if title, _ := values[shared.FieldTitle].(string); title == "" {
+		return util.FlagErrorf("--title required")
+	}
+	var remainingWork float64
+	switch v := values[shared.FieldRemainingWork].(type) {
+	case float64:
+		remainingWork = v
+	case string:
+		remainingWork, _ = strconv.ParseFloat(v, 64)
+	}
+
+	names := lo.Keys(values)
+	sort.Strings(names)
+	document := make([]webapi.JsonPatchOperation, 0, len(names))
+	for _, name := range names {
+		document = append(document, shared.AddFieldOperation(name, values[name]))
+	}
+
+	var parent *workitemtracking.WorkItem
+	if opts.parentID > 0 {
+		parent, err = client.GetWorkItem(rctx, workitemtracking.GetWorkItemArgs{
+			Id:      &opts.parentID,
+			Project: &project,
+			Fields:  &[]string{shared.FieldTitle},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get parent work item %d: %w", opts.parentID, err)
+		}
+	}
+
+	if opts.checkCapacity {
+		if err := checkCapacity(rctx, iostrms, conn, client, project, opts, remainingWork); err != nil {
+			return err
+		}
+	}
+
+	iostrms.StartProgressIndicator()
+	wi, err := client.CreateWorkItem(rctx, workitemtracking.CreateWorkItemArgs{
+		Document: &document,
+		Project:  &project,
+		Type:     &opts.workItemType,
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to create work item: %w", err)
+	}
+
+	if parent != nil {
+		id := *wi.Id
+		err = iostrms.RunWithProgress(fmt.Sprintf("Linking work item %d to parent %d", id, opts.parentID), func() error {
+			linked, err := client.UpdateWorkItem(rctx, workitemtracking.UpdateWorkItemArgs{
+				Id:      &id,
+				Project: &project,
+				Document: &[]webapi.JsonPatchOperation{
+					shared.AddRelationOperation(shared.RelationParent, *parent.Url),
+				},
+			})
+			if err != nil {
+				return err
+			}
+			wi = linked
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("created work item %d but failed to link it to parent %d: %w", id, opts.parentID, err)
+		}
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Type", "State", "Title")
+	tp.AddField(strconv.Itoa(*wi.Id), printer.WithTruncate(nil))
+	tp.AddField(shared.FieldString(wi, shared.FieldWorkItemType))
+	tp.AddField(shared.FieldString(wi, shared.FieldState))
+	tp.AddField(shared.FieldString(wi, shared.FieldTitle))
+	tp.EndRow()
+	return tp.Render()
+}
+
+// checkCapacity prints a warning if the assignee has not enough sprint capacity left for the work item.
+func checkCapacity(ctx context.Context, iostrms *iostreams.IOStreams, conn *azuredevops.Connection, client workitemtracking.Client, project string, opts *createOptions, remainingWork float64) error {
+	workClient, err := work.NewClient(ctx, conn)
+	if err != nil {
+		return err
+	}
+
+	iostrms.StartProgressIndicator()
+	status, err := shared.CheckCapacity(ctx, workClient, client, project, opts.team, opts.assignedTo, opts.iteration, remainingWork)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	cs := iostrms.ColorScheme()
+	if status == nil {
+		fmt.Fprintf(iostrms.ErrOut, "%s %s has no capacity set in team %s\n", cs.WarningIcon(), opts.assignedTo, opts.team)
+		return nil
+	}
+	if status.RemainingHours() < 0 {
+		fmt.Fprintf(iostrms.ErrOut, "%s %s is over capacity in %s: %gh of remaining work for %gh of capacity\n",
+			cs.WarningIcon(), status.Member, status.Iteration, status.RemainingWork, status.Capacity)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package create
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+)
+
+// workflowFields are set by the state transitions of the work item type. The field metadata does not
+// mark them as read-only, but values copied from another work item violate the rules of the type.
+var workflowFields = map[string]bool{
+	"System.State":                          true,
+	"System.Reason":                         true,
+	"Microsoft.VSTS.Common.StateChangeDate": true,
+	"Microsoft.VSTS.Common.ActivatedDate":   true,
+	"Microsoft.VSTS.Common.ActivatedBy":     true,
+	"Microsoft.VSTS.Common.ResolvedDate":    true,
+	"Microsoft.VSTS.Common.ResolvedBy":      true,
+	"Microsoft.VSTS.Common.ResolvedReason":  true,
+	"Microsoft.VSTS.Common.ClosedDate":      true,
+	"Microsoft.VSTS.Common.ClosedBy":        true,
+}
+
+// template holds default field values of a work item. Its format matches the JSON output of
+// "work-item show", so the output of an existing work item can be used as template.
+type template struct {
+	Fields map[string]any `json:"fields"`
+}
+
+// parseTemplate returns the field values of a template. Board fields are skipped and identities are
+// replaced by their unique name. Read-only fields are removed by removeReadOnlyFields once the type
+// of the work item is known.
+func parseTemplate(data []byte) (map[string]any, error) {
+	var t template
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("failed to parse template: %w", err)
+	}
+	values := make(map[string]any, len(t.Fields))
+	for name, value := range t.Fields {
+		if strings.HasPrefix(name, "System.BoardColumn") || strings.HasPrefix(name, "WEF_") {
+			continue
+		}
+		if identity, ok := value.(map[string]any); ok {
+			if uniqueName, ok := identity["uniqueName"].(string); ok {
+				value = uniqueName
+			}
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+// loadTeamTemplate returns the field values of the work item template with the given name or ID of a
+// team. The work item type of the template is returned as value of the field System.WorkItemType.
+func loadTeamTemplate(ctx context.Context, client workitemtracking.Client, project, team, name string) (map[string]any, error) {
+	refs, err := client.GetTemplates(ctx, workitemtracking.GetTemplatesArgs{
+		Project: &project,
+		Team:    &team,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work item templates of team %s: %w", team, err)
+	}
+	ref, err := findTeamTemplate(lo.FromPtr(refs), team, name)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := client.GetTemplate(ctx, workitemtracking.GetTemplateArgs{
+		Project:    &project,
+		Team:       &team,
+		TemplateId: ref.Id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get work item template %s: %w", *ref.Name, err)
+	}
+	values := make(map[string]any, len(lo.FromPtr(t.Fields))+1)
+	for name, value := range lo.FromPtr(t.Fields) {
+		values[name] = value
+	}
+	values[shared.FieldWorkItemType] = lo.FromPtr(t.WorkItemTypeName)
+	return values, nil
+}
+
+// getFieldMetadata returns the fields of the work item type and the metadata of all fields of the
+// project, which tells whether a field is read-only.
+func getFieldMetadata(ctx context.Context, client workitemtracking.Client, project, workItemType string) ([]workitemtracking.WorkItemTypeFieldWithReferences, []workitemtracking.WorkItemField2, error) {
+	typeFields, err := client.GetWorkItemTypeFieldsWithReferences(ctx, workitemtracking.GetWorkItemTypeFieldsWithReferencesArgs{
+		Project: &project,
+		Type:    &workItemType,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get fields of work item type %s: %w", workItemType, err)
+	}
+	fields, err := client.GetWorkItemFields(ctx, workitemtracking.GetWorkItemFieldsArgs{
+		Project: &project,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get fields of project %s: %w", project, err)
+	}
+	return lo.FromPtr(typeFields), lo.FromPtr(fields), nil
+}
+
+// removeReadOnlyFields removes the template values which cannot be set when a work item is created:
+// fields which are not part of the work item type, fields which are read-only according to their
+// metadata, like System.Id, System.AreaLevel2 or System.AttachedFileCount, and the workflow fields.
+func removeReadOnlyFields(values map[string]any, typeFields []workitemtracking.WorkItemTypeFieldWithReferences, fields []workitemtracking.WorkItemField2) {
+	ofType := make(map[string]bool, len(typeFields))
+	for _, f := range typeFields {
+		ofType[strings.ToLower(lo.FromPtr(f.ReferenceName))] = true
+	}
+	readOnly := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if lo.FromPtr(f.ReadOnly) {
+			readOnly[strings.ToLower(lo.FromPtr(f.ReferenceName))] = true
+		}
+	}
+	for name := range values {
+		key := strings.ToLower(name)
+		if !ofType[key] || readOnly[key] || workflowFields[name] {
+			delete(values, name)
+		}
+	}
+}
+
+// findTeamTemplate returns the template with the given name or ID. If there is no such template, the
+// error lists the names of the available templates.
+func findTeamTemplate(refs []workitemtracking.WorkItemTemplateReference, team, name string) (*workitemtracking.WorkItemTemplateReference, error) {
+	ref, ok := lo.Find(refs, func(r workitemtracking.WorkItemTemplateReference) bool {
+		return strings.EqualFold(lo.FromPtr(r.Name), name) || (r.Id != nil && strings.EqualFold(r.Id.String(), name))
+	})
+	if ok {
+		return &ref, nil
+	}
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("team %s has no work item templates", team)
+	}
+	names := lo.Map(refs, func(r workitemtracking.WorkItemTemplateReference, _ int) string {
+		return fmt.Sprintf("%s (%s)", lo.FromPtr(r.Name), lo.FromPtr(r.WorkItemTypeName))
+	})
+	sort.Strings(names)
+	return nil, fmt.Errorf("work item template %q not found in team %s; available templates:\n  %s", name, team, strings.Join(names, "\n  "))
+}
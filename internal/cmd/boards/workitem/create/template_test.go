@@ -0,0 +1,149 @@
+package create
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTemplate(t *testing.T) {
+	values, err := parseTemplate([]byte(`{
+		"id": 42,
+		"fields": {
+			"System.Id": 42,
+			"System.Rev": 3,
+			"System.State": "Active",
+			"System.Title": "Template",
+			"System.AssignedTo": {"displayName": "Jane Doe", "uniqueName": "jane@contoso.com"},
+			"Microsoft.VSTS.Common.Priority": 2,
+			"WEF_6CB513B6E70E43499D9FC94E5BBFB784_Kanban.Column": "Doing"
+		}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{
+		"System.Id":                      float64(42),
+		"System.Rev":                     float64(3),
+		"System.State":                   "Active",
+		"System.Title":                   "Template",
+		"System.AssignedTo":              "jane@contoso.com",
+		"Microsoft.VSTS.Common.Priority": float64(2),
+	}, values)
+
+	_, err = parseTemplate([]byte(`{"fields": [`))
+	assert.Error(t, err)
+}
+
+func TestRemoveReadOnlyFields(t *testing.T) {
+	// output of "work-item show --format json" of an active bug
+	values, err := parseTemplate([]byte(`{
+		"id": 42,
+		"rev": 7,
+		"fields": {
+			"System.Id": 42,
+			"System.AreaId": 12,
+			"System.AreaPath": "Fabrikam\\Web\\Login",
+			"System.TeamProject": "Fabrikam",
+			"System.NodeName": "Login",
+			"System.AreaLevel1": "Fabrikam",
+			"System.AreaLevel2": "Web",
+			"System.AreaLevel3": "Login",
+			"System.Rev": 7,
+			"System.AuthorizedDate": "2024-03-05T10:12:44.123Z",
+			"System.RevisedDate": "9999-01-01T00:00:00Z",
+			"System.IterationId": 31,
+			"System.IterationPath": "Fabrikam\\Sprint 12",
+			"System.IterationLevel1": "Fabrikam",
+			"System.IterationLevel2": "Sprint 12",
+			"System.WorkItemType": "Bug",
+			"System.State": "Active",
+			"System.Reason": "Approved",
+			"System.AssignedTo": {"displayName": "Jane Doe", "uniqueName": "jane@contoso.com", "id": "8c1f2b6e-0d4a-4b63-9a0e-3f1d2c5b7a90"},
+			"System.CreatedDate": "2024-03-01T08:00:00Z",
+			"System.CreatedBy": {"displayName": "John Smith", "uniqueName": "john@contoso.com"},
+			"System.ChangedDate": "2024-03-05T10:12:44.123Z",
+			"System.ChangedBy": {"displayName": "Jane Doe", "uniqueName": "jane@contoso.com"},
+			"System.AuthorizedAs": {"displayName": "Jane Doe", "uniqueName": "jane@contoso.com"},
+			"System.PersonId": 52117788,
+			"System.Watermark": 1045,
+			"System.CommentCount": 2,
+			"System.ExternalLinkCount": 1,
+			"System.HyperLinkCount": 0,
+			"System.RelatedLinkCount": 3,
+			"System.RemoteLinkCount": 0,
+			"System.AttachedFileCount": 1,
+			"System.Title": "Login fails with SSO",
+			"System.BoardColumn": "Doing",
+			"System.BoardColumnDone": false,
+			"Microsoft.VSTS.Common.StateChangeDate": "2024-03-02T09:30:00Z",
+			"Microsoft.VSTS.Common.ActivatedDate": "2024-03-02T09:30:00Z",
+			"Microsoft.VSTS.Common.ActivatedBy": {"displayName": "Jane Doe", "uniqueName": "jane@contoso.com"},
+			"Microsoft.VSTS.Common.Priority": 2,
+			"Microsoft.VSTS.Common.Severity": "2 - High",
+			"Microsoft.VSTS.Common.ValueArea": "Business",
+			"Microsoft.VSTS.TCM.ReproSteps": "<div>Sign in with SSO</div>",
+			"WEF_6CB513B6E70E43499D9FC94E5BBFB784_Kanban.Column": "Doing"
+		},
+		"url": "https://dev.azure.com/fabrikam/_apis/wit/workItems/42"
+	}`))
+	require.NoError(t, err)
+
+	writable := []string{
+		"System.AreaPath", "System.IterationPath", "System.AssignedTo", "System.Title", "System.State", "System.Reason",
+		"Microsoft.VSTS.Common.StateChangeDate", "Microsoft.VSTS.Common.ActivatedDate", "Microsoft.VSTS.Common.ActivatedBy",
+		"Microsoft.VSTS.Common.Priority", "Microsoft.VSTS.Common.Severity", "Microsoft.VSTS.Common.ValueArea",
+		"Microsoft.VSTS.TCM.ReproSteps",
+	}
+	readOnly := []string{
+		"System.Id", "System.AreaId", "System.TeamProject", "System.NodeName", "System.AreaLevel1", "System.AreaLevel2",
+		"System.AreaLevel3", "System.Rev", "System.AuthorizedDate", "System.RevisedDate", "System.IterationId",
+		"System.IterationLevel1", "System.IterationLevel2", "System.WorkItemType", "System.CreatedDate",
+		"System.CreatedBy", "System.ChangedDate", "System.ChangedBy", "System.AuthorizedAs", "System.PersonId",
+		"System.Watermark", "System.CommentCount", "System.ExternalLinkCount", "System.HyperLinkCount",
+		"System.RelatedLinkCount", "System.RemoteLinkCount", "System.AttachedFileCount",
+	}
+	var typeFields []workitemtracking.WorkItemTypeFieldWithReferences
+	var fields []workitemtracking.WorkItemField2
+	for _, name := range append(append([]string{}, writable...), readOnly...) {
+		typeFields = append(typeFields, workitemtracking.WorkItemTypeFieldWithReferences{ReferenceName: lo.ToPtr(name)})
+		fields = append(fields, workitemtracking.WorkItemField2{ReferenceName: lo.ToPtr(name), ReadOnly: lo.ToPtr(lo.Contains(readOnly, name))})
+	}
+
+	removeReadOnlyFields(values, typeFields, fields)
+	assert.Equal(t, map[string]any{
+		"System.AreaPath":                 "Fabrikam\\Web\\Login",
+		"System.IterationPath":            "Fabrikam\\Sprint 12",
+		"System.AssignedTo":               "jane@contoso.com",
+		"System.Title":                    "Login fails with SSO",
+		"Microsoft.VSTS.Common.Priority":  float64(2),
+		"Microsoft.VSTS.Common.Severity":  "2 - High",
+		"Microsoft.VSTS.Common.ValueArea": "Business",
+		"Microsoft.VSTS.TCM.ReproSteps":   "<div>Sign in with SSO</div>",
+	}, values)
+}
+
+func TestFindTeamTemplate(t *testing.T) {
+	id := uuid.MustParse("3b8e5c0a-8f5d-4c3e-9a4e-5b2f3c1d7e60")
+	refs := []workitemtracking.WorkItemTemplateReference{
+		{Id: &id, Name: lo.ToPtr("Triage bug"), WorkItemTypeName: lo.ToPtr("Bug")},
+		{Id: lo.ToPtr(uuid.New()), Name: lo.ToPtr("Spike"), WorkItemTypeName: lo.ToPtr("Task")},
+	}
+
+	ref, err := findTeamTemplate(refs, "Team A", "triage BUG")
+	require.NoError(t, err)
+	assert.Equal(t, "Triage bug", *ref.Name)
+
+	ref, err = findTeamTemplate(refs, "Team A", id.String())
+	require.NoError(t, err)
+	assert.Equal(t, "Triage bug", *ref.Name)
+
+	_, err = findTeamTemplate(refs, "Team A", "Feature")
+	require.Error(t, err)
+	assert.Equal(t, "work item template \"Feature\" not found in team Team A; available templates:\n  Spike (Task)\n  Triage bug (Bug)", err.Error())
+
+	_, err = findTeamTemplate(nil, "Team A", "Feature")
+	assert.EqualError(t, err, "team Team A has no work item templates")
+}
@@ -0,0 +1,152 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+type exportOptions struct {
+	shared.QueryOptions
+	scope  string
+	output string
+}
+
+func NewCmdExport(ctx util.CmdContext) *cobra.Command {
+	opts := &exportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export [organization/]project",
+		Short: "Export work items to a CSV file",
+		Long: heredoc.Doc(`
+			Export the work items of a project to a CSV file, most recently changed first.
+
+			The work items are selected with the same flags as the list command. The file has the
+			columns ID, Work Item Type, Title, State, Assigned To, Area Path, Iteration Path, Tags
+			and Description and can be imported again with the import command.
+		`),
+		Example: heredoc.Doc(`
+			# export all active bugs to bugs.csv
+			azdo boards work-item export myproject --type Bug --state Active --output bugs.csv
+
+			# write the work items of a sprint to standard output
+			azdo boards work-item export myorg/myproject --iteration "myproject\Sprint 12"
+		`),
+		Args: util.ExactArgs(1, "cannot export work items: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			if opts.Limit < 1 {
+				return util.FlagErrorf("invalid limit: %d", opts.Limit)
+			}
+			return runExport(ctx, opts)
+		},
+	}
+
+	shared.AddQueryFlags(cmd, &opts.QueryOptions, 1000)
+	cmd.Flags().StringVarP(&opts.output, "output", "o", "-", "File to write the work items to; \"-\" writes to standard output")
+
+	return cmd
+}
+
+func runExport(ctx util.CmdContext, opts *exportOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	fields := lo.Map(shared.CSVColumns, func(c shared.CSVColumn, _ int) string { return c.Field })
+
+	iostrms.StartProgressIndicator()
+	items, err := shared.QueryWorkItems(rctx, client, project, &opts.QueryOptions, fields)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return
+	}
+	if len(items) == 0 {
+		return util.NewNoResultsError("No work items found")
+	}
+
+	var out io.Writer = iostrms.Out
+	if opts.output != "-" {
+		f, err := os.Create(opts.output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+	if err := writeCSV(out, items); err != nil {
+		return fmt.Errorf("failed to write work items: %w", err)
+	}
+
+	if opts.output != "-" && iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Exported %s to %s\n", cs.SuccessIcon(), text.Pluralize(len(items), "work item"), opts.output)
+	}
+	return nil
+}
+
+func writeCSV(out io.Writer, items []workitemtracking.WorkItem) error {
+	w := csv.NewWriter(out)
+	header := lo.Map(shared.CSVColumns, func(c shared.CSVColumn, _ int) string { return c.Header })
+	if err := w.Write(header); err != nil {
+		return err
+	}
+	for i := range items {
+		wi := &items[i]
+		record := make([]string, 0, len(shared.CSVColumns))
+		for _, c := range shared.CSVColumns {
+			record = append(record, fieldValue(wi, c.Field))
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// fieldValue returns the value of the field as written to the CSV file. Identities are written
+// as "Display Name <user@example.com>", which is accepted when the work item is imported.
+func fieldValue(wi *workitemtracking.WorkItem, field string) string {
+	if field == shared.FieldID {
+		return strconv.Itoa(*wi.Id)
+	}
+	if wi.Fields != nil {
+		if m, ok := (*wi.Fields)[field].(map[string]any); ok {
+			name, _ := m["displayName"].(string)
+			if uniqueName, _ := m["uniqueName"].(string); uniqueName != "" {
+				return fmt.Sprintf("%s <%s>", name, uniqueName)
+			}
+		}
+	}
+	return shared.FieldString(wi, field)
+}
@@ -0,0 +1,275 @@
+package importcmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+type importOptions struct {
+	scope        string
+	file         string
+	workItemType string
+	dryRun       bool
+	format       string
+}
+
+// workItemRow is a work item read from a row of the CSV file.
+type workItemRow struct {
+	// Line is the line of the row in the CSV file
+	Line         int
+	WorkItemType string
+	// Fields maps the reference names of the fields to their values
+	Fields map[string]string
+}
+
+func NewCmdImport(ctx util.CmdContext) *cobra.Command {
+	opts := &importOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import [organization/]project <file>",
+		Short: "Create work items from a CSV file",
+		Long: heredoc.Doc(`
+			Create a work item for each row of a CSV file.
+
+			The first row of the file must contain the column headers. The standard columns written
+			by the export command are recognized; any other column must be the reference name of a
+			field, e.g. "Microsoft.VSTS.Common.Priority". The ID column is ignored, so an exported
+			file always creates new work items. Empty cells leave the field unset.
+
+			Rows without a "Work Item Type" use the type given by --type. With --dry-run the file
+			is only parsed and validated and no work items are created.
+		`),
+		Example: heredoc.Doc(`
+			# check a CSV file without creating work items
+			azdo boards work-item import myproject bugs.csv --dry-run
+
+			# create tasks from a file without a type column
+			azdo boards work-item import myorg/myproject tasks.csv --type Task
+		`),
+		Args: util.ExactArgs(2, "cannot import work items: project and file required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+			opts.file = args[1]
+
+			return runImport(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.workItemType, "type", "", "Type of the work items in rows without a \"Work Item Type\"")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Parse and validate the file without creating work items")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runImport(ctx util.CmdContext, opts *importOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	content, err := iostrms.ReadUserFile(opts.file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.file, err)
+	}
+	rows, err := parseCSV(bytes.NewReader(content), opts.workItemType)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", opts.file, err)
+	}
+	if len(rows) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No work items found in %s", opts.file))
+	}
+
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	types, err := client.GetWorkItemTypes(rctx, workitemtracking.GetWorkItemTypesArgs{
+		Project: &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get work item types of project %s: %w", project, err)
+	}
+	typeNames := lo.Map(lo.FromPtr(types), func(t workitemtracking.WorkItemType, _ int) string {
+		return lo.FromPtr(t.Name)
+	})
+	if err := validateTypes(rows, typeNames); err != nil {
+		return fmt.Errorf("invalid work items in %s: %w", opts.file, err)
+	}
+
+	if opts.dryRun {
+		iostrms.StopProgressIndicator()
+		if iostrms.IsStdoutTTY() {
+			cs := iostrms.ColorScheme()
+			fmt.Fprintf(iostrms.ErrOut, "%s %s would be created\n", cs.SuccessIcon(), text.Pluralize(len(rows), "work item"))
+		}
+		tp, err := ctx.Printer(opts.format)
+		if err != nil {
+			return err
+		}
+		tp.AddColumns("Line", "Type", "State", "Title")
+		for _, r := range rows {
+			tp.AddField(strconv.Itoa(r.Line), printer.WithTruncate(nil))
+			tp.AddField(r.WorkItemType)
+			tp.AddField(r.Fields[shared.FieldState])
+			tp.AddField(r.Fields[shared.FieldTitle])
+			tp.EndRow()
+		}
+		return tp.Render()
+	}
+
+	created := make([]*workitemtracking.WorkItem, 0, len(rows))
+	for _, r := range rows {
+		iostrms.StartProgressIndicatorWithLabel(fmt.Sprintf("Creating work item %d of %d", len(created)+1, len(rows)))
+		document := make([]webapi.JsonPatchOperation, 0, len(r.Fields))
+		fieldNames := lo.Keys(r.Fields)
+		sort.Strings(fieldNames)
+		for _, field := range fieldNames {
+			document = append(document, shared.AddFieldOperation(field, r.Fields[field]))
+		}
+		wi, err := client.CreateWorkItem(rctx, workitemtracking.CreateWorkItemArgs{
+			Document: &document,
+			Project:  &project,
+			Type:     &r.WorkItemType,
+		})
+		if err != nil {
+			iostrms.StopProgressIndicator()
+			if len(created) > 0 {
+				fmt.Fprintf(iostrms.ErrOut, "Created %s before the error occurred\n", text.Pluralize(len(created), "work item"))
+			}
+			return fmt.Errorf("failed to create work item from line %d: %w", r.Line, err)
+		}
+		created = append(created, wi)
+	}
+	iostrms.StopProgressIndicator()
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Type", "State", "Title")
+	for _, wi := range created {
+		tp.AddField(strconv.Itoa(*wi.Id), printer.WithTruncate(nil))
+		tp.AddField(shared.FieldString(wi, shared.FieldWorkItemType))
+		tp.AddField(shared.FieldString(wi, shared.FieldState))
+		tp.AddField(shared.FieldString(wi, shared.FieldTitle))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// parseCSV reads the work items from the CSV content. Rows without a work item type get defaultType.
+func parseCSV(r io.Reader, defaultType string) ([]workItemRow, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, len(header))
+	for i, h := range header {
+		h = strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))
+		if c, ok := lo.Find(shared.CSVColumns, func(c shared.CSVColumn) bool {
+			return strings.EqualFold(c.Header, h) || strings.EqualFold(c.Field, h)
+		}); ok {
+			fields[i] = c.Field
+			continue
+		}
+		if !strings.Contains(h, ".") {
+			return nil, fmt.Errorf("unknown column %q; use the reference name of the field", h)
+		}
+		fields[i] = h
+	}
+	if dups := lo.FindDuplicates(fields); len(dups) > 0 {
+		return nil, fmt.Errorf("duplicate column for field %s", dups[0])
+	}
+
+	var rows []workItemRow
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := cr.FieldPos(0)
+		row := workItemRow{
+			Line:         line,
+			WorkItemType: defaultType,
+			Fields:       map[string]string{},
+		}
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if value == "" || fields[i] == shared.FieldID {
+				continue
+			}
+			if fields[i] == shared.FieldWorkItemType {
+				row.WorkItemType = value
+				continue
+			}
+			row.Fields[fields[i]] = value
+		}
+		if row.WorkItemType == "" && len(row.Fields) == 0 {
+			continue
+		}
+		if row.WorkItemType == "" {
+			return nil, fmt.Errorf("line %d: work item type is required; add a \"Work Item Type\" column or use --type", line)
+		}
+		if row.Fields[shared.FieldTitle] == "" {
+			return nil, fmt.Errorf("line %d: title is required", line)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// validateTypes checks that the types of all rows are defined in the project. The names of the
+// types are corrected to the case used by the project.
+func validateTypes(rows []workItemRow, typeNames []string) error {
+	for i := range rows {
+		name, ok := lo.Find(typeNames, func(n string) bool {
+			return strings.EqualFold(n, rows[i].WorkItemType)
+		})
+		if !ok {
+			return fmt.Errorf("line %d: unknown work item type %q", rows[i].Line, rows[i].WorkItemType)
+		}
+		rows[i].WorkItemType = name
+	}
+	return nil
+}
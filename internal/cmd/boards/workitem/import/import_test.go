@@ -0,0 +1,96 @@
+package importcmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		defaultType string
+		want        []workItemRow
+		wantErr     string
+	}{
+		{
+			name: "exported file",
+			content: "ID,Work Item Type,Title,State,Assigned To,Tags\n" +
+				"12,Bug,Login fails,Active,Jane Doe <jane@example.com>,\n" +
+				"\n" +
+				"13,Task,\"Write tests, docs\",,,ui\n",
+			want: []workItemRow{
+				{
+					Line:         2,
+					WorkItemType: "Bug",
+					Fields: map[string]string{
+						"System.Title":      "Login fails",
+						"System.State":      "Active",
+						"System.AssignedTo": "Jane Doe <jane@example.com>",
+					},
+				},
+				{
+					Line:         4,
+					WorkItemType: "Task",
+					Fields: map[string]string{
+						"System.Title": "Write tests, docs",
+						"System.Tags":  "ui",
+					},
+				},
+			},
+		},
+		{
+			name:        "default type and reference names",
+			content:     "title,Microsoft.VSTS.Common.Priority\nFix build,1\n",
+			defaultType: "Task",
+			want: []workItemRow{
+				{
+					Line:         2,
+					WorkItemType: "Task",
+					Fields: map[string]string{
+						"System.Title":                   "Fix build",
+						"Microsoft.VSTS.Common.Priority": "1",
+					},
+				},
+			},
+		},
+		{
+			name:    "unknown column",
+			content: "Title,Priority\nFix build,1\n",
+			wantErr: `unknown column "Priority"; use the reference name of the field`,
+		},
+		{
+			name:    "duplicate column",
+			content: "Title,System.Title\nFix build,Fix build\n",
+			wantErr: "duplicate column for field System.Title",
+		},
+		{
+			name:    "missing type",
+			content: "Title\nFix build\n",
+			wantErr: `line 2: work item type is required; add a "Work Item Type" column or use --type`,
+		},
+		{
+			name:    "missing title",
+			content: "Work Item Type,State\nBug,New\n",
+			wantErr: "line 2: title is required",
+		},
+		{
+			name: "empty file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCSV(strings.NewReader(tt.content), tt.defaultType)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,306 @@
+package list
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+var listFields = []string{
+	shared.FieldWorkItemType,
+	shared.FieldState,
+	shared.FieldTitle,
+	shared.FieldAssignedTo,
+}
+
+// dateLayouts are the accepted layouts of --since and --until
+var dateLayouts = []string{time.RFC3339, "2006-01-02T15:04", "2006-01-02"}
+
+type listOptions struct {
+	shared.QueryOptions
+	scope         string
+	format        string
+	watch         bool
+	interval      time.Duration
+	since         string
+	until         string
+	changedInLast string
+	saveAs        string
+	shared        bool
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List work items",
+		Long: heredoc.Doc(`
+			List the work items of a project, most recently changed first.
+
+			Use --since and --until to only list work items which were last changed in a date range.
+			Dates are given as "2006-01-02", "2006-01-02T15:04" or in RFC 3339 format and are
+			interpreted in local time unless they have a time zone. A date without a time is
+			inclusive, so --until 2024-03-31 includes all changes on March 31. --changed-in-last is a
+			shorthand for --since relative to now and accepts durations like "7d", "2w" or "12h".
+
+			With --watch the list is refreshed every --interval and work items which were added,
+			changed or removed since the previous refresh are highlighted. Press "q" to stop watching.
+
+			With --save-as the query is saved with the given name in "My Queries", or in "Shared
+			Queries" with --shared, before the work items are listed. The name may contain folders
+			separated by "/", which must exist. Date filters cannot be saved.
+		`),
+		Example: heredoc.Doc(`
+			# list active bugs
+			azdo boards work-item list myproject --type Bug --state Active
+
+			# list the work items changed in the last week
+			azdo boards work-item list myproject --changed-in-last 7d
+
+			# list the work items changed in the first quarter
+			azdo boards work-item list myproject --since 2024-01-01 --until 2024-03-31
+
+			# watch the work items of the current sprint
+			azdo boards work-item list myorg/myproject --iteration "myproject\Sprint 12" --watch --interval 1m
+
+			# save the query for active bugs as shared query
+			azdo boards work-item list myproject --type Bug --state Active --save-as "Active bugs" --shared
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list work items: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			if err := validateListOptions(opts, time.Now()); err != nil {
+				return err
+			}
+			if opts.watch {
+				return runWatch(ctx, opts)
+			}
+			if opts.saveAs != "" {
+				if err := runSave(ctx, opts); err != nil {
+					return err
+				}
+			}
+			return runList(ctx, opts)
+		},
+	}
+
+	shared.AddQueryFlags(cmd, &opts.QueryOptions, 50)
+	util.AddOutputFormatFlag(cmd, &opts.format)
+	cmd.Flags().StringVar(&opts.since, "since", "", "Only list work items changed on or after this date")
+	cmd.Flags().StringVar(&opts.until, "until", "", "Only list work items changed on or before this date")
+	cmd.Flags().StringVar(&opts.changedInLast, "changed-in-last", "", "Only list work items changed within this duration, e.g. \"7d\"")
+	cmd.Flags().BoolVarP(&opts.watch, "watch", "w", false, "Refresh the list periodically and highlight changes")
+	cmd.Flags().DurationVar(&opts.interval, "interval", 30*time.Second, "Refresh interval of --watch")
+	cmd.Flags().StringVar(&opts.saveAs, "save-as", "", "Save the query with this name")
+	cmd.Flags().BoolVar(&opts.shared, "shared", false, "Save the query in \"Shared Queries\" instead of \"My Queries\"")
+	cmd.MarkFlagsMutuallyExclusive("save-as", "watch")
+
+	return cmd
+}
+
+// validateListOptions validates the options and resolves the date filters into the query options.
+// Relative durations are resolved against now.
+func validateListOptions(opts *listOptions, now time.Time) error {
+	if opts.Limit < 1 {
+		return util.FlagErrorf("invalid limit: %d", opts.Limit)
+	}
+	if opts.watch {
+		if opts.format != "table" {
+			return util.FlagErrorf("--watch is only supported with table output")
+		}
+		if opts.interval < time.Second {
+			return util.FlagErrorf("--interval must be at least 1s")
+		}
+	}
+	if err := util.MutuallyExclusive("specify only one of --since or --changed-in-last", opts.since != "", opts.changedInLast != ""); err != nil {
+		return err
+	}
+	if opts.saveAs != "" && (opts.since != "" || opts.until != "" || opts.changedInLast != "") {
+		return util.FlagErrorf("--save-as does not support --since, --until or --changed-in-last")
+	}
+	if opts.shared && opts.saveAs == "" {
+		return util.FlagErrorf("--shared requires --save-as")
+	}
+
+	if opts.since != "" {
+		t, _, err := parseDate(opts.since)
+		if err != nil {
+			return util.FlagErrorf("invalid value for --since: %w", err)
+		}
+		opts.ChangedSince = &t
+	}
+	if opts.changedInLast != "" {
+		d, err := util.ParseDuration(opts.changedInLast)
+		if err != nil {
+			return util.FlagErrorf("invalid value for --changed-in-last: %w", err)
+		}
+		if d <= 0 {
+			return util.FlagErrorf("invalid value for --changed-in-last: duration must be positive")
+		}
+		t := now.Add(-d)
+		opts.ChangedSince = &t
+	}
+	if opts.until != "" {
+		t, dateOnly, err := parseDate(opts.until)
+		if err != nil {
+			return util.FlagErrorf("invalid value for --until: %w", err)
+		}
+		if dateOnly {
+			t = t.AddDate(0, 0, 1).Add(-time.Second)
+		}
+		opts.ChangedUntil = &t
+	}
+	if opts.ChangedSince != nil && opts.ChangedUntil != nil && opts.ChangedUntil.Before(*opts.ChangedSince) {
+		return util.FlagErrorf("--until must not be before --since")
+	}
+	return nil
+}
+
+// parseDate parses a date in one of the dateLayouts and reports whether the date has no time component.
+func parseDate(s string) (t time.Time, dateOnly bool, err error) {
+	for _, layout := range dateLayouts {
+		if t, err = time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, layout == "2006-01-02", nil
+		}
+	}
+	return time.Time{}, false, fmt.Errorf("%q is not a date, expected e.g. \"2006-01-02\"", s)
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	items, err := queryWorkItems(ctx, opts)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return
+	}
+	if len(items) == 0 {
+		return util.NewNoResultsError("No work items found")
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	addWorkItemRows(tp, items, nil)
+	return tp.Render()
+}
+
+// addWorkItemRows adds the work items to the printer. If colorFunc is not nil, it is called to
+// determine the color of each row.
+func addWorkItemRows(tp printer.Printer, items []workitemtracking.WorkItem, colorFunc func(wi *workitemtracking.WorkItem) func(string) string) {
+	tp.AddColumns("ID", "Type", "State", "Title", "Assigned To")
+	for i := range items {
+		wi := &items[i]
+		var fieldOpts []printer.FieldOption
+		if colorFunc != nil {
+			if c := colorFunc(wi); c != nil {
+				fieldOpts = append(fieldOpts, printer.WithColor(c))
+			}
+		}
+		tp.AddField(strconv.Itoa(*wi.Id), append(fieldOpts, printer.WithTruncate(nil))...)
+		tp.AddField(shared.FieldString(wi, shared.FieldWorkItemType), fieldOpts...)
+		tp.AddField(shared.FieldString(wi, shared.FieldState), fieldOpts...)
+		tp.AddField(shared.FieldString(wi, shared.FieldTitle), fieldOpts...)
+		tp.AddField(shared.FieldString(wi, shared.FieldAssignedTo), fieldOpts...)
+		tp.EndRow()
+	}
+}
+
+// runSave saves the query of the options as a query of the authenticated user or as shared query.
+func runSave(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	parent, name := savedQueryPath(opts.saveAs, opts.shared)
+	if name == "" {
+		return util.FlagErrorf("invalid query name: %q", opts.saveAs)
+	}
+
+	iostrms.StartProgressIndicator()
+	q, err := client.CreateQuery(rctx, workitemtracking.CreateQueryArgs{
+		PostedQuery: &workitemtracking.QueryHierarchyItem{
+			Name: &name,
+			Wiql: lo.ToPtr(shared.BuildQuery(&opts.QueryOptions)),
+		},
+		Project: &project,
+		Query:   &parent,
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to save query %s: %w", opts.saveAs, err)
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Saved query %s\n", cs.SuccessIcon(), cs.Bold(lo.FromPtr(q.Path)))
+	}
+	return
+}
+
+// savedQueryPath splits the name of a query to save into the path of its parent folder and its name.
+func savedQueryPath(saveAs string, isShared bool) (parent, name string) {
+	parent = lo.Ternary(isShared, "Shared Queries", "My Queries")
+	saveAs = strings.Trim(saveAs, "/")
+	if idx := strings.LastIndex(saveAs, "/"); idx >= 0 {
+		return parent + "/" + saveAs[:idx], strings.TrimSpace(saveAs[idx+1:])
+	}
+	return parent, strings.TrimSpace(saveAs)
+}
+
+func queryWorkItems(ctx util.CmdContext, opts *listOptions) ([]workitemtracking.WorkItem, error) {
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return nil, err
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.QueryWorkItems(rctx, client, project, &opts.QueryOptions, listFields)
+}
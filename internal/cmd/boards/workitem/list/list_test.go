@@ -0,0 +1,102 @@
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateListOptions(t *testing.T) {
+	now := time.Date(2024, 4, 10, 12, 0, 0, 0, time.UTC)
+	local := func(s string) time.Time {
+		t, err := time.ParseInLocation("2006-01-02T15:04:05", s, time.Local)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+
+	tests := []struct {
+		name      string
+		opts      listOptions
+		wantSince *time.Time
+		wantUntil *time.Time
+		wantErr   string
+	}{
+		{
+			name: "no date filters",
+		},
+		{
+			name:      "date range",
+			opts:      listOptions{since: "2024-01-01", until: "2024-03-31"},
+			wantSince: ptr(local("2024-01-01T00:00:00")),
+			wantUntil: ptr(local("2024-03-31T23:59:59")),
+		},
+		{
+			name:      "rfc3339",
+			opts:      listOptions{since: "2024-01-01T08:30:00Z"},
+			wantSince: ptr(time.Date(2024, 1, 1, 8, 30, 0, 0, time.UTC)),
+		},
+		{
+			name:      "changed in last",
+			opts:      listOptions{changedInLast: "7d"},
+			wantSince: ptr(now.Add(-7 * 24 * time.Hour)),
+		},
+		{
+			name:    "since and changed in last",
+			opts:    listOptions{since: "2024-01-01", changedInLast: "7d"},
+			wantErr: "specify only one of --since or --changed-in-last",
+		},
+		{
+			name:    "invalid date",
+			opts:    listOptions{since: "01/01/2024"},
+			wantErr: `invalid value for --since: "01/01/2024" is not a date, expected e.g. "2006-01-02"`,
+		},
+		{
+			name:    "until before since",
+			opts:    listOptions{since: "2024-02-01", until: "2024-01-01"},
+			wantErr: "--until must not be before --since",
+		},
+		{
+			name:    "save with date filter",
+			opts:    listOptions{changedInLast: "7d", saveAs: "Recent"},
+			wantErr: "--save-as does not support --since, --until or --changed-in-last",
+		},
+		{
+			name:    "shared without save",
+			opts:    listOptions{shared: true},
+			wantErr: "--shared requires --save-as",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.Limit = 50
+			err := validateListOptions(&opts, now)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSince, opts.ChangedSince)
+			assert.Equal(t, tt.wantUntil, opts.ChangedUntil)
+		})
+	}
+}
+
+func TestSavedQueryPath(t *testing.T) {
+	parent, name := savedQueryPath("Active bugs", false)
+	assert.Equal(t, "My Queries", parent)
+	assert.Equal(t, "Active bugs", name)
+
+	parent, name = savedQueryPath("Team A/Triage/Active bugs", true)
+	assert.Equal(t, "Shared Queries/Team A/Triage", parent)
+	assert.Equal(t, "Active bugs", name)
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
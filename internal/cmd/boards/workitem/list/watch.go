@@ -0,0 +1,217 @@
+package list
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"golang.org/x/term"
+)
+
+type changeKind int
+
+const (
+	changeNone changeKind = iota
+	changeAdded
+	changeChanged
+	changeRemoved
+)
+
+// diffWorkItems compares the current work items with the previous ones by ID and revision. It returns
+// the kind of change of each current work item and the work items which are no longer part of the result.
+func diffWorkItems(previous, current []workitemtracking.WorkItem) (map[int]changeKind, []workitemtracking.WorkItem) {
+	changes := map[int]changeKind{}
+	if previous == nil {
+		return changes, nil
+	}
+
+	revisions := make(map[int]int, len(previous))
+	for _, wi := range previous {
+		revisions[*wi.Id] = revision(&wi)
+	}
+	for _, wi := range current {
+		rev, ok := revisions[*wi.Id]
+		switch {
+		case !ok:
+			changes[*wi.Id] = changeAdded
+		case rev != revision(&wi):
+			changes[*wi.Id] = changeChanged
+		}
+		delete(revisions, *wi.Id)
+	}
+
+	var removed []workitemtracking.WorkItem
+	for _, wi := range previous {
+		if _, ok := revisions[*wi.Id]; ok {
+			changes[*wi.Id] = changeRemoved
+			removed = append(removed, wi)
+		}
+	}
+	return changes, removed
+}
+
+func revision(wi *workitemtracking.WorkItem) int {
+	if wi.Rev == nil {
+		return 0
+	}
+	return *wi.Rev
+}
+
+func runWatch(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	if !iostrms.IsStdoutTTY() {
+		return util.FlagErrorf("--watch requires a terminal")
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	quit, restore := listenForQuit(iostrms)
+	defer restore()
+
+	iostrms.SetAlternateScreenBufferEnabled(true)
+	iostrms.StartAlternateScreenBuffer()
+	defer iostrms.StopAlternateScreenBuffer()
+
+	// the terminal may be in raw mode which doesn't translate line feeds
+	out := &crlfWriter{w: iostrms.Out}
+	cs := iostrms.ColorScheme()
+
+	ticker := time.NewTicker(opts.interval)
+	defer ticker.Stop()
+
+	var previous []workitemtracking.WorkItem
+	for {
+		items, err := queryWorkItems(ctx, opts)
+		if err != nil {
+			return err
+		}
+
+		changes, removed := diffWorkItems(previous, items)
+		previous = items
+
+		buf := &bytes.Buffer{}
+		fmt.Fprintln(buf, cs.Gray(fmt.Sprintf("Every %s, last refreshed %s. Press q to quit.", opts.interval, time.Now().Format(time.TimeOnly))))
+		fmt.Fprintln(buf)
+		if len(items) == 0 && len(removed) == 0 {
+			fmt.Fprintln(buf, "No work items found")
+		} else {
+			tp, err := printer.NewTablePrinter(buf, true, iostrms.TerminalWidth())
+			if err != nil {
+				return err
+			}
+			addWorkItemRows(tp, append(items, removed...), func(wi *workitemtracking.WorkItem) func(string) string {
+				switch changes[*wi.Id] {
+				case changeAdded:
+					return cs.Green
+				case changeChanged:
+					return cs.Yellow
+				case changeRemoved:
+					return cs.Red
+				}
+				return nil
+			})
+			if err := tp.Render(); err != nil {
+				return err
+			}
+		}
+
+		iostrms.RefreshScreen()
+		if _, err := out.Write(buf.Bytes()); err != nil {
+			return err
+		}
+
+		select {
+		case <-quit:
+			return nil
+		case <-rctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// listenForQuit returns a channel which is closed when the user presses "q" or Ctrl+C. If stdin is a
+// terminal it is switched to raw mode so that key presses are read immediately. The returned function
+// restores the original terminal state.
+func listenForQuit(iostrms *iostreams.IOStreams) (<-chan struct{}, func()) {
+	quit := make(chan struct{})
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+
+	var once sync.Once
+	closeQuit := func() {
+		once.Do(func() { close(quit) })
+	}
+
+	restore := func() {
+		signal.Stop(interrupt)
+	}
+
+	if iostrms.IsStdinTTY() {
+		fd := int(iostrms.In.Fd())
+		if state, err := term.MakeRaw(fd); err == nil {
+			restore = func() {
+				signal.Stop(interrupt)
+				_ = term.Restore(fd, state)
+			}
+			keys := make(chan byte)
+			go func() {
+				b := make([]byte, 1)
+				for {
+					if _, err := iostrms.In.Read(b); err != nil {
+						return
+					}
+					keys <- b[0]
+				}
+			}()
+			go func() {
+				for {
+					select {
+					case k := <-keys:
+						// 3 is Ctrl+C which isn't turned into a signal in raw mode
+						if k == 'q' || k == 'Q' || k == 3 {
+							closeQuit()
+							return
+						}
+					case <-interrupt:
+						closeQuit()
+						return
+					}
+				}
+			}()
+			return quit, restore
+		}
+	}
+
+	go func() {
+		<-interrupt
+		closeQuit()
+	}()
+	return quit, restore
+}
+
+// crlfWriter translates line feeds to carriage return and line feed pairs.
+type crlfWriter struct {
+	w io.Writer
+}
+
+func (c *crlfWriter) Write(p []byte) (int, error) {
+	_, err := c.w.Write(bytes.ReplaceAll(p, []byte("\n"), []byte("\r\n")))
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
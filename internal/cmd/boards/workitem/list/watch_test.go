@@ -0,0 +1,59 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func workItem(id, rev int) workitemtracking.WorkItem {
+	return workitemtracking.WorkItem{
+		Id:  lo.ToPtr(id),
+		Rev: lo.ToPtr(rev),
+	}
+}
+
+func TestDiffWorkItems(t *testing.T) {
+	tests := []struct {
+		name        string
+		previous    []workitemtracking.WorkItem
+		current     []workitemtracking.WorkItem
+		wantChanges map[int]changeKind
+		wantRemoved []int
+	}{
+		{
+			name:        "first refresh has no changes",
+			current:     []workitemtracking.WorkItem{workItem(1, 1), workItem(2, 1)},
+			wantChanges: map[int]changeKind{},
+		},
+		{
+			name:        "unchanged",
+			previous:    []workitemtracking.WorkItem{workItem(1, 1)},
+			current:     []workitemtracking.WorkItem{workItem(1, 1)},
+			wantChanges: map[int]changeKind{},
+		},
+		{
+			name:     "added, changed and removed",
+			previous: []workitemtracking.WorkItem{workItem(1, 1), workItem(2, 3), workItem(3, 1)},
+			current:  []workitemtracking.WorkItem{workItem(2, 4), workItem(3, 1), workItem(4, 1)},
+			wantChanges: map[int]changeKind{
+				1: changeRemoved,
+				2: changeChanged,
+				4: changeAdded,
+			},
+			wantRemoved: []int{1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, removed := diffWorkItems(tt.previous, tt.current)
+			assert.Equal(t, tt.wantChanges, changes)
+			assert.ElementsMatch(t, tt.wantRemoved, lo.Map(removed, func(wi workitemtracking.WorkItem, _ int) int {
+				return *wi.Id
+			}))
+		})
+	}
+}
@@ -0,0 +1,245 @@
+package move
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// maxTreeDepth is the depth of the area and iteration trees fetched to validate the target path
+const maxTreeDepth = 50
+
+// moveResult is the outcome of moving a single work item
+type moveResult struct {
+	id      int
+	from    string
+	updated *workitemtracking.WorkItem
+	err     error
+}
+
+type moveOptions struct {
+	workItemIDs []int
+	scope       string
+	iteration   string
+	area        string
+	comment     string
+	dryRun      bool
+}
+
+func NewCmdMove(ctx util.CmdContext) *cobra.Command {
+	opts := &moveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "move [<id>] [organization/]project",
+		Short: "Move work items to another iteration or area",
+		Long: heredoc.Doc(`
+			Move one or more work items to another iteration or area.
+
+			The target path is checked against the iteration or area tree of the project before any
+			work item is changed. The path may be given with or without the project name, e.g.
+			"myproject\Sprint 12" or "Sprint 12". Use --id to move several work items at once; work items
+			which cannot be moved are reported at the end and do not stop the others.
+		`),
+		Example: heredoc.Doc(`
+			# move work item 42 to sprint 12
+			azdo boards work-item move 42 myproject --to-iteration "Sprint 12"
+
+			# move several work items to another area and leave a comment
+			azdo boards work-item move myorg/myproject --id 42 --id 43 --to-area "myproject\Team A" --comment "Owned by team A"
+
+			# show which work items would be moved without changing them
+			azdo boards work-item move 42 myproject --to-iteration "Sprint 12" --dry-run
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[len(args)-1]
+			if len(args) == 2 {
+				id, err := shared.ParseWorkItemID(args[0])
+				if err != nil {
+					return err
+				}
+				opts.workItemIDs = append(opts.workItemIDs, id)
+			}
+			opts.workItemIDs = lo.Uniq(opts.workItemIDs)
+
+			if len(opts.workItemIDs) == 0 {
+				return util.FlagErrorf("work item ID required; pass it as argument or with --id")
+			}
+			for _, id := range opts.workItemIDs {
+				if id < 1 {
+					return util.FlagErrorf("invalid work item ID: %d", id)
+				}
+			}
+			if err := util.MutuallyExclusive("specify only one of --to-iteration or --to-area", opts.iteration != "", opts.area != ""); err != nil {
+				return err
+			}
+			if opts.iteration == "" && opts.area == "" {
+				return util.FlagErrorf("--to-iteration or --to-area required")
+			}
+
+			return runMove(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntSliceVar(&opts.workItemIDs, "id", nil, "ID of a work item to move; can be repeated")
+	cmd.Flags().StringVar(&opts.iteration, "to-iteration", "", "Iteration path to move the work items to")
+	cmd.Flags().StringVar(&opts.area, "to-area", "", "Area path to move the work items to")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment to add to the work items")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be moved without changing the work items")
+
+	return cmd
+}
+
+func runMove(ctx util.CmdContext, opts *moveOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	field, group, target := shared.FieldIterationPath, workitemtracking.TreeStructureGroupValues.Iterations, opts.iteration
+	if opts.area != "" {
+		field, group, target = shared.FieldAreaPath, workitemtracking.TreeStructureGroupValues.Areas, opts.area
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	path, err := resolvePath(rctx, client, project, group, target)
+	if err != nil {
+		return
+	}
+
+	results, err := moveWorkItems(rctx, client, project, opts.workItemIDs, field, path, opts.comment, opts.dryRun, iostrms.Out)
+	if err != nil {
+		return
+	}
+	iostrms.StopProgressIndicator()
+
+	failed := lo.Filter(results, func(r moveResult, _ int) bool { return r.err != nil })
+	if moved := lo.Filter(results, func(r moveResult, _ int) bool { return r.updated != nil }); len(moved) > 0 {
+		tp, err := ctx.Printer("table")
+		if err != nil {
+			return err
+		}
+		tp.AddColumns("ID", "Title", "From", "To")
+		for _, r := range moved {
+			tp.AddField(strconv.Itoa(r.id), printer.WithTruncate(nil))
+			tp.AddField(shared.FieldString(r.updated, shared.FieldTitle))
+			tp.AddField(r.from)
+			tp.AddField(shared.FieldString(r.updated, field))
+			tp.EndRow()
+		}
+		if err := tp.Render(); err != nil {
+			return err
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	fmt.Fprintf(iostrms.ErrOut, "%s Failed to move %s:\n", iostrms.ColorScheme().FailureIcon(), text.Pluralize(len(failed), "work item"))
+	for _, r := range failed {
+		fmt.Fprintf(iostrms.ErrOut, "  %d: %s\n", r.id, r.err)
+	}
+	return util.ErrSilent
+}
+
+// moveWorkItems sets the field of the work items to path and adds the comment, if any. The work
+// items are fetched in batches; a failed update is recorded in the result of the work item and
+// does not stop the remaining ones. With dryRun the moves are only printed to out.
+func moveWorkItems(ctx context.Context, client workitemtracking.Client, project string, ids []int, field, path, comment string, dryRun bool, out io.Writer) ([]moveResult, error) {
+	items, err := shared.GetWorkItems(ctx, client, project, ids, []string{shared.FieldTitle, field})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]moveResult, 0, len(items))
+	for i := range items {
+		r := moveResult{id: *items[i].Id, from: shared.FieldString(&items[i], field)}
+		r.err = util.DryRunWrap(dryRun, out, fmt.Sprintf("move work item %d from %s to %s", r.id, r.from, path), func() (err error) {
+			r.updated, err = client.UpdateWorkItem(ctx, workitemtracking.UpdateWorkItemArgs{
+				Id:      &r.id,
+				Project: &project,
+				Document: &[]webapi.JsonPatchOperation{
+					shared.AddFieldOperation(field, path),
+				},
+			})
+			if err != nil {
+				return
+			}
+			if comment != "" {
+				return shared.AddComment(ctx, client, project, r.id, comment)
+			}
+			return
+		})
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// resolvePath looks up the path in the iteration or area tree of the project and returns it as
+// used in work item fields, i.e. starting with the project name.
+func resolvePath(ctx context.Context, client workitemtracking.Client, project string, group workitemtracking.TreeStructureGroup, path string) (string, error) {
+	root, err := client.GetClassificationNode(ctx, workitemtracking.GetClassificationNodeArgs{
+		Project:        &project,
+		StructureGroup: &group,
+		Depth:          lo.ToPtr(maxTreeDepth),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s of project %s: %w", group, project, err)
+	}
+
+	segments := splitPath(path)
+	if len(segments) > 0 && strings.EqualFold(segments[0], lo.FromPtr(root.Name)) {
+		segments = segments[1:]
+	}
+	node := root
+	resolved := []string{lo.FromPtr(root.Name)}
+	for _, s := range segments {
+		child, ok := lo.Find(lo.FromPtr(node.Children), func(c workitemtracking.WorkItemClassificationNode) bool {
+			return strings.EqualFold(lo.FromPtr(c.Name), s)
+		})
+		if !ok {
+			return "", util.FlagErrorf("path %q does not exist in the %s of project %s", path, group, project)
+		}
+		node = &child
+		resolved = append(resolved, lo.FromPtr(child.Name))
+	}
+	return strings.Join(resolved, `\`), nil
+}
+
+func splitPath(path string) []string {
+	return lo.Filter(strings.FieldsFunc(path, func(r rune) bool {
+		return r == '\\' || r == '/'
+	}), func(s string, _ int) bool {
+		return strings.TrimSpace(s) != ""
+	})
+}
@@ -0,0 +1,111 @@
+package move
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+)
+
+type fakeClient struct {
+	workitemtracking.Client
+	root    *workitemtracking.WorkItemClassificationNode
+	batches [][]int
+	updated []int
+	failID  int
+}
+
+func (c *fakeClient) GetWorkItemsBatch(_ context.Context, args workitemtracking.GetWorkItemsBatchArgs) (*[]workitemtracking.WorkItem, error) {
+	ids := *args.WorkItemGetRequest.Ids
+	c.batches = append(c.batches, ids)
+	items := lo.Map(ids, func(id int, _ int) workitemtracking.WorkItem {
+		return workitemtracking.WorkItem{Id: lo.ToPtr(id), Fields: &map[string]any{shared.FieldIterationPath: `MyProject\Sprint 1`}}
+	})
+	return &items, nil
+}
+
+func (c *fakeClient) UpdateWorkItem(_ context.Context, args workitemtracking.UpdateWorkItemArgs) (*workitemtracking.WorkItem, error) {
+	if *args.Id == c.failID {
+		return nil, errors.New("work item is locked")
+	}
+	c.updated = append(c.updated, *args.Id)
+	return &workitemtracking.WorkItem{Id: args.Id, Fields: &map[string]any{shared.FieldIterationPath: (*args.Document)[0].Value}}, nil
+}
+
+func (c *fakeClient) GetClassificationNode(context.Context, workitemtracking.GetClassificationNodeArgs) (*workitemtracking.WorkItemClassificationNode, error) {
+	return c.root, nil
+}
+
+func node(name string, children ...workitemtracking.WorkItemClassificationNode) workitemtracking.WorkItemClassificationNode {
+	n := workitemtracking.WorkItemClassificationNode{Name: lo.ToPtr(name)}
+	if len(children) > 0 {
+		n.Children = &children
+	}
+	return n
+}
+
+func TestResolvePath(t *testing.T) {
+	root := node("MyProject", node("Team A", node("Backend")), node("Team B"))
+	client := &fakeClient{root: &root}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr string
+	}{
+		{name: "with project", path: `MyProject\Team A\Backend`, want: `MyProject\Team A\Backend`},
+		{name: "without project", path: "team a/backend", want: `MyProject\Team A\Backend`},
+		{name: "root", path: "myproject", want: "MyProject"},
+		{name: "unknown", path: `MyProject\Team C`, wantErr: `path "MyProject\\Team C" does not exist in the areas of project MyProject`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolvePath(context.Background(), client, "MyProject", workitemtracking.TreeStructureGroupValues.Areas, tt.path)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMoveWorkItems(t *testing.T) {
+	ids := lo.RangeFrom(1, 201)
+
+	t.Run("batches and continues after a failure", func(t *testing.T) {
+		client := &fakeClient{failID: 7}
+		results, err := moveWorkItems(context.Background(), client, "MyProject", ids, shared.FieldIterationPath, `MyProject\Sprint 2`, "", false, &bytes.Buffer{})
+		require.NoError(t, err)
+
+		require.Len(t, client.batches, 2)
+		assert.Len(t, client.batches[0], 200)
+		assert.Equal(t, []int{201}, client.batches[1])
+		assert.Len(t, client.updated, 200)
+		require.Len(t, results, 201)
+		assert.EqualError(t, results[6].err, "work item is locked")
+		assert.Nil(t, results[6].updated)
+		assert.Equal(t, `MyProject\Sprint 1`, results[200].from)
+		assert.Equal(t, `MyProject\Sprint 2`, shared.FieldString(results[200].updated, shared.FieldIterationPath))
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		client := &fakeClient{}
+		out := &bytes.Buffer{}
+		results, err := moveWorkItems(context.Background(), client, "MyProject", []int{42}, shared.FieldIterationPath, `MyProject\Sprint 2`, "", true, out)
+		require.NoError(t, err)
+
+		assert.Empty(t, client.updated)
+		assert.Equal(t, []moveResult{{id: 42, from: `MyProject\Sprint 1`}}, results)
+		assert.Equal(t, "Would move work item 42 from MyProject\\Sprint 1 to MyProject\\Sprint 2\n", out.String())
+	})
+}
@@ -0,0 +1,17 @@
+package priority
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/priority/set"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdPriority(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "priority <command>",
+		Short: "Manage the priority of work items",
+	}
+
+	cmd.AddCommand(set.NewCmdSet(ctx))
+	return cmd
+}
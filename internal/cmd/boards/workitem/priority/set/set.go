@@ -0,0 +1,186 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// fieldPriority is the reference name of the priority field
+const fieldPriority = "Microsoft.VSTS.Common.Priority"
+
+// confirmThreshold is the number of work items above which the update must be confirmed
+const confirmThreshold = 10
+
+// maxConcurrency is the maximum number of concurrent update requests
+const maxConcurrency = 8
+
+type setOptions struct {
+	workItemIDs []int
+	scope       string
+	priority    int
+	yes         bool
+}
+
+// updateResult is the outcome of updating a single work item
+type updateResult struct {
+	id  int
+	err error
+}
+
+func NewCmdSet(ctx util.CmdContext) *cobra.Command {
+	opts := &setOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set <id>... [organization/]project",
+		Short: "Set the priority of work items",
+		Long: heredoc.Doc(`
+			Set the priority of one or more work items.
+
+			The work items are updated concurrently. A failure to update one work item does not stop
+			the update of the others; all failures are reported at the end. Changing the priority of
+			more than 10 work items must be confirmed.
+		`),
+		Example: heredoc.Doc(`
+			# raise the priority of work item 42
+			azdo boards work-item priority set 42 myproject --priority 1
+
+			# lower the priority of several work items without confirmation
+			azdo boards work-item priority set 42 43 44 myorg/myproject --priority 3 --yes
+		`),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[len(args)-1]
+			for _, arg := range args[:len(args)-1] {
+				id, err := shared.ParseWorkItemID(arg)
+				if err != nil {
+					return err
+				}
+				opts.workItemIDs = append(opts.workItemIDs, id)
+			}
+			opts.workItemIDs = lo.Uniq(opts.workItemIDs)
+
+			if opts.priority < 1 || opts.priority > 4 {
+				return util.FlagErrorf("invalid value for --priority: %d; must be between 1 and 4", opts.priority)
+			}
+
+			return runSet(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.priority, "priority", "p", 0, "Priority to set, from 1 (highest) to 4 (lowest)")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	_ = cmd.MarkFlagRequired("priority")
+
+	return cmd
+}
+
+func runSet(ctx util.CmdContext, opts *setOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+
+	if len(opts.workItemIDs) > confirmThreshold && !opts.yes {
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--yes required when not running interactively")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		confirmed, err := p.Confirm(fmt.Sprintf("Set the priority of %s to %d?", text.Pluralize(len(opts.workItemIDs), "work item"), opts.priority), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return util.ErrCancel
+		}
+	}
+
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	total := len(opts.workItemIDs)
+	iostrms.StartProgressIndicatorWithLabel(fmt.Sprintf("Updating work items (0/%d)", total))
+	defer iostrms.StopProgressIndicator()
+
+	var mu sync.Mutex
+	done := 0
+	results := updatePriorities(rctx, client, project, opts.workItemIDs, opts.priority, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		done++
+		iostrms.StartProgressIndicatorWithLabel(fmt.Sprintf("Updating work items (%d/%d)", done, total))
+	})
+	iostrms.StopProgressIndicator()
+
+	failed := lo.Filter(results, func(r updateResult, _ int) bool { return r.err != nil })
+	cs := iostrms.ColorScheme()
+	if updated := total - len(failed); updated > 0 {
+		fmt.Fprintf(iostrms.Out, "%s Set the priority of %s to %d\n", cs.SuccessIcon(), text.Pluralize(updated, "work item"), opts.priority)
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	fmt.Fprintf(iostrms.ErrOut, "%s Failed to update %s:\n", cs.FailureIcon(), text.Pluralize(len(failed), "work item"))
+	for _, r := range failed {
+		fmt.Fprintf(iostrms.ErrOut, "  %d: %s\n", r.id, r.err)
+	}
+	return util.ErrSilent
+}
+
+// updatePriorities sets the priority of the work items concurrently and returns the results
+// ordered by work item ID. onDone is called after each update.
+func updatePriorities(ctx context.Context, client workitemtracking.Client, project string, ids []int, priority int, onDone func()) []updateResult {
+	results := make([]updateResult, len(ids))
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i, id int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			_, err := client.UpdateWorkItem(ctx, workitemtracking.UpdateWorkItemArgs{
+				Id:      &id,
+				Project: &project,
+				Document: &[]webapi.JsonPatchOperation{
+					shared.AddFieldOperation(fieldPriority, priority),
+				},
+			})
+			results[i] = updateResult{id: id, err: err}
+			onDone()
+		}(i, id)
+	}
+	wg.Wait()
+
+	sort.Slice(results, func(i, j int) bool { return results[i].id < results[j].id })
+	return results
+}
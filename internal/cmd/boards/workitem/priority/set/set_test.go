@@ -0,0 +1,59 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	workitemtracking.Client
+	mu      sync.Mutex
+	updated map[int]any
+	fail    map[int]bool
+}
+
+func (c *fakeClient) UpdateWorkItem(_ context.Context, args workitemtracking.UpdateWorkItemArgs) (*workitemtracking.WorkItem, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fail[*args.Id] {
+		return nil, fmt.Errorf("work item %d does not exist", *args.Id)
+	}
+	c.updated[*args.Id] = (*args.Document)[0].Value
+	return &workitemtracking.WorkItem{Id: args.Id}, nil
+}
+
+func TestUpdatePriorities(t *testing.T) {
+	client := &fakeClient{
+		updated: map[int]any{},
+		fail:    map[int]bool{7: true},
+	}
+	ids := []int{12, 7, 3, 25, 1, 9, 10, 11, 2, 4, 5}
+
+	calls := 0
+	var mu sync.Mutex
+	results := updatePriorities(context.Background(), client, "proj", ids, 2, func() {
+		mu.Lock()
+		calls++
+		mu.Unlock()
+	})
+
+	require.Len(t, results, len(ids))
+	assert.Equal(t, len(ids), calls)
+	for i, r := range results {
+		if i > 0 {
+			assert.Less(t, results[i-1].id, r.id)
+		}
+		if r.id == 7 {
+			assert.EqualError(t, r.err, "work item 7 does not exist")
+			continue
+		}
+		assert.NoError(t, r.err)
+		assert.Equal(t, 2, client.updated[r.id])
+	}
+}
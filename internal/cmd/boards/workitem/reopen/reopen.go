@@ -0,0 +1,125 @@
+package reopen
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type reopenOptions struct {
+	workItemID int
+	scope      string
+	state      string
+	comment    string
+}
+
+func NewCmdReopen(ctx util.CmdContext) *cobra.Command {
+	opts := &reopenOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reopen <id> [organization/]project",
+		Short: "Reopen a work item",
+		Long: heredoc.Doc(`
+			Reopen a closed or resolved work item.
+
+			When --state is not given, the work item is moved to the first state of its type
+			which belongs to the "Active" or "InProgress" category.
+		`),
+		Example: heredoc.Doc(`
+			# reopen work item 42
+			azdo boards work-item reopen 42 myproject --comment "Still happens on Windows"
+		`),
+		Args: util.ExactArgs(2, "cannot reopen work item: work item ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseWorkItemID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.workItemID = id
+			opts.scope = args[1]
+
+			return runReopen(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.state, "state", "", "State to move the work item to")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment to add to the work item")
+
+	return cmd
+}
+
+func runReopen(ctx util.CmdContext, opts *reopenOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	wi, err := client.GetWorkItem(rctx, workitemtracking.GetWorkItemArgs{
+		Id:      &opts.workItemID,
+		Project: &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get work item %d: %w", opts.workItemID, err)
+	}
+
+	states, err := shared.GetWorkItemTypeStates(rctx, client, project, wi)
+	if err != nil {
+		return
+	}
+
+	currentState := shared.FieldString(wi, shared.FieldState)
+	switch shared.StateCategory(states, currentState) {
+	case shared.StateCategoryProposed, shared.StateCategoryActive, shared.StateCategoryInProgress:
+		return fmt.Errorf("work item %d is already active (%s)", opts.workItemID, currentState)
+	}
+
+	state := opts.state
+	if state == "" {
+		var ok bool
+		state, ok = shared.FindStateByCategory(states, shared.StateCategoryActive, shared.StateCategoryInProgress)
+		if !ok {
+			return fmt.Errorf("work item type %s has no active state; specify the state with --state", shared.FieldString(wi, shared.FieldWorkItemType))
+		}
+	}
+	if strings.EqualFold(state, currentState) {
+		return fmt.Errorf("work item %d is already in state %s", opts.workItemID, currentState)
+	}
+
+	iostrms.StartProgressIndicator()
+	wi, err = shared.UpdateState(rctx, client, project, opts.workItemID, state, opts.comment)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return
+	}
+
+	finalState := shared.FieldString(wi, shared.FieldState)
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Reopened work item %d %s (%s)\n", cs.SuccessIcon(), opts.workItemID, shared.FieldString(wi, shared.FieldTitle), cs.Bold(finalState))
+	} else {
+		fmt.Fprintln(iostrms.Out, finalState)
+	}
+	return
+}
@@ -0,0 +1,109 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+type listOptions struct {
+	scope  string
+	format string
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List saved work item queries",
+		Long: heredoc.Doc(`
+			List the saved work item queries of a project, i.e. the queries in "My Queries" of the
+			authenticated user and in "Shared Queries", including their subfolders.
+		`),
+		Example: heredoc.Doc(`
+			# list the saved queries of a project
+			azdo boards work-item saved-query list myproject
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list saved queries: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			return runList(ctx, opts)
+		},
+	}
+
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	queries, err := shared.ListSavedQueries(rctx, client, project)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return
+	}
+	if len(queries) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No saved queries found in project %s", project))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(queries)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	tp.AddColumns("ID", "Name", "Path", "Last Executed")
+	for _, q := range queries {
+		tp.AddField(lo.FromPtr(q.Id).String(), printer.WithTruncate(nil))
+		tp.AddField(lo.FromPtr(q.Name))
+		tp.AddField(lo.FromPtr(q.Path))
+		switch {
+		case q.LastExecutedDate == nil:
+			tp.AddField("")
+		case iostrms.IsStdoutTTY():
+			tp.AddField(text.FuzzyAgo(now, q.LastExecutedDate.Time))
+		default:
+			tp.AddField(q.LastExecutedDate.Time.Format(time.RFC3339))
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
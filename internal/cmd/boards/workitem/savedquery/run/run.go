@@ -0,0 +1,168 @@
+package run
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+var runFields = []string{
+	shared.FieldWorkItemType,
+	shared.FieldState,
+	shared.FieldTitle,
+	shared.FieldAssignedTo,
+}
+
+type runOptions struct {
+	name   string
+	scope  string
+	limit  int
+	format string
+}
+
+func NewCmdRun(ctx util.CmdContext) *cobra.Command {
+	opts := &runOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "run <name> [organization/]project",
+		Short: "Run a saved work item query",
+		Long: heredoc.Doc(`
+			Run a saved work item query and list the work items it returns.
+
+			The query is given by its ID, its path like "Shared Queries/Active bugs" or its name if
+			the name is unique in the project. For queries returning work item links, the linked
+			work items are listed.
+		`),
+		Example: heredoc.Doc(`
+			# run the shared query "Active bugs"
+			azdo boards work-item saved-query run "Shared Queries/Active bugs" myproject
+
+			# run a query by name
+			azdo boards work-item saved-query run "Assigned to me" myorg/myproject
+		`),
+		Args: util.ExactArgs(2, "cannot run saved query: name and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.scope = args[1]
+
+			if opts.limit < 1 {
+				return util.FlagErrorf("invalid limit: %d", opts.limit)
+			}
+
+			return runRun(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 50, "Maximum number of work items to list")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runRun(ctx util.CmdContext, opts *runOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	id, err := uuid.Parse(opts.name)
+	if err != nil {
+		queries, err := shared.ListSavedQueries(rctx, client, project)
+		if err != nil {
+			return err
+		}
+		q, err := shared.FindSavedQuery(queries, opts.name)
+		if err != nil {
+			return err
+		}
+		id = *q.Id
+	}
+
+	res, err := client.QueryById(rctx, workitemtracking.QueryByIdArgs{
+		Id:            &id,
+		Project:       &project,
+		Top:           &opts.limit,
+		TimePrecision: lo.ToPtr(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to run query %s: %w", opts.name, err)
+	}
+	ids := resultIDs(res)
+	if len(ids) == 0 {
+		iostrms.StopProgressIndicator()
+		return util.NewNoResultsError(fmt.Sprintf("No work items found by query %s", opts.name))
+	}
+
+	items, err := shared.GetWorkItems(rctx, client, project, ids, runFields)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(items)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Type", "State", "Title", "Assigned To")
+	for i := range items {
+		wi := &items[i]
+		tp.AddField(strconv.Itoa(*wi.Id), printer.WithTruncate(nil))
+		tp.AddField(shared.FieldString(wi, shared.FieldWorkItemType))
+		tp.AddField(shared.FieldString(wi, shared.FieldState))
+		tp.AddField(shared.FieldString(wi, shared.FieldTitle))
+		tp.AddField(shared.FieldString(wi, shared.FieldAssignedTo))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// resultIDs returns the IDs of the work items of a query result in order. For link queries the
+// sources and targets of the links are returned.
+func resultIDs(res *workitemtracking.WorkItemQueryResult) []int {
+	var ids []int
+	for _, wi := range lo.FromPtr(res.WorkItems) {
+		ids = append(ids, *wi.Id)
+	}
+	for _, rel := range lo.FromPtr(res.WorkItemRelations) {
+		if rel.Source != nil {
+			ids = append(ids, *rel.Source.Id)
+		}
+		if rel.Target != nil {
+			ids = append(ids, *rel.Target.Id)
+		}
+	}
+	return lo.Uniq(ids)
+}
@@ -0,0 +1,29 @@
+package run
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func ref(id int) *workitemtracking.WorkItemReference {
+	return &workitemtracking.WorkItemReference{Id: lo.ToPtr(id)}
+}
+
+func TestResultIDs(t *testing.T) {
+	assert.Equal(t, []int{3, 1, 2}, resultIDs(&workitemtracking.WorkItemQueryResult{
+		WorkItems: &[]workitemtracking.WorkItemReference{*ref(3), *ref(1), *ref(2)},
+	}))
+
+	assert.Equal(t, []int{1, 2, 3}, resultIDs(&workitemtracking.WorkItemQueryResult{
+		WorkItemRelations: &[]workitemtracking.WorkItemLink{
+			{Target: ref(1)},
+			{Source: ref(1), Target: ref(2)},
+			{Source: ref(1), Target: ref(3)},
+		},
+	}))
+
+	assert.Empty(t, resultIDs(&workitemtracking.WorkItemQueryResult{}))
+}
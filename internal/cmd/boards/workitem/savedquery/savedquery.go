@@ -0,0 +1,19 @@
+package savedquery
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/savedquery/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/savedquery/run"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdSavedQuery(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "saved-query <command>",
+		Short: "Manage saved work item queries",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(run.NewCmdRun(ctx))
+	return cmd
+}
@@ -0,0 +1,192 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/search"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// maxPageSize is the maximum number of results returned by a single work item search request
+const maxPageSize = 1000
+
+type searchOptions struct {
+	query         string
+	scope         string
+	workItemTypes []string
+	states        []string
+	limit         int
+	format        string
+}
+
+type searchResult struct {
+	ID           int    `json:"id"`
+	WorkItemType string `json:"workItemType"`
+	State        string `json:"state"`
+	Title        string `json:"title"`
+	AssignedTo   string `json:"assignedTo"`
+}
+
+func NewCmdSearch(ctx util.CmdContext) *cobra.Command {
+	opts := &searchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "search <query> [organization/]project",
+		Short: "Search work items",
+		Long: heredoc.Doc(`
+			Search the work items of a project by free text using Azure DevOps work item search.
+
+			In contrast to the list command, which selects work items by field values, search
+			matches the query against the title, description and other text fields of the work items.
+		`),
+		Example: heredoc.Doc(`
+			# search for work items mentioning a timeout
+			azdo boards work-item search timeout myproject
+
+			# search active bugs
+			azdo boards work-item search "login fails" myorg/myproject --type Bug --state Active
+		`),
+		Args: util.ExactArgs(2, "cannot search work items: query and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.query = args[0]
+			opts.scope = args[1]
+
+			if opts.limit < 1 {
+				return util.FlagErrorf("invalid limit: %d", opts.limit)
+			}
+
+			return runSearch(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.workItemTypes, "type", nil, "Only search work items of this type; can be repeated")
+	cmd.Flags().StringArrayVar(&opts.states, "state", nil, "Only search work items in this state; can be repeated")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 50, "Maximum number of work items to return")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runSearch(ctx util.CmdContext, opts *searchOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := search.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	filters := map[string][]string{
+		"System.TeamProject": {project},
+	}
+	if len(opts.workItemTypes) > 0 {
+		filters[shared.FieldWorkItemType] = lo.Uniq(opts.workItemTypes)
+	}
+	if len(opts.states) > 0 {
+		filters[shared.FieldState] = lo.Uniq(opts.states)
+	}
+
+	iostrms.StartProgressIndicator()
+	var results []searchResult
+	for skip := 0; len(results) < opts.limit; {
+		top := lo.Min([]int{opts.limit - len(results), maxPageSize})
+		res, err := client.FetchWorkItemSearchResults(rctx, search.FetchWorkItemSearchResultsArgs{
+			Request: &search.WorkItemSearchRequest{
+				SearchText: &opts.query,
+				Filters:    &filters,
+				Skip:       &skip,
+				Top:        &top,
+			},
+			Project: &project,
+		})
+		if err != nil {
+			iostrms.StopProgressIndicator()
+			return fmt.Errorf("failed to search work items: %w", err)
+		}
+		if res.Results == nil || len(*res.Results) == 0 {
+			break
+		}
+		for _, r := range *res.Results {
+			results = append(results, newSearchResult(&r))
+		}
+		skip += len(*res.Results)
+		if skip >= lo.FromPtr(res.Count) {
+			break
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	if len(results) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No work items found matching %q", opts.query))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(results)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Type", "State", "Title", "Assigned To")
+	for _, r := range results {
+		tp.AddField(strconv.Itoa(r.ID), printer.WithTruncate(nil))
+		tp.AddField(r.WorkItemType)
+		tp.AddField(r.State)
+		tp.AddField(r.Title)
+		tp.AddField(r.AssignedTo)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+func newSearchResult(r *search.WorkItemResult) searchResult {
+	// the search service returns the reference names of the fields in lower case
+	fields := map[string]string{}
+	if r.Fields != nil {
+		for k, v := range *r.Fields {
+			fields[strings.ToLower(k)] = v
+		}
+	}
+	field := func(name string) string {
+		return fields[strings.ToLower(name)]
+	}
+	id, _ := strconv.Atoi(field("System.Id"))
+	return searchResult{
+		ID:           id,
+		WorkItemType: field(shared.FieldWorkItemType),
+		State:        field(shared.FieldState),
+		Title:        field(shared.FieldTitle),
+		AssignedTo:   displayName(field(shared.FieldAssignedTo)),
+	}
+}
+
+// displayName strips the unique name from an identity in the form "Display Name <user@example.com>".
+func displayName(identity string) string {
+	if idx := strings.LastIndex(identity, " <"); idx > 0 && strings.HasSuffix(identity, ">") {
+		return identity[:idx]
+	}
+	return identity
+}
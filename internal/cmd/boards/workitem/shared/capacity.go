@@ -0,0 +1,154 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+)
+
+// FieldRemainingWork is the reference name of the field holding the remaining hours of a work item
+const FieldRemainingWork = "Microsoft.VSTS.Scheduling.RemainingWork"
+
+// maxCapacityWorkItems is the maximum number of work items whose remaining work is summed up by CheckCapacity
+const maxCapacityWorkItems = 1000
+
+// CapacityStatus is the sprint capacity of a team member compared with the remaining work assigned to them.
+type CapacityStatus struct {
+	Member        string
+	Iteration     string
+	Capacity      float64
+	RemainingWork float64
+}
+
+// RemainingHours returns the hours of capacity left after the remaining work; it is negative if the
+// member is over capacity.
+func (s *CapacityStatus) RemainingHours() float64 {
+	return s.Capacity - s.RemainingWork
+}
+
+// CheckCapacity returns the capacity of the team member in the iteration of the team and the remaining
+// work assigned to them there, including additionalWork. If iterationPath is empty, the current
+// iteration of the team is used. It returns nil if the member has no capacity set in the iteration.
+// The days off of the member and of the team are not counted as working days.
+func CheckCapacity(ctx context.Context, workClient work.Client, witClient workitemtracking.Client, project, team, member, iterationPath string, additionalWork float64) (*CapacityStatus, error) {
+	iteration, err := FindTeamIteration(ctx, workClient, project, team, iterationPath)
+	if err != nil {
+		return nil, err
+	}
+	if iteration.Attributes == nil || iteration.Attributes.StartDate == nil || iteration.Attributes.FinishDate == nil {
+		return nil, fmt.Errorf("iteration %s has no start and finish date", lo.FromPtr(iteration.Path))
+	}
+
+	capacities, err := workClient.GetCapacitiesWithIdentityRefAndTotals(ctx, work.GetCapacitiesWithIdentityRefAndTotalsArgs{
+		Project:     &project,
+		Team:        &team,
+		IterationId: iteration.Id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get capacity of team %s in iteration %s: %w", team, lo.FromPtr(iteration.Path), err)
+	}
+	capacity, ok := lo.Find(lo.FromPtr(capacities.TeamMembers), func(c work.TeamMemberCapacityIdentityRef) bool {
+		return c.TeamMember != nil && (strings.EqualFold(lo.FromPtr(c.TeamMember.UniqueName), member) || strings.EqualFold(lo.FromPtr(c.TeamMember.DisplayName), member))
+	})
+	if !ok {
+		return nil, nil
+	}
+	teamDaysOff, err := workClient.GetTeamDaysOff(ctx, work.GetTeamDaysOffArgs{
+		Project:     &project,
+		IterationId: iteration.Id,
+		Team:        &team,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get days off of team %s in iteration %s: %w", team, lo.FromPtr(iteration.Path), err)
+	}
+
+	perDay := lo.SumBy(lo.FromPtr(capacity.Activities), func(a work.Activity) float64 {
+		return float64(lo.FromPtr(a.CapacityPerDay))
+	})
+	daysOff := append(append([]work.DateRange{}, lo.FromPtr(capacity.DaysOff)...), lo.FromPtr(teamDaysOff.DaysOff)...)
+	workingDays := RemainingWorkingDays(iteration.Attributes.StartDate.Time, iteration.Attributes.FinishDate.Time, time.Now(), daysOff)
+	status := &CapacityStatus{
+		Member:    lo.FromPtr(capacity.TeamMember.DisplayName),
+		Iteration: lo.FromPtr(iteration.Path),
+		Capacity:  perDay * float64(workingDays),
+	}
+
+	items, err := QueryWorkItems(ctx, witClient, project, &QueryOptions{
+		AssignedTo: lo.FromPtr(capacity.TeamMember.UniqueName),
+		Iteration:  lo.FromPtr(iteration.Path),
+		Limit:      maxCapacityWorkItems,
+	}, []string{FieldRemainingWork})
+	if err != nil {
+		return nil, err
+	}
+	status.RemainingWork = additionalWork
+	for i := range items {
+		if v, err := strconv.ParseFloat(FieldString(&items[i], FieldRemainingWork), 64); err == nil {
+			status.RemainingWork += v
+		}
+	}
+	return status, nil
+}
+
+// FindTeamIteration returns the iteration of the team with the given path or name. If iterationPath
+// is empty, the current iteration of the team is returned. If team is empty, the default team of the
+// project is used.
+func FindTeamIteration(ctx context.Context, workClient work.Client, project, team, iterationPath string) (*work.TeamSettingsIteration, error) {
+	teamName := lo.Ternary(team != "", "team "+team, "the default team")
+	args := work.GetTeamIterationsArgs{
+		Project: &project,
+		Team:    &team,
+	}
+	if iterationPath == "" {
+		args.Timeframe = lo.ToPtr("current")
+	}
+	iterations, err := workClient.GetTeamIterations(ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iterations of %s: %w", teamName, err)
+	}
+	iteration, ok := lo.Find(lo.FromPtr(iterations), func(i work.TeamSettingsIteration) bool {
+		return iterationPath == "" || strings.EqualFold(lo.FromPtr(i.Path), iterationPath) || strings.EqualFold(lo.FromPtr(i.Name), iterationPath)
+	})
+	if !ok {
+		if iterationPath == "" {
+			return nil, fmt.Errorf("%s has no current iteration", teamName)
+		}
+		return nil, fmt.Errorf("iteration %s is not an iteration of %s", iterationPath, teamName)
+	}
+	return &iteration, nil
+}
+
+// RemainingWorkingDays returns the number of weekdays from now, or start if it is later, until
+// finish which are not days off.
+func RemainingWorkingDays(start, finish, now time.Time, daysOff []work.DateRange) int {
+	day := truncateDay(start)
+	if today := truncateDay(now); today.After(day) {
+		day = today
+	}
+	finish = truncateDay(finish)
+
+	days := 0
+	for ; !day.After(finish); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			continue
+		}
+		off := lo.ContainsBy(daysOff, func(r work.DateRange) bool {
+			return r.Start != nil && r.End != nil && !day.Before(truncateDay(r.Start.Time)) && !day.After(truncateDay(r.End.Time))
+		})
+		if !off {
+			days++
+		}
+	}
+	return days
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,121 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/work"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWorkClient struct {
+	work.Client
+	iteration   work.TeamSettingsIteration
+	members     []work.TeamMemberCapacityIdentityRef
+	teamDaysOff []work.DateRange
+}
+
+func (c *fakeWorkClient) GetTeamIterations(_ context.Context, _ work.GetTeamIterationsArgs) (*[]work.TeamSettingsIteration, error) {
+	return &[]work.TeamSettingsIteration{c.iteration}, nil
+}
+
+func (c *fakeWorkClient) GetCapacitiesWithIdentityRefAndTotals(_ context.Context, _ work.GetCapacitiesWithIdentityRefAndTotalsArgs) (*work.TeamCapacity, error) {
+	return &work.TeamCapacity{TeamMembers: &c.members}, nil
+}
+
+func (c *fakeWorkClient) GetTeamDaysOff(_ context.Context, _ work.GetTeamDaysOffArgs) (*work.TeamSettingsDaysOff, error) {
+	return &work.TeamSettingsDaysOff{DaysOff: &c.teamDaysOff}, nil
+}
+
+type fakeWiqlClient struct {
+	workitemtracking.Client
+}
+
+func (c *fakeWiqlClient) QueryByWiql(_ context.Context, _ workitemtracking.QueryByWiqlArgs) (*workitemtracking.WorkItemQueryResult, error) {
+	return &workitemtracking.WorkItemQueryResult{}, nil
+}
+
+func TestRemainingWorkingDays(t *testing.T) {
+	day := func(d int) time.Time {
+		return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC)
+	}
+	// the sprint runs from Monday, March 4 to Friday, March 15
+	start, finish := day(4), day(15)
+
+	tests := []struct {
+		name    string
+		now     time.Time
+		daysOff []work.DateRange
+		want    int
+	}{
+		{name: "before the sprint", now: day(1), want: 10},
+		{name: "in the second week", now: day(11).Add(15 * time.Hour), want: 5},
+		{name: "on the weekend", now: day(9), want: 5},
+		{name: "after the sprint", now: day(18), want: 0},
+		{
+			name: "with days off",
+			now:  day(1),
+			daysOff: []work.DateRange{
+				{Start: &azuredevops.Time{Time: day(6)}, End: &azuredevops.Time{Time: day(8)}},
+			},
+			want: 7,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RemainingWorkingDays(start, finish, tt.now, tt.daysOff))
+		})
+	}
+}
+
+func TestCheckCapacity(t *testing.T) {
+	day := func(d int) *azuredevops.Time {
+		return &azuredevops.Time{Time: time.Date(2100, 3, d, 0, 0, 0, 0, time.UTC)}
+	}
+	iteration := func(start, finish *azuredevops.Time) work.TeamSettingsIteration {
+		return work.TeamSettingsIteration{
+			Id:   lo.ToPtr(uuid.New()),
+			Path: lo.ToPtr(`Fabrikam\Sprint 1`),
+			Attributes: &work.TeamIterationAttributes{
+				StartDate:  start,
+				FinishDate: finish,
+			},
+		}
+	}
+	// the sprint runs from Monday, March 1 to Friday, March 12 with a capacity of 6 hours per day
+	members := []work.TeamMemberCapacityIdentityRef{{
+		TeamMember: &webapi.IdentityRef{DisplayName: lo.ToPtr("Jamal Hartnett"), UniqueName: lo.ToPtr("jamal@example.com")},
+		Activities: &[]work.Activity{{CapacityPerDay: lo.ToPtr[float32](6)}},
+		DaysOff:    &[]work.DateRange{{Start: day(2), End: day(2)}},
+	}}
+
+	t.Run("member and team days off", func(t *testing.T) {
+		client := &fakeWorkClient{
+			iteration:   iteration(day(1), day(12)),
+			members:     members,
+			teamDaysOff: []work.DateRange{{Start: day(11), End: day(12)}},
+		}
+		status, err := CheckCapacity(context.Background(), client, &fakeWiqlClient{}, "Fabrikam", "Team", "jamal@example.com", `Fabrikam\Sprint 1`, 4)
+		require.NoError(t, err)
+		require.NotNil(t, status)
+		assert.Equal(t, float64(6*7), status.Capacity)
+		assert.Equal(t, float64(4), status.RemainingWork)
+	})
+
+	t.Run("iteration without dates", func(t *testing.T) {
+		client := &fakeWorkClient{
+			iteration: iteration(nil, nil),
+			members:   members,
+		}
+		_, err := CheckCapacity(context.Background(), client, &fakeWiqlClient{}, "Fabrikam", "Team", "jamal@example.com", `Fabrikam\Sprint 1`, 4)
+		assert.EqualError(t, err, `iteration Fabrikam\Sprint 1 has no start and finish date`)
+	})
+}
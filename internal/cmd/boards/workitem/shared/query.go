@@ -0,0 +1,129 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+)
+
+// maxBatchSize is the maximum number of work items which can be fetched with a single batch request
+const maxBatchSize = 200
+
+// QueryOptions holds the filters of commands which select work items of a project by field values.
+type QueryOptions struct {
+	WorkItemTypes []string
+	States        []string
+	AssignedTo    string
+	Area          string
+	Iteration     string
+	ChangedSince  *time.Time
+	ChangedUntil  *time.Time
+	Limit         int
+}
+
+// AddQueryFlags registers the flags of the query options. limit is the default of the --limit flag.
+func AddQueryFlags(cmd *cobra.Command, opts *QueryOptions, limit int) {
+	cmd.Flags().StringArrayVar(&opts.WorkItemTypes, "type", nil, "Only select work items of this type; can be repeated")
+	cmd.Flags().StringArrayVar(&opts.States, "state", nil, "Only select work items in this state; can be repeated")
+	cmd.Flags().StringVar(&opts.AssignedTo, "assigned-to", "", "Only select work items assigned to this user; use \"@me\" for yourself")
+	cmd.Flags().StringVar(&opts.Area, "area", "", "Only select work items under this area path")
+	cmd.Flags().StringVar(&opts.Iteration, "iteration", "", "Only select work items under this iteration path")
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", limit, "Maximum number of work items to select")
+}
+
+// BuildQuery returns the WIQL query selecting the work items matching the query options, most
+// recently changed first.
+func BuildQuery(opts *QueryOptions) string {
+	conditions := []string{"[System.TeamProject] = @project"}
+	if len(opts.WorkItemTypes) > 0 {
+		conditions = append(conditions, fmt.Sprintf("[%s] IN (%s)", FieldWorkItemType, wiqlList(opts.WorkItemTypes)))
+	}
+	if len(opts.States) > 0 {
+		conditions = append(conditions, fmt.Sprintf("[%s] IN (%s)", FieldState, wiqlList(opts.States)))
+	}
+	if opts.AssignedTo != "" {
+		value := wiqlString(opts.AssignedTo)
+		if strings.EqualFold(opts.AssignedTo, "@me") {
+			value = "@me"
+		}
+		conditions = append(conditions, fmt.Sprintf("[%s] = %s", FieldAssignedTo, value))
+	}
+	if opts.Area != "" {
+		conditions = append(conditions, fmt.Sprintf("[%s] UNDER %s", FieldAreaPath, wiqlString(opts.Area)))
+	}
+	if opts.Iteration != "" {
+		conditions = append(conditions, fmt.Sprintf("[%s] UNDER %s", FieldIterationPath, wiqlString(opts.Iteration)))
+	}
+	if opts.ChangedSince != nil {
+		conditions = append(conditions, fmt.Sprintf("[%s] >= %s", FieldChangedDate, wiqlTime(*opts.ChangedSince)))
+	}
+	if opts.ChangedUntil != nil {
+		conditions = append(conditions, fmt.Sprintf("[%s] <= %s", FieldChangedDate, wiqlTime(*opts.ChangedUntil)))
+	}
+	return fmt.Sprintf("SELECT [System.Id] FROM WorkItems WHERE %s ORDER BY [%s] DESC", strings.Join(conditions, " AND "), FieldChangedDate)
+}
+
+// QueryWorkItems returns the work items of the project matching the query options. Only the given
+// fields are fetched.
+func QueryWorkItems(ctx context.Context, client workitemtracking.Client, project string, opts *QueryOptions, fields []string) ([]workitemtracking.WorkItem, error) {
+	res, err := client.QueryByWiql(ctx, workitemtracking.QueryByWiqlArgs{
+		Wiql: &workitemtracking.Wiql{
+			Query: lo.ToPtr(BuildQuery(opts)),
+		},
+		Project: &project,
+		Top:     &opts.Limit,
+		// without time precision WIQL rejects date values with a time component
+		TimePrecision: lo.ToPtr(opts.ChangedSince != nil || opts.ChangedUntil != nil),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query work items: %w", err)
+	}
+	if res.WorkItems == nil || len(*res.WorkItems) == 0 {
+		return nil, nil
+	}
+
+	ids := lo.Map(*res.WorkItems, func(r workitemtracking.WorkItemReference, _ int) int {
+		return *r.Id
+	})
+	return GetWorkItems(ctx, client, project, ids, fields)
+}
+
+// GetWorkItems returns the work items with the given IDs in batches. Only the given fields are fetched.
+func GetWorkItems(ctx context.Context, client workitemtracking.Client, project string, ids []int, fields []string) ([]workitemtracking.WorkItem, error) {
+	items := make([]workitemtracking.WorkItem, 0, len(ids))
+	for _, chunk := range lo.Chunk(ids, maxBatchSize) {
+		batch, err := client.GetWorkItemsBatch(ctx, workitemtracking.GetWorkItemsBatchArgs{
+			WorkItemGetRequest: &workitemtracking.WorkItemBatchGetRequest{
+				Ids:    lo.ToPtr(chunk),
+				Fields: &fields,
+			},
+			Project: &project,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get work items: %w", err)
+		}
+		if batch != nil {
+			items = append(items, *batch...)
+		}
+	}
+	return items, nil
+}
+
+func wiqlString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func wiqlTime(t time.Time) string {
+	return wiqlString(t.UTC().Format(time.RFC3339))
+}
+
+func wiqlList(values []string) string {
+	return strings.Join(lo.Map(lo.Uniq(values), func(v string, _ int) string {
+		return wiqlString(v)
+	}), ", ")
+}
@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildQuery(t *testing.T) {
+	opts := &QueryOptions{
+		WorkItemTypes: []string{"Bug", "User Story"},
+		States:        []string{"Active"},
+		AssignedTo:    "@Me",
+		Area:          "proj\\Team's Area",
+	}
+	assert.Equal(t,
+		"SELECT [System.Id] FROM WorkItems WHERE [System.TeamProject] = @project"+
+			" AND [System.WorkItemType] IN ('Bug', 'User Story')"+
+			" AND [System.State] IN ('Active')"+
+			" AND [System.AssignedTo] = @me"+
+			" AND [System.AreaPath] UNDER 'proj\\Team''s Area'"+
+			" ORDER BY [System.ChangedDate] DESC",
+		BuildQuery(opts))
+}
+
+func TestBuildQueryChangedDate(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2024, 3, 31, 23, 59, 59, 0, time.FixedZone("CET", 3600))
+	opts := &QueryOptions{
+		ChangedSince: &since,
+		ChangedUntil: &until,
+	}
+	assert.Equal(t,
+		"SELECT [System.Id] FROM WorkItems WHERE [System.TeamProject] = @project"+
+			" AND [System.ChangedDate] >= '2024-01-01T00:00:00Z'"+
+			" AND [System.ChangedDate] <= '2024-03-31T22:59:59Z'"+
+			" ORDER BY [System.ChangedDate] DESC",
+		BuildQuery(opts))
+}
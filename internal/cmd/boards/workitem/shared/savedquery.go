@@ -0,0 +1,82 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+)
+
+// maxQueryDepth is the maximum depth of the query hierarchy returned by a single request
+const maxQueryDepth = 2
+
+// ListSavedQueries returns the saved queries of the project, i.e. the queries in "My Queries" of the
+// authenticated user and in "Shared Queries". Folders are not returned.
+func ListSavedQueries(ctx context.Context, client workitemtracking.Client, project string) ([]workitemtracking.QueryHierarchyItem, error) {
+	roots, err := client.GetQueries(ctx, workitemtracking.GetQueriesArgs{
+		Project: &project,
+		Expand:  &workitemtracking.QueryExpandValues.None,
+		Depth:   lo.ToPtr(maxQueryDepth),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get queries of project %s: %w", project, err)
+	}
+
+	var queries []workitemtracking.QueryHierarchyItem
+	var walk func(items []workitemtracking.QueryHierarchyItem) error
+	walk = func(items []workitemtracking.QueryHierarchyItem) error {
+		for _, item := range items {
+			if !lo.FromPtr(item.IsFolder) {
+				queries = append(queries, item)
+				continue
+			}
+			children := item.Children
+			if children == nil && lo.FromPtr(item.HasChildren) {
+				// folders deeper than maxQueryDepth are returned without their children
+				folder, err := client.GetQuery(ctx, workitemtracking.GetQueryArgs{
+					Project: &project,
+					Query:   lo.ToPtr(item.Id.String()),
+					Expand:  &workitemtracking.QueryExpandValues.None,
+					Depth:   lo.ToPtr(maxQueryDepth),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to get query folder %s: %w", lo.FromPtr(item.Path), err)
+				}
+				children = folder.Children
+			}
+			if err := walk(lo.FromPtr(children)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(lo.FromPtr(roots)); err != nil {
+		return nil, err
+	}
+	return queries, nil
+}
+
+// FindSavedQuery returns the query with the given ID, path or name. A name must identify a single query.
+func FindSavedQuery(queries []workitemtracking.QueryHierarchyItem, name string) (*workitemtracking.QueryHierarchyItem, error) {
+	if q, ok := lo.Find(queries, func(q workitemtracking.QueryHierarchyItem) bool {
+		return (q.Id != nil && strings.EqualFold(q.Id.String(), name)) || strings.EqualFold(lo.FromPtr(q.Path), name)
+	}); ok {
+		return &q, nil
+	}
+
+	matches := lo.Filter(queries, func(q workitemtracking.QueryHierarchyItem, _ int) bool {
+		return strings.EqualFold(lo.FromPtr(q.Name), name)
+	})
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("saved query %q not found", name)
+	case 1:
+		return &matches[0], nil
+	}
+	paths := lo.Map(matches, func(q workitemtracking.QueryHierarchyItem, _ int) string {
+		return lo.FromPtr(q.Path)
+	})
+	return nil, fmt.Errorf("query name %q is ambiguous; use one of the paths:\n  %s", name, strings.Join(paths, "\n  "))
+}
@@ -0,0 +1,98 @@
+package shared
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeQueryClient struct {
+	workitemtracking.Client
+	roots   []workitemtracking.QueryHierarchyItem
+	folders map[string]workitemtracking.QueryHierarchyItem
+}
+
+func (c *fakeQueryClient) GetQueries(_ context.Context, _ workitemtracking.GetQueriesArgs) (*[]workitemtracking.QueryHierarchyItem, error) {
+	return &c.roots, nil
+}
+
+func (c *fakeQueryClient) GetQuery(_ context.Context, args workitemtracking.GetQueryArgs) (*workitemtracking.QueryHierarchyItem, error) {
+	folder := c.folders[*args.Query]
+	return &folder, nil
+}
+
+func query(path string) workitemtracking.QueryHierarchyItem {
+	return workitemtracking.QueryHierarchyItem{
+		Id:   lo.ToPtr(uuid.New()),
+		Name: lo.ToPtr(path[strings.LastIndex(path, "/")+1:]),
+		Path: &path,
+	}
+}
+
+func folder(path string, children ...workitemtracking.QueryHierarchyItem) workitemtracking.QueryHierarchyItem {
+	f := query(path)
+	f.IsFolder = lo.ToPtr(true)
+	f.HasChildren = lo.ToPtr(len(children) > 0)
+	if len(children) > 0 {
+		f.Children = &children
+	}
+	return f
+}
+
+func TestListSavedQueries(t *testing.T) {
+	deep := folder("Shared Queries/Team/Archive", query("Shared Queries/Team/Archive/Old bugs"))
+	unloaded := deep
+	unloaded.Children = nil
+	client := &fakeQueryClient{
+		roots: []workitemtracking.QueryHierarchyItem{
+			folder("My Queries", query("My Queries/Assigned to me")),
+			folder("Shared Queries",
+				query("Shared Queries/Active bugs"),
+				folder("Shared Queries/Team", unloaded),
+			),
+		},
+		folders: map[string]workitemtracking.QueryHierarchyItem{
+			deep.Id.String(): deep,
+		},
+	}
+
+	queries, err := ListSavedQueries(context.Background(), client, "myproject")
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"My Queries/Assigned to me",
+		"Shared Queries/Active bugs",
+		"Shared Queries/Team/Archive/Old bugs",
+	}, lo.Map(queries, func(q workitemtracking.QueryHierarchyItem, _ int) string { return *q.Path }))
+}
+
+func TestFindSavedQuery(t *testing.T) {
+	queries := []workitemtracking.QueryHierarchyItem{
+		query("My Queries/Active bugs"),
+		query("Shared Queries/Active bugs"),
+		query("Shared Queries/Blocked"),
+	}
+
+	q, err := FindSavedQuery(queries, "blocked")
+	require.NoError(t, err)
+	assert.Equal(t, "Shared Queries/Blocked", *q.Path)
+
+	q, err = FindSavedQuery(queries, "shared queries/active bugs")
+	require.NoError(t, err)
+	assert.Equal(t, "Shared Queries/Active bugs", *q.Path)
+
+	q, err = FindSavedQuery(queries, queries[0].Id.String())
+	require.NoError(t, err)
+	assert.Equal(t, "My Queries/Active bugs", *q.Path)
+
+	_, err = FindSavedQuery(queries, "Active bugs")
+	assert.EqualError(t, err, "query name \"Active bugs\" is ambiguous; use one of the paths:\n  My Queries/Active bugs\n  Shared Queries/Active bugs")
+
+	_, err = FindSavedQuery(queries, "Unknown")
+	assert.EqualError(t, err, "saved query \"Unknown\" not found")
+}
@@ -0,0 +1,100 @@
+package shared
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// Reference names of the system fields of a work item
+const (
+	FieldID            = "System.Id"
+	FieldTitle         = "System.Title"
+	FieldDescription   = "System.Description"
+	FieldState         = "System.State"
+	FieldWorkItemType  = "System.WorkItemType"
+	FieldAssignedTo    = "System.AssignedTo"
+	FieldAreaPath      = "System.AreaPath"
+	FieldIterationPath = "System.IterationPath"
+	FieldHistory       = "System.History"
+	FieldTags          = "System.Tags"
+	FieldChangedDate   = "System.ChangedDate"
+)
+
+// RelationParent is the link type of a relation from a child to its parent work item.
+const RelationParent = "System.LinkTypes.Hierarchy-Reverse"
+
+// ParseWorkItemID parses a work item ID argument.
+func ParseWorkItemID(arg string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+	if err != nil || id < 1 {
+		return 0, util.FlagErrorf("invalid work item ID: %s", arg)
+	}
+	return id, nil
+}
+
+// AddFieldOperation returns a JSON patch operation which sets the field of a work item.
+func AddFieldOperation(field string, value any) webapi.JsonPatchOperation {
+	return webapi.JsonPatchOperation{
+		Op:    &webapi.OperationValues.Add,
+		Path:  lo.ToPtr("/fields/" + field),
+		Value: value,
+	}
+}
+
+// AddRelationOperation returns a JSON patch operation which adds a relation of the given type to the
+// work item referenced by url.
+func AddRelationOperation(rel, url string) webapi.JsonPatchOperation {
+	return webapi.JsonPatchOperation{
+		Op:   &webapi.OperationValues.Add,
+		Path: lo.ToPtr("/relations/-"),
+		Value: map[string]any{
+			"rel": rel,
+			"url": url,
+		},
+	}
+}
+
+// FieldString returns the value of a work item field as string.
+func FieldString(wi *workitemtracking.WorkItem, field string) string {
+	if wi.Fields == nil {
+		return ""
+	}
+	v, ok := (*wi.Fields)[field]
+	if !ok || v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	if m, ok := v.(map[string]any); ok {
+		if name, ok := m["displayName"].(string); ok {
+			return name
+		}
+	}
+	return fmt.Sprint(v)
+}
+
+// CSVColumn maps a column of a work item CSV file to the reference name of a field.
+type CSVColumn struct {
+	Header string
+	Field  string
+}
+
+// CSVColumns are the standard columns of work item CSV files written by export and read by import.
+var CSVColumns = []CSVColumn{
+	{Header: "ID", Field: FieldID},
+	{Header: "Work Item Type", Field: FieldWorkItemType},
+	{Header: "Title", Field: FieldTitle},
+	{Header: "State", Field: FieldState},
+	{Header: "Assigned To", Field: FieldAssignedTo},
+	{Header: "Area Path", Field: FieldAreaPath},
+	{Header: "Iteration Path", Field: FieldIterationPath},
+	{Header: "Tags", Field: FieldTags},
+	{Header: "Description", Field: FieldDescription},
+}
@@ -0,0 +1,95 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+)
+
+// Categories of work item states
+const (
+	StateCategoryProposed   = "Proposed"
+	StateCategoryActive     = "Active"
+	StateCategoryInProgress = "InProgress"
+	StateCategoryResolved   = "Resolved"
+	StateCategoryCompleted  = "Completed"
+	StateCategoryRemoved    = "Removed"
+)
+
+// FindStateByCategory returns the name of the first state which belongs to one of the categories.
+// The categories are checked in the order they are passed.
+func FindStateByCategory(states []workitemtracking.WorkItemStateColor, categories ...string) (string, bool) {
+	for _, category := range categories {
+		for _, s := range states {
+			if strings.EqualFold(lo.FromPtr(s.Category), category) {
+				return lo.FromPtr(s.Name), true
+			}
+		}
+	}
+	return "", false
+}
+
+// StateCategory returns the category of the named state or an empty string if the state is unknown.
+func StateCategory(states []workitemtracking.WorkItemStateColor, state string) string {
+	for _, s := range states {
+		if strings.EqualFold(lo.FromPtr(s.Name), state) {
+			return lo.FromPtr(s.Category)
+		}
+	}
+	return ""
+}
+
+// GetWorkItemTypeStates returns the states defined for the type of the work item.
+func GetWorkItemTypeStates(ctx context.Context, client workitemtracking.Client, project string, wi *workitemtracking.WorkItem) ([]workitemtracking.WorkItemStateColor, error) {
+	workItemType := FieldString(wi, FieldWorkItemType)
+	states, err := client.GetWorkItemTypeStates(ctx, workitemtracking.GetWorkItemTypeStatesArgs{
+		Project: &project,
+		Type:    &workItemType,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get states of work item type %s: %w", workItemType, err)
+	}
+	if states == nil {
+		return nil, nil
+	}
+	return *states, nil
+}
+
+// UpdateState sets the state of the work item and, if comment is not empty, adds the comment to the work item.
+func UpdateState(ctx context.Context, client workitemtracking.Client, project string, id int, state, comment string) (*workitemtracking.WorkItem, error) {
+	wi, err := client.UpdateWorkItem(ctx, workitemtracking.UpdateWorkItemArgs{
+		Id:      &id,
+		Project: &project,
+		Document: &[]webapi.JsonPatchOperation{
+			AddFieldOperation(FieldState, state),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to set state of work item %d to %s: %w", id, state, err)
+	}
+	if comment != "" {
+		if err := AddComment(ctx, client, project, id, comment); err != nil {
+			return nil, err
+		}
+	}
+	return wi, nil
+}
+
+// AddComment adds the comment to the work item.
+func AddComment(ctx context.Context, client workitemtracking.Client, project string, id int, comment string) error {
+	_, err := client.AddComment(ctx, workitemtracking.AddCommentArgs{
+		Request: &workitemtracking.CommentCreate{
+			Text: &comment,
+		},
+		Project:    &project,
+		WorkItemId: &id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add comment to work item %d: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindStateByCategory(t *testing.T) {
+	states := []workitemtracking.WorkItemStateColor{
+		{Name: lo.ToPtr("New"), Category: lo.ToPtr("Proposed")},
+		{Name: lo.ToPtr("Active"), Category: lo.ToPtr("InProgress")},
+		{Name: lo.ToPtr("Resolved"), Category: lo.ToPtr("Resolved")},
+		{Name: lo.ToPtr("Closed"), Category: lo.ToPtr("Completed")},
+		{Name: lo.ToPtr("Removed"), Category: lo.ToPtr("Removed")},
+	}
+
+	tests := []struct {
+		name       string
+		categories []string
+		want       string
+		wantOK     bool
+	}{
+		{
+			name:       "completed",
+			categories: []string{StateCategoryCompleted},
+			want:       "Closed",
+			wantOK:     true,
+		},
+		{
+			name:       "first matching category wins",
+			categories: []string{StateCategoryActive, StateCategoryInProgress},
+			want:       "Active",
+			wantOK:     true,
+		},
+		{
+			name:       "no match",
+			categories: []string{"Unknown"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FindStateByCategory(states, tt.categories...)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.Equal(t, "Resolved", StateCategory(states, "resolved"))
+	assert.Equal(t, "", StateCategory(states, "Unknown"))
+}
@@ -0,0 +1,260 @@
+package show
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type showOptions struct {
+	workItemID      int
+	scope           string
+	expandRelations bool
+	format          string
+}
+
+// relation is a relation of a work item with the display name of its type and, for relations to
+// other work items, the ID and title of the target.
+type relation struct {
+	Type          string `json:"type"`
+	ReferenceName string `json:"referenceName"`
+	TargetID      int    `json:"targetId,omitempty"`
+	TargetTitle   string `json:"targetTitle,omitempty"`
+	URL           string `json:"url"`
+}
+
+// showResult is the JSON output of the command
+type showResult struct {
+	*workitemtracking.WorkItem
+	ResolvedRelations []relation `json:"resolvedRelations,omitempty"`
+}
+
+func NewCmdShow(ctx util.CmdContext) *cobra.Command {
+	opts := &showOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "show <id> [organization/]project",
+		Short: "Show a work item",
+		Long: heredoc.Doc(`
+			Show the fields of a work item.
+
+			With --expand-relations the relations of the work item are listed as well, with the
+			display name of the relation type and the ID and title of related work items. This shows
+			the parent, children and other linked work items at a glance.
+		`),
+		Example: heredoc.Doc(`
+			# show work item 42
+			azdo boards work-item show 42 myproject
+
+			# show work item 42 with its parent, children and links
+			azdo boards work-item show 42 myorg/myproject --expand-relations
+		`),
+		Aliases: []string{"view"},
+		Args:    util.ExactArgs(2, "cannot show work item: work item ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseWorkItemID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.workItemID = id
+			opts.scope = args[1]
+
+			return runShow(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.expandRelations, "expand-relations", false, "List the relations of the work item")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runShow(ctx util.CmdContext, opts *showOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := workitemtracking.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	expand := workitemtracking.WorkItemExpandValues.Fields
+	if opts.expandRelations {
+		expand = workitemtracking.WorkItemExpandValues.All
+	}
+	wi, err := client.GetWorkItem(rctx, workitemtracking.GetWorkItemArgs{
+		Id:      &opts.workItemID,
+		Project: &project,
+		Expand:  &expand,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get work item %d: %w", opts.workItemID, err)
+	}
+
+	var relations []relation
+	if opts.expandRelations {
+		relations, err = resolveRelations(rctx, client, project, wi)
+		if err != nil {
+			return
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(showResult{
+			WorkItem:          wi,
+			ResolvedRelations: relations,
+		})
+	}
+
+	cs := iostrms.ColorScheme()
+	fmt.Fprintf(iostrms.Out, "%s %s\n", cs.Bold(shared.FieldString(wi, shared.FieldTitle)), cs.Gray(fmt.Sprintf("#%d", *wi.Id)))
+	for _, f := range []struct{ label, field string }{
+		{"Type", shared.FieldWorkItemType},
+		{"State", shared.FieldState},
+		{"Assigned To", shared.FieldAssignedTo},
+		{"Area", shared.FieldAreaPath},
+		{"Iteration", shared.FieldIterationPath},
+		{"Tags", shared.FieldTags},
+	} {
+		fmt.Fprintf(iostrms.Out, "%s: %s\n", cs.Bold(f.label), shared.FieldString(wi, f.field))
+	}
+
+	if !opts.expandRelations {
+		return nil
+	}
+	fmt.Fprintln(iostrms.Out)
+	if len(relations) == 0 {
+		fmt.Fprintln(iostrms.Out, "No relations")
+		return nil
+	}
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Relation Type", "Target ID", "Target Title", "URL")
+	for _, r := range relations {
+		tp.AddField(r.Type)
+		if r.TargetID > 0 {
+			tp.AddField(strconv.Itoa(r.TargetID), printer.WithTruncate(nil))
+		} else {
+			tp.AddField("")
+		}
+		tp.AddField(r.TargetTitle)
+		tp.AddField(r.URL, printer.WithTruncate(nil))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// resolveRelations returns the relations of the work item ordered by type and target. The display
+// names of the relation types and the titles of related work items are looked up.
+func resolveRelations(ctx context.Context, client workitemtracking.Client, project string, wi *workitemtracking.WorkItem) ([]relation, error) {
+	if len(lo.FromPtr(wi.Relations)) == 0 {
+		return nil, nil
+	}
+
+	types, err := client.GetRelationTypes(ctx, workitemtracking.GetRelationTypesArgs{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relation types: %w", err)
+	}
+	typeNames := map[string]string{}
+	for _, t := range lo.FromPtr(types) {
+		typeNames[lo.FromPtr(t.ReferenceName)] = lo.FromPtr(t.Name)
+	}
+
+	relations := lo.Map(*wi.Relations, func(r workitemtracking.WorkItemRelation, _ int) relation {
+		rel := relation{
+			ReferenceName: lo.FromPtr(r.Rel),
+			TargetID:      workItemIDFromURL(lo.FromPtr(r.Url)),
+			URL:           lo.FromPtr(r.Url),
+		}
+		rel.Type = typeNames[rel.ReferenceName]
+		if rel.Type == "" {
+			rel.Type = rel.ReferenceName
+		}
+		return rel
+	})
+
+	ids := lo.Uniq(lo.FilterMap(relations, func(r relation, _ int) (int, bool) {
+		return r.TargetID, r.TargetID > 0
+	}))
+	if len(ids) > 0 {
+		targets, err := client.GetWorkItemsBatch(ctx, workitemtracking.GetWorkItemsBatchArgs{
+			WorkItemGetRequest: &workitemtracking.WorkItemBatchGetRequest{
+				Ids:         &ids,
+				Fields:      &[]string{shared.FieldTitle},
+				ErrorPolicy: &workitemtracking.WorkItemErrorPolicyValues.Omit,
+			},
+			Project: &project,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get related work items: %w", err)
+		}
+		titles := map[int]string{}
+		for i := range lo.FromPtr(targets) {
+			t := &(*targets)[i]
+			if t.Id != nil {
+				titles[*t.Id] = shared.FieldString(t, shared.FieldTitle)
+			}
+		}
+		for i := range relations {
+			relations[i].TargetTitle = titles[relations[i].TargetID]
+		}
+	}
+
+	sort.SliceStable(relations, func(i, j int) bool {
+		if relations[i].Type != relations[j].Type {
+			return relations[i].Type < relations[j].Type
+		}
+		return relations[i].TargetID < relations[j].TargetID
+	})
+	return relations, nil
+}
+
+// workItemIDFromURL returns the ID of the work item referenced by the URL of a relation or 0 if the
+// relation does not reference a work item.
+func workItemIDFromURL(rawURL string) int {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return 0
+	}
+	if !strings.EqualFold(path.Base(path.Dir(u.Path)), "workItems") {
+		return 0
+	}
+	id, err := strconv.Atoi(path.Base(u.Path))
+	if err != nil {
+		return 0
+	}
+	return id
+}
@@ -0,0 +1,62 @@
+package show
+
+import (
+	"context"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/workitemtracking"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/shared"
+)
+
+type fakeClient struct {
+	workitemtracking.Client
+	titles map[int]string
+}
+
+func (c *fakeClient) GetRelationTypes(context.Context, workitemtracking.GetRelationTypesArgs) (*[]workitemtracking.WorkItemRelationType, error) {
+	return &[]workitemtracking.WorkItemRelationType{
+		{ReferenceName: lo.ToPtr("System.LinkTypes.Hierarchy-Reverse"), Name: lo.ToPtr("Parent")},
+		{ReferenceName: lo.ToPtr("System.LinkTypes.Hierarchy-Forward"), Name: lo.ToPtr("Child")},
+	}, nil
+}
+
+func (c *fakeClient) GetWorkItemsBatch(_ context.Context, args workitemtracking.GetWorkItemsBatchArgs) (*[]workitemtracking.WorkItem, error) {
+	var items []workitemtracking.WorkItem
+	for _, id := range *args.WorkItemGetRequest.Ids {
+		if title, ok := c.titles[id]; ok {
+			items = append(items, workitemtracking.WorkItem{
+				Id:     lo.ToPtr(id),
+				Fields: &map[string]any{shared.FieldTitle: title},
+			})
+		}
+	}
+	return &items, nil
+}
+
+func TestResolveRelations(t *testing.T) {
+	client := &fakeClient{titles: map[int]string{1: "Epic", 5: "Task B", 3: "Task A"}}
+	rel := func(ref, url string) workitemtracking.WorkItemRelation {
+		return workitemtracking.WorkItemRelation{Rel: lo.ToPtr(ref), Url: lo.ToPtr(url)}
+	}
+	wi := &workitemtracking.WorkItem{
+		Id: lo.ToPtr(2),
+		Relations: &[]workitemtracking.WorkItemRelation{
+			rel("System.LinkTypes.Hierarchy-Forward", "https://dev.azure.com/org/_apis/wit/workItems/5"),
+			rel("ArtifactLink", "vstfs:///Git/Commit/abc"),
+			rel("System.LinkTypes.Hierarchy-Reverse", "https://dev.azure.com/org/_apis/wit/workItems/1"),
+			rel("System.LinkTypes.Hierarchy-Forward", "https://dev.azure.com/org/_apis/wit/workItems/3"),
+		},
+	}
+
+	got, err := resolveRelations(context.Background(), client, "proj", wi)
+	require.NoError(t, err)
+	assert.Equal(t, []relation{
+		{Type: "ArtifactLink", ReferenceName: "ArtifactLink", URL: "vstfs:///Git/Commit/abc"},
+		{Type: "Child", ReferenceName: "System.LinkTypes.Hierarchy-Forward", TargetID: 3, TargetTitle: "Task A", URL: "https://dev.azure.com/org/_apis/wit/workItems/3"},
+		{Type: "Child", ReferenceName: "System.LinkTypes.Hierarchy-Forward", TargetID: 5, TargetTitle: "Task B", URL: "https://dev.azure.com/org/_apis/wit/workItems/5"},
+		{Type: "Parent", ReferenceName: "System.LinkTypes.Hierarchy-Reverse", TargetID: 1, TargetTitle: "Epic", URL: "https://dev.azure.com/org/_apis/wit/workItems/1"},
+	}, got)
+}
@@ -0,0 +1,40 @@
+package workitem
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/attachment"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/close"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/create"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/export"
+	importcmd "github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/import"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/move"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/priority"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/reopen"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/savedquery"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/search"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards/workitem/show"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdWorkItem(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "work-item <command>",
+		Short:   "Manage work items",
+		Aliases: []string{"wi"},
+	}
+
+	cmd.AddCommand(attachment.NewCmdAttachment(ctx))
+	cmd.AddCommand(close.NewCmdClose(ctx))
+	cmd.AddCommand(create.NewCmdCreate(ctx))
+	cmd.AddCommand(export.NewCmdExport(ctx))
+	cmd.AddCommand(importcmd.NewCmdImport(ctx))
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(move.NewCmdMove(ctx))
+	cmd.AddCommand(priority.NewCmdPriority(ctx))
+	cmd.AddCommand(reopen.NewCmdReopen(ctx))
+	cmd.AddCommand(savedquery.NewCmdSavedQuery(ctx))
+	cmd.AddCommand(search.NewCmdSearch(ctx))
+	cmd.AddCommand(show.NewCmdShow(ctx))
+	return cmd
+}
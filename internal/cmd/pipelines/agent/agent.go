@@ -0,0 +1,19 @@
+package agent
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/agent/delete"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/agent/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdAgent(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "agent <command>",
+		Short: "Manage agents of agent pools",
+	}
+
+	cmd.AddCommand(delete.NewCmdAgentDelete(ctx))
+	cmd.AddCommand(list.NewCmdAgentList(ctx))
+	return cmd
+}
@@ -0,0 +1,144 @@
+package delete
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type deleteOptions struct {
+	organizationName string
+	agentID          int
+	poolID           int
+	yes              bool
+	force            bool
+	dryRun           bool
+}
+
+func NewCmdAgentDelete(ctx util.CmdContext) *cobra.Command {
+	opts := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <agent-id> [organization]",
+		Short: "Delete an agent from an agent pool",
+		Long: heredoc.Doc(`
+			Delete an agent from an agent pool.
+
+			If the agent is currently running a job, the agent is not deleted unless --force is given.
+		`),
+		Example: heredoc.Doc(`
+			# delete agent 7 from agent pool 12 in the default organization
+			azdo pipelines agent delete 7 --pool-id 12
+
+			# delete the agent without confirmation, even if it is running a job
+			azdo pipelines agent delete 7 myorg --pool-id 12 --yes --force
+
+			# show which agent would be deleted
+			azdo pipelines agent delete 7 --pool-id 12 --dry-run
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid agent ID: %s", args[0])
+			}
+			opts.agentID = id
+			if len(args) > 1 {
+				opts.organizationName = args[1]
+			}
+			return runDelete(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.poolID, "pool-id", 0, "ID of the agent pool containing the agent")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Delete the agent even if it is running a job")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be deleted without deleting it")
+	_ = cmd.MarkFlagRequired("pool-id")
+
+	return cmd
+}
+
+func runDelete(ctx util.CmdContext, opts *deleteOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	pool, err := client.GetAgentPool(rctx, taskagent.GetAgentPoolArgs{
+		PoolId: &opts.poolID,
+	})
+	if err != nil {
+		return
+	}
+	agent, err := client.GetAgent(rctx, taskagent.GetAgentArgs{
+		PoolId:                 &opts.poolID,
+		AgentId:                &opts.agentID,
+		IncludeAssignedRequest: lo.ToPtr(true),
+	})
+	if err != nil {
+		return
+	}
+
+	cs := iostrms.ColorScheme()
+	if agent.AssignedRequest != nil {
+		if !opts.force {
+			return fmt.Errorf("agent %s in pool %s is currently running a job; use --force to delete it anyway", *agent.Name, *pool.Name)
+		}
+		fmt.Fprintf(iostrms.ErrOut, "%s Agent %s is currently running a job\n", cs.WarningIcon(), cs.Bold(*agent.Name))
+	}
+
+	if !opts.yes && !opts.dryRun {
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--yes required when not running interactively")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		confirmed, err := p.Confirm(fmt.Sprintf("Delete agent %s from pool %s?", *agent.Name, *pool.Name), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return util.ErrCancel
+		}
+	}
+
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("delete agent %s from pool %s", *agent.Name, *pool.Name), func() error {
+		return client.DeleteAgent(rctx, taskagent.DeleteAgentArgs{
+			PoolId:  &opts.poolID,
+			AgentId: &opts.agentID,
+		})
+	})
+	if err != nil || opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		fmt.Fprintf(iostrms.Out, "%s Deleted agent %s from pool %s\n", cs.SuccessIcon(), cs.Bold(*agent.Name), cs.Bold(*pool.Name))
+	}
+	return
+}
@@ -0,0 +1,141 @@
+package list
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// demandOperators lists the supported comparison operators. Operators sharing a prefix
+// must be listed with the longest operator first.
+var demandOperators = []string{">=", "<=", "==", "!=", ">", "<", "="}
+
+// demand is a single requirement an agent capability must satisfy. A demand without
+// an operator only requires the capability to exist.
+type demand struct {
+	name     string
+	operator string
+	value    string
+}
+
+func (d demand) String() string {
+	if d.operator == "" {
+		return d.name
+	}
+	return fmt.Sprintf("%s %s %s", d.name, d.operator, d.value)
+}
+
+// parseCapability parses a KEY=VALUE capability filter. A filter without a value
+// matches all agents which have the capability.
+func parseCapability(s string) (demand, error) {
+	name, value, found := strings.Cut(s, "=")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return demand{}, fmt.Errorf("invalid capability filter %q: expected KEY=VALUE", s)
+	}
+	if !found {
+		return demand{name: name}, nil
+	}
+	return demand{
+		name:     name,
+		operator: "==",
+		value:    strings.TrimSpace(value),
+	}, nil
+}
+
+// parseDemand parses a demand expression like "Agent.Version >= 2.200" or "java".
+func parseDemand(s string) (demand, error) {
+	expr := strings.TrimSpace(s)
+	for _, op := range demandOperators {
+		idx := strings.Index(expr, op)
+		if idx < 0 {
+			continue
+		}
+		d := demand{
+			name:     strings.TrimSpace(expr[:idx]),
+			operator: op,
+			value:    strings.TrimSpace(expr[idx+len(op):]),
+		}
+		if d.operator == "=" {
+			d.operator = "=="
+		}
+		if d.name == "" || d.value == "" {
+			return demand{}, fmt.Errorf("invalid demand expression %q", s)
+		}
+		return d, nil
+	}
+	if expr == "" || strings.ContainsAny(expr, " \t") {
+		return demand{}, fmt.Errorf("invalid demand expression %q", s)
+	}
+	return demand{name: expr}, nil
+}
+
+// match reports whether the capabilities satisfy the demand and returns the name of
+// the matching capability.
+func (d demand) match(capabilities map[string]string) (string, bool) {
+	for name, value := range capabilities {
+		if !strings.EqualFold(name, d.name) {
+			continue
+		}
+		if d.operator == "" {
+			return name, true
+		}
+		cmp := compareCapabilityValues(value, d.value)
+		var ok bool
+		switch d.operator {
+		case "==":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		}
+		return name, ok
+	}
+	return "", false
+}
+
+// compareCapabilityValues compares two capability values. Values consisting of dot separated
+// numbers are compared as versions, all other values are compared case-insensitively.
+func compareCapabilityValues(a, b string) int {
+	va, aok := parseVersion(a)
+	vb, bok := parseVersion(b)
+	if !aok || !bok {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}
+	for i := 0; i < len(va) || i < len(vb); i++ {
+		var x, y int
+		if i < len(va) {
+			x = va[i]
+		}
+		if i < len(vb) {
+			y = vb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func parseVersion(s string) ([]int, bool) {
+	parts := strings.Split(s, ".")
+	version := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, false
+		}
+		version = append(version, n)
+	}
+	return version, true
+}
@@ -0,0 +1,88 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDemand(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    demand
+		wantErr bool
+	}{
+		{
+			expr: "Agent.Version >= 2.200",
+			want: demand{name: "Agent.Version", operator: ">=", value: "2.200"},
+		},
+		{
+			expr: "Agent.OS=Linux",
+			want: demand{name: "Agent.OS", operator: "==", value: "Linux"},
+		},
+		{
+			expr: "docker",
+			want: demand{name: "docker"},
+		},
+		{
+			expr:    "java 11",
+			wantErr: true,
+		},
+		{
+			expr:    ">= 2",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			d, err := parseDemand(tt.expr)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, d)
+		})
+	}
+}
+
+func TestParseCapability(t *testing.T) {
+	d, err := parseCapability("Agent.OS=Linux")
+	assert.NoError(t, err)
+	assert.Equal(t, demand{name: "Agent.OS", operator: "==", value: "Linux"}, d)
+
+	d, err = parseCapability("docker")
+	assert.NoError(t, err)
+	assert.Equal(t, demand{name: "docker"}, d)
+
+	_, err = parseCapability("=Linux")
+	assert.Error(t, err)
+}
+
+func TestDemandMatch(t *testing.T) {
+	capabilities := map[string]string{
+		"Agent.Version": "2.210.1",
+		"Agent.OS":      "Linux",
+		"docker":        "",
+	}
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: "Agent.Version >= 2.200", want: true},
+		{expr: "Agent.Version < 2.200", want: false},
+		{expr: "Agent.Version > 2.9", want: true},
+		{expr: "agent.os == linux", want: true},
+		{expr: "Agent.OS != Linux", want: false},
+		{expr: "docker", want: true},
+		{expr: "java", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			d, err := parseDemand(tt.expr)
+			assert.NoError(t, err)
+			_, ok := d.match(capabilities)
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+}
@@ -0,0 +1,176 @@
+package list
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type listOptions struct {
+	organizationName string
+	poolID           int
+	capabilities     []string
+	demandFilters    []string
+	format           string
+}
+
+func NewCmdAgentList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization]",
+		Short: "List the agents of an agent pool",
+		Long: heredoc.Doc(`
+			List the agents of an agent pool.
+
+			Agents can be filtered by their system or user capabilities. All filters must match
+			for an agent to be listed.
+		`),
+		Example: heredoc.Doc(`
+			# list the agents of agent pool 12 in the default organization
+			azdo pipelines agent list --pool-id 12
+
+			# list all Linux agents which have docker installed
+			azdo pipelines agent list myorg --pool-id 12 --capability Agent.OS=Linux --capability docker
+
+			# list all agents with a minimum agent version
+			azdo pipelines agent list --pool-id 12 --demand-filter "Agent.Version >= 2.200"
+		`),
+		Args:    cobra.MaximumNArgs(1),
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.organizationName = args[0]
+			}
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.poolID, "pool-id", 0, "ID of the agent pool")
+	cmd.Flags().StringArrayVar(&opts.capabilities, "capability", nil, "Only list agents with the capability KEY[=VALUE]; can be repeated")
+	cmd.Flags().StringArrayVar(&opts.demandFilters, "demand-filter", nil, "Only list agents satisfying the demand expression, e.g. \"Agent.Version >= 2.200\"; can be repeated")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+	_ = cmd.MarkFlagRequired("pool-id")
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	demands := []demand{}
+	for _, c := range opts.capabilities {
+		d, err := parseCapability(c)
+		if err != nil {
+			return util.FlagErrorWrap(err)
+		}
+		demands = append(demands, d)
+	}
+	for _, f := range opts.demandFilters {
+		d, err := parseDemand(f)
+		if err != nil {
+			return util.FlagErrorWrap(err)
+		}
+		demands = append(demands, d)
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	res, err := client.GetAgents(rctx, taskagent.GetAgentsArgs{
+		PoolId:              &opts.poolID,
+		IncludeCapabilities: lo.ToPtr(len(demands) > 0),
+	})
+	if err != nil {
+		return
+	}
+
+	type agentMatch struct {
+		agent   taskagent.TaskAgent
+		matched []string
+	}
+	agents := []agentMatch{}
+	for _, a := range *res {
+		capabilities := map[string]string{}
+		if a.SystemCapabilities != nil {
+			for k, v := range *a.SystemCapabilities {
+				capabilities[k] = v
+			}
+		}
+		if a.UserCapabilities != nil {
+			for k, v := range *a.UserCapabilities {
+				capabilities[k] = v
+			}
+		}
+		matched := []string{}
+		for _, d := range demands {
+			name, ok := d.match(capabilities)
+			if !ok {
+				break
+			}
+			if v := capabilities[name]; v != "" {
+				name = fmt.Sprintf("%s=%s", name, v)
+			}
+			matched = append(matched, name)
+		}
+		if len(matched) != len(demands) {
+			continue
+		}
+		agents = append(agents, agentMatch{
+			agent:   a,
+			matched: matched,
+		})
+	}
+
+	if len(agents) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No agents found in pool %d for organization %s", opts.poolID, organizationName))
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+
+	cs := iostrms.ColorScheme()
+	columns := []string{"ID", "Name", "Status", "Enabled", "Version"}
+	if len(demands) > 0 {
+		columns = append(columns, "Capabilities")
+	}
+	tp.AddColumns(columns...)
+	for _, m := range agents {
+		tp.AddField(strconv.Itoa(*m.agent.Id), printer.WithTruncate(nil))
+		tp.AddField(*m.agent.Name)
+		tp.AddField(string(lo.FromPtr(m.agent.Status)))
+		tp.AddField(strconv.FormatBool(lo.FromPtr(m.agent.Enabled)))
+		tp.AddField(lo.FromPtr(m.agent.Version))
+		if len(demands) > 0 {
+			tp.AddField(strings.Join(m.matched, ", "), printer.WithColor(cs.Green))
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,19 @@
+package definition
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/definition/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/definition/update"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdDefinition(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "definition <command>",
+		Short: "Manage pipeline definitions",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(update.NewCmdUpdate(ctx))
+	return cmd
+}
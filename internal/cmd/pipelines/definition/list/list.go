@@ -0,0 +1,289 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// folderSeparator separates the folders of the path of a pipeline definition.
+const folderSeparator = `\`
+
+type listOptions struct {
+	scope     string
+	folder    string
+	recursive bool
+	limit     int
+	format    string
+}
+
+type definitionResult struct {
+	ID          int          `json:"id"`
+	Name        string       `json:"name"`
+	Type        string       `json:"type"`
+	Path        string       `json:"path"`
+	LatestBuild *build.Build `json:"latestBuild,omitempty"`
+}
+
+// row is a row of the table. Rows without definition are folders.
+type row struct {
+	depth      int
+	folder     string
+	definition *build.BuildDefinitionReference
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List pipeline definitions",
+		Long: heredoc.Doc(`
+			List the pipeline definitions of a project together with their latest run.
+
+			With --folder only the definitions in this folder are listed. Adding --recursive includes
+			the definitions of all subfolders and shows them as a tree of folders.
+		`),
+		Example: heredoc.Doc(`
+			# list the pipeline definitions of a project
+			azdo pipelines definition list myproject
+
+			# show the definitions below the folder Services as tree
+			azdo pipelines definition list myorg/myproject --folder Services --recursive
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list definitions: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.folder, "folder", "", "Only list definitions in this folder")
+	cmd.Flags().BoolVar(&opts.recursive, "recursive", false, "Include the definitions of the subfolders of --folder")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 50, "Maximum number of definitions to list")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	if opts.limit < 1 {
+		return util.FlagErrorf("invalid value for --limit: %d", opts.limit)
+	}
+	if opts.recursive && opts.folder == "" {
+		return util.FlagErrorf("--recursive requires --folder")
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	folder := ""
+	if opts.folder != "" {
+		folder = normalizeFolder(opts.folder)
+	}
+	definitions, err := getDefinitions(rctx, client, project, folder, opts.recursive, opts.limit)
+	if err != nil {
+		return
+	}
+	iostrms.StopProgressIndicator()
+
+	if len(definitions) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No pipeline definitions found in project %s", project))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(lo.Map(definitions, func(d build.BuildDefinitionReference, _ int) definitionResult {
+			return definitionResult{
+				ID:          lo.FromPtr(d.Id),
+				Name:        lo.FromPtr(d.Name),
+				Type:        string(lo.FromPtr(d.Type)),
+				Path:        lo.FromPtr(d.Path),
+				LatestBuild: d.LatestBuild,
+			}
+		}))
+	}
+
+	var rows []row
+	if opts.recursive {
+		rows = treeRows(definitions, folder)
+	} else {
+		rows = lo.Map(definitions, func(d build.BuildDefinitionReference, i int) row {
+			return row{definition: &definitions[i]}
+		})
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Name", "Type", "Folder", "Latest Run", "Latest Status")
+	for _, r := range rows {
+		indent := strings.Repeat("  ", r.depth)
+		if r.definition == nil {
+			tp.AddField("")
+			tp.AddField(indent + r.folder + folderSeparator)
+			tp.AddField("")
+			tp.AddField("")
+			tp.AddField("")
+			tp.AddField("")
+			tp.EndRow()
+			continue
+		}
+		d := r.definition
+		tp.AddField(strconv.Itoa(lo.FromPtr(d.Id)), printer.WithTruncate(nil))
+		tp.AddField(indent + lo.FromPtr(d.Name))
+		tp.AddField(string(lo.FromPtr(d.Type)))
+		tp.AddField(lo.FromPtr(d.Path))
+		if b := d.LatestBuild; b != nil {
+			tp.AddField(lo.FromPtr(b.BuildNumber))
+			tp.AddField(buildStatus(b))
+		} else {
+			tp.AddField("")
+			tp.AddField("")
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// getDefinitions returns up to limit definitions ordered by folder and name. If folder is not empty,
+// only definitions in this folder, or with recursive also in its subfolders, are returned.
+func getDefinitions(ctx context.Context, client build.Client, project, folder string, recursive bool, limit int) ([]build.BuildDefinitionReference, error) {
+	args := build.GetDefinitionsArgs{
+		Project:             &project,
+		QueryOrder:          &build.DefinitionQueryOrderValues.DefinitionNameAscending,
+		IncludeLatestBuilds: lo.ToPtr(true),
+	}
+	if folder != "" && !recursive {
+		args.Path = &folder
+	}
+	var definitions []build.BuildDefinitionReference
+	for {
+		res, err := client.GetDefinitions(ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get pipeline definitions of project %s: %w", project, err)
+		}
+		for _, d := range res.Value {
+			if folder == "" || inFolder(lo.FromPtr(d.Path), folder, recursive) {
+				definitions = append(definitions, d)
+			}
+		}
+		if len(definitions) >= limit || res.ContinuationToken == "" {
+			break
+		}
+		args.ContinuationToken = &res.ContinuationToken
+	}
+	if len(definitions) > limit {
+		definitions = definitions[:limit]
+	}
+	sortDefinitions(definitions)
+	return definitions, nil
+}
+
+// sortDefinitions orders the definitions by folder, keeping the order of definitions in the same folder.
+func sortDefinitions(definitions []build.BuildDefinitionReference) {
+	sort.SliceStable(definitions, func(i, j int) bool {
+		return lessPath(lo.FromPtr(definitions[i].Path), lo.FromPtr(definitions[j].Path))
+	})
+}
+
+// normalizeFolder converts a folder given by the user into the form used by Azure DevOps, which
+// separates folders by backslashes and starts with a backslash.
+func normalizeFolder(folder string) string {
+	folder = strings.ReplaceAll(folder, "/", folderSeparator)
+	return folderSeparator + strings.Trim(folder, folderSeparator)
+}
+
+func inFolder(path, folder string, recursive bool) bool {
+	if strings.EqualFold(path, folder) {
+		return true
+	}
+	if !recursive {
+		return false
+	}
+	prefix := strings.TrimSuffix(folder, folderSeparator) + folderSeparator
+	return len(path) > len(prefix) && strings.EqualFold(path[:len(prefix)], prefix)
+}
+
+// treeRows returns the rows of a tree of the definitions below the root folder. The definitions
+// must be ordered with lessPath. Each folder below the root is a row, followed by its definitions and
+// subfolders indented by one level.
+func treeRows(definitions []build.BuildDefinitionReference, root string) []row {
+	var rows []row
+	var open []string
+	for i := range definitions {
+		d := &definitions[i]
+		segments := folderSegments(lo.FromPtr(d.Path)[len(root):])
+		common := 0
+		for common < len(open) && common < len(segments) && strings.EqualFold(open[common], segments[common]) {
+			common++
+		}
+		open = open[:common]
+		for _, s := range segments[common:] {
+			rows = append(rows, row{depth: len(open), folder: s})
+			open = append(open, s)
+		}
+		rows = append(rows, row{depth: len(open), definition: d})
+	}
+	return rows
+}
+
+func buildStatus(b *build.Build) string {
+	if lo.FromPtr(b.Status) == build.BuildStatusValues.Completed && b.Result != nil {
+		return string(*b.Result)
+	}
+	return string(lo.FromPtr(b.Status))
+}
+
+func folderSegments(path string) []string {
+	path = strings.Trim(path, folderSeparator)
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, folderSeparator)
+}
+
+// lessPath orders paths folder by folder, so that subfolders directly follow their parent.
+func lessPath(a, b string) bool {
+	as, bs := folderSegments(a), folderSegments(b)
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if x, y := strings.ToLower(as[i]), strings.ToLower(bs[i]); x != y {
+			return x < y
+		}
+	}
+	return len(as) < len(bs)
+}
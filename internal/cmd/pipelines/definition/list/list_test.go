@@ -0,0 +1,77 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeFolder(t *testing.T) {
+	assert.Equal(t, `\Services\Api`, normalizeFolder("Services/Api"))
+	assert.Equal(t, `\Services\Api`, normalizeFolder(`\Services\Api\`))
+	assert.Equal(t, `\`, normalizeFolder("/"))
+}
+
+func TestInFolder(t *testing.T) {
+	tests := []struct {
+		path      string
+		folder    string
+		recursive bool
+		want      bool
+	}{
+		{path: `\Services`, folder: `\Services`, want: true},
+		{path: `\services`, folder: `\Services`, want: true},
+		{path: `\Services\Api`, folder: `\Services`, want: false},
+		{path: `\Services\Api`, folder: `\Services`, recursive: true, want: true},
+		{path: `\ServicesOld`, folder: `\Services`, recursive: true, want: false},
+		{path: `\Services`, folder: `\`, recursive: true, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, inFolder(tt.path, tt.folder, tt.recursive))
+		})
+	}
+}
+
+func TestTreeRows(t *testing.T) {
+	def := func(id int, path string) build.BuildDefinitionReference {
+		return build.BuildDefinitionReference{Id: lo.ToPtr(id), Path: lo.ToPtr(path)}
+	}
+	definitions := []build.BuildDefinitionReference{
+		def(4, `\Services\Web`),
+		def(1, `\Services`),
+		def(2, `\Services\Api`),
+		def(5, `\Services-Old`),
+		def(3, `\Services\Api\Jobs`),
+	}
+	definitions = lo.Filter(definitions, func(d build.BuildDefinitionReference, _ int) bool {
+		return inFolder(*d.Path, `\Services`, true)
+	})
+	sortDefinitions(definitions)
+
+	rows := treeRows(definitions, `\Services`)
+
+	type flatRow struct {
+		depth  int
+		folder string
+		id     int
+	}
+	got := lo.Map(rows, func(r row, _ int) flatRow {
+		f := flatRow{depth: r.depth, folder: r.folder}
+		if r.definition != nil {
+			f.id = *r.definition.Id
+		}
+		return f
+	})
+	assert.Equal(t, []flatRow{
+		{depth: 0, id: 1},
+		{depth: 0, folder: "Api"},
+		{depth: 1, id: 2},
+		{depth: 1, folder: "Jobs"},
+		{depth: 2, id: 3},
+		{depth: 0, folder: "Web"},
+		{depth: 1, id: 4},
+	}, got)
+}
@@ -0,0 +1,185 @@
+package update
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type updateOptions struct {
+	definitionID    int
+	scope           string
+	name            string
+	defaultBranch   string
+	variables       []string
+	deleteVariables []string
+}
+
+func NewCmdUpdate(ctx util.CmdContext) *cobra.Command {
+	opts := &updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "update <id> [organization/]project",
+		Short: "Update a pipeline definition",
+		Long: heredoc.Doc(`
+			Update the name, default branch or variables of a pipeline definition.
+
+			Variables set with --variable keep their secret and settable-at-queue-time flags if they
+			already exist. Variables removed with --delete-variable must exist. Variable names are
+			matched case-insensitively.
+		`),
+		Example: heredoc.Doc(`
+			# change the default value of a pipeline variable
+			azdo pipelines definition update 12 myproject --variable configuration=Release
+
+			# rename a pipeline and build the main branch by default
+			azdo pipelines definition update 12 myorg/myproject --name ci-main --default-branch main
+
+			# remove an obsolete variable
+			azdo pipelines definition update 12 myproject --delete-variable legacyFlag
+		`),
+		Args: util.ExactArgs(2, "cannot update pipeline definition: ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid pipeline definition ID: %s", args[0])
+			}
+			opts.definitionID = id
+			opts.scope = args[1]
+
+			if opts.name == "" && opts.defaultBranch == "" && len(opts.variables) == 0 && len(opts.deleteVariables) == 0 {
+				return util.FlagErrorf("nothing to update; specify at least one of --name, --default-branch, --variable or --delete-variable")
+			}
+
+			return runUpdate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.name, "name", "", "New name of the pipeline definition")
+	cmd.Flags().StringVar(&opts.defaultBranch, "default-branch", "", "Default branch of the pipeline definition")
+	cmd.Flags().StringArrayVar(&opts.variables, "variable", nil, "Set a variable in the form KEY=VALUE; can be repeated")
+	cmd.Flags().StringArrayVar(&opts.deleteVariables, "delete-variable", nil, "Delete a variable; can be repeated")
+
+	return cmd
+}
+
+func runUpdate(ctx util.CmdContext, opts *updateOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	definition, err := client.GetDefinition(rctx, build.GetDefinitionArgs{
+		Project:      &project,
+		DefinitionId: &opts.definitionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get pipeline definition %d: %w", opts.definitionID, err)
+	}
+
+	if err := applyChanges(definition, opts); err != nil {
+		return err
+	}
+
+	updated, err := client.UpdateDefinition(rctx, build.UpdateDefinitionArgs{
+		Definition:   definition,
+		Project:      &project,
+		DefinitionId: &opts.definitionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update pipeline definition %d: %w", opts.definitionID, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Updated pipeline definition %s (%d), revision %d\n", cs.SuccessIcon(), cs.Bold(lo.FromPtr(updated.Name)), opts.definitionID, lo.FromPtr(updated.Revision))
+	}
+	return
+}
+
+// applyChanges applies the requested changes to the definition.
+func applyChanges(definition *build.BuildDefinition, opts *updateOptions) error {
+	if opts.name != "" {
+		definition.Name = &opts.name
+	}
+	if opts.defaultBranch != "" {
+		if definition.Repository == nil {
+			return fmt.Errorf("pipeline definition %d has no repository", opts.definitionID)
+		}
+		definition.Repository.DefaultBranch = lo.ToPtr(util.NormalizeBranchRef(opts.defaultBranch))
+	}
+
+	if len(opts.variables) == 0 && len(opts.deleteVariables) == 0 {
+		return nil
+	}
+	variables := lo.FromPtr(definition.Variables)
+	if variables == nil {
+		variables = map[string]build.BuildDefinitionVariable{}
+	}
+
+	var missing []string
+	for _, name := range opts.deleteVariables {
+		key, ok := findVariable(variables, name)
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		delete(variables, key)
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return util.FlagErrorf("cannot delete variables which do not exist: %s", strings.Join(missing, ", "))
+	}
+
+	for _, v := range opts.variables {
+		name, value, ok := strings.Cut(v, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return util.FlagErrorf("invalid variable %q; expected KEY=VALUE", v)
+		}
+		key, ok := findVariable(variables, name)
+		if !ok {
+			key = name
+		}
+		variable := variables[key]
+		variable.Value = &value
+		variables[key] = variable
+	}
+	definition.Variables = &variables
+	return nil
+}
+
+// findVariable returns the key of the variable with the name, ignoring case.
+func findVariable(variables map[string]build.BuildDefinitionVariable, name string) (string, bool) {
+	return lo.FindKeyBy(variables, func(k string, _ build.BuildDefinitionVariable) bool {
+		return strings.EqualFold(k, name)
+	})
+}
@@ -0,0 +1,52 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyChanges(t *testing.T) {
+	newDefinition := func() *build.BuildDefinition {
+		return &build.BuildDefinition{
+			Name:       lo.ToPtr("ci"),
+			Repository: &build.BuildRepository{DefaultBranch: lo.ToPtr("refs/heads/master")},
+			Variables: &map[string]build.BuildDefinitionVariable{
+				"Configuration": {Value: lo.ToPtr("Debug"), AllowOverride: lo.ToPtr(true)},
+				"token":         {IsSecret: lo.ToPtr(true)},
+			},
+		}
+	}
+
+	t.Run("name, branch and variables", func(t *testing.T) {
+		def := newDefinition()
+		err := applyChanges(def, &updateOptions{
+			name:            "ci-main",
+			defaultBranch:   "main",
+			variables:       []string{"configuration=Release", "platform=x64"},
+			deleteVariables: []string{"TOKEN"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ci-main", *def.Name)
+		assert.Equal(t, "refs/heads/main", *def.Repository.DefaultBranch)
+		assert.Equal(t, map[string]build.BuildDefinitionVariable{
+			"Configuration": {Value: lo.ToPtr("Release"), AllowOverride: lo.ToPtr(true)},
+			"platform":      {Value: lo.ToPtr("x64")},
+		}, *def.Variables)
+	})
+
+	t.Run("delete missing variables", func(t *testing.T) {
+		def := newDefinition()
+		err := applyChanges(def, &updateOptions{deleteVariables: []string{"b", "token", "a"}})
+		assert.EqualError(t, err, "cannot delete variables which do not exist: a, b")
+	})
+
+	t.Run("invalid variable", func(t *testing.T) {
+		def := newDefinition()
+		err := applyChanges(def, &updateOptions{variables: []string{"novalue"}})
+		assert.EqualError(t, err, `invalid variable "novalue"; expected KEY=VALUE`)
+	})
+}
@@ -0,0 +1,33 @@
+package pipelines
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/agent"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/definition"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/yaml"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdPipelines(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pipelines <command>",
+		Short: "Work with Azure Pipelines",
+		Long:  `Work with Azure Pipelines, agent pools and agents.`,
+		Example: heredoc.Doc(`
+			$ azdo pipelines pool create myorg --name mypool
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(agent.NewCmdAgent(ctx))
+	cmd.AddCommand(definition.NewCmdDefinition(ctx))
+	cmd.AddCommand(pool.NewCmdPool(ctx))
+	cmd.AddCommand(yaml.NewCmdYaml(ctx))
+	cmd.AddCommand(run.NewCmdRun(ctx))
+	cmd.AddCommand(variablegroup.NewCmdVariableGroup(ctx))
+	return cmd
+}
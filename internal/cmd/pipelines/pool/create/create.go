@@ -0,0 +1,100 @@
+package create
+
+import (
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type createOptions struct {
+	organizationName string
+	name             string
+	poolType         string
+	autoProvision    bool
+	autoUpdate       bool
+	format           string
+}
+
+func NewCmdPoolCreate(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create [organization]",
+		Short: "Create an agent pool",
+		Example: heredoc.Doc(`
+			# create an agent pool in the default organization
+			azdo pipelines pool create --name mypool
+
+			# create a deployment pool which is automatically provisioned in all projects
+			azdo pipelines pool create myorg --name mypool --pool-type deployment --auto-provision
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.organizationName = args[0]
+			}
+			return runCreate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.name, "name", "", "Name of the agent pool")
+	util.StringEnumFlag(cmd, &opts.poolType, "pool-type", "", string(taskagent.TaskAgentPoolTypeValues.Automation),
+		[]string{
+			string(taskagent.TaskAgentPoolTypeValues.Automation),
+			string(taskagent.TaskAgentPoolTypeValues.Deployment),
+		}, "Type of the agent pool")
+	cmd.Flags().BoolVar(&opts.autoProvision, "auto-provision", false, "Automatically provision a queue for the pool in every project")
+	cmd.Flags().BoolVar(&opts.autoUpdate, "auto-update", false, "Allow agents in the pool to update automatically")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func runCreate(ctx util.CmdContext, opts *createOptions) (err error) {
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	poolType := taskagent.TaskAgentPoolType(opts.poolType)
+	pool, err := client.AddAgentPool(rctx, taskagent.AddAgentPoolArgs{
+		Pool: &taskagent.TaskAgentPool{
+			Name:          &opts.name,
+			PoolType:      &poolType,
+			AutoProvision: &opts.autoProvision,
+			AutoUpdate:    &opts.autoUpdate,
+		},
+	})
+	if err != nil {
+		return
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+
+	tp.AddColumns("ID", "Name")
+	tp.AddField(strconv.Itoa(*pool.Id), printer.WithTruncate(nil))
+	tp.AddField(*pool.Name)
+	tp.EndRow()
+	return tp.Render()
+}
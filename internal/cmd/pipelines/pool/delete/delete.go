@@ -0,0 +1,140 @@
+package delete
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type deleteOptions struct {
+	organizationName string
+	poolID           int
+	yes              bool
+	force            bool
+	dryRun           bool
+}
+
+func NewCmdPoolDelete(ctx util.CmdContext) *cobra.Command {
+	opts := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <pool-id> [organization]",
+		Short: "Delete an agent pool",
+		Long: heredoc.Doc(`
+			Delete an agent pool.
+
+			The pool is only deleted if none of its agents are online, unless --force is given.
+		`),
+		Example: heredoc.Doc(`
+			# delete the agent pool with ID 12 in the default organization
+			azdo pipelines pool delete 12
+
+			# delete the agent pool without confirmation even if agents are online
+			azdo pipelines pool delete 12 myorg --yes --force
+
+			# show which agent pool would be deleted
+			azdo pipelines pool delete 12 --dry-run
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid pool ID: %s", args[0])
+			}
+			opts.poolID = id
+			if len(args) > 1 {
+				opts.organizationName = args[1]
+			}
+			return runDelete(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Delete the pool even if agents are online")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be deleted without deleting it")
+
+	return cmd
+}
+
+func runDelete(ctx util.CmdContext, opts *deleteOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	pool, err := client.GetAgentPool(rctx, taskagent.GetAgentPoolArgs{
+		PoolId: &opts.poolID,
+	})
+	if err != nil {
+		return
+	}
+
+	if !opts.force {
+		agents, err := client.GetAgents(rctx, taskagent.GetAgentsArgs{
+			PoolId: &opts.poolID,
+		})
+		if err != nil {
+			return err
+		}
+		online := lo.CountBy(lo.FromPtr(agents), func(a taskagent.TaskAgent) bool {
+			return a.Status != nil && *a.Status == taskagent.TaskAgentStatusValues.Online
+		})
+		if online > 0 {
+			return fmt.Errorf("agent pool %s has %d online agent(s); use --force to delete it anyway", *pool.Name, online)
+		}
+	}
+
+	if !opts.yes && !opts.dryRun {
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--yes required when not running interactively")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		confirmed, err := p.Confirm(fmt.Sprintf("Delete agent pool %s (%d)?", *pool.Name, *pool.Id), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return util.ErrCancel
+		}
+	}
+
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("delete agent pool %s (%d)", *pool.Name, *pool.Id), func() error {
+		return client.DeleteAgentPool(rctx, taskagent.DeleteAgentPoolArgs{
+			PoolId: &opts.poolID,
+		})
+	})
+	if err != nil || opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Deleted agent pool %s (%d)\n", cs.SuccessIcon(), cs.Bold(*pool.Name), *pool.Id)
+	}
+	return
+}
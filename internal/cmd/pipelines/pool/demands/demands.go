@@ -0,0 +1,164 @@
+package demands
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type demandsOptions struct {
+	organizationName string
+	poolID           int
+	format           string
+}
+
+// capability is a capability of the agents of a pool
+type capability struct {
+	Name        string `json:"name"`
+	Value       string `json:"value"`
+	AgentCount  int    `json:"agentCount"`
+	TotalAgents int    `json:"totalAgents"`
+}
+
+func NewCmdPoolAgentDemands(ctx util.CmdContext) *cobra.Command {
+	opts := &demandsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "agent-demands <pool-id> [organization]",
+		Short: "List the capabilities of the agents of a pool",
+		Long: heredoc.Doc(`
+			List the capabilities of all agents of a pool, which are the demands pipelines can use
+			to select agents of the pool.
+
+			For each capability the most common value among the agents and the number of agents
+			which have the capability are listed.
+		`),
+		Example: heredoc.Doc(`
+			# list the demands which can be satisfied by agent pool 12
+			azdo pipelines pool agent-demands 12 myorg
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid pool ID: %s", args[0])
+			}
+			opts.poolID = id
+			if len(args) > 1 {
+				opts.organizationName = args[1]
+			}
+			return runDemands(ctx, opts)
+		},
+	}
+
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runDemands(ctx util.CmdContext, opts *demandsOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	agents, err := client.GetAgents(rctx, taskagent.GetAgentsArgs{
+		PoolId:              &opts.poolID,
+		IncludeCapabilities: lo.ToPtr(true),
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get agents of pool %d: %w", opts.poolID, err)
+	}
+	if len(lo.FromPtr(agents)) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No agents found in pool %d", opts.poolID))
+	}
+
+	capabilities := aggregateCapabilities(*agents)
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(capabilities)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Capability", "Value", "Agent Count", "Total Agents")
+	for _, c := range capabilities {
+		tp.AddField(c.Name)
+		tp.AddField(c.Value)
+		tp.AddField(strconv.Itoa(c.AgentCount), printer.WithTruncate(nil))
+		tp.AddField(strconv.Itoa(c.TotalAgents), printer.WithTruncate(nil))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// aggregateCapabilities returns the system and user capabilities of the agents ordered by name. The
+// value of a capability is the most common value among the agents; ties are broken by the smallest value.
+func aggregateCapabilities(agents []taskagent.TaskAgent) []capability {
+	values := map[string]map[string]int{}
+	for _, a := range agents {
+		merged := map[string]string{}
+		for k, v := range lo.FromPtr(a.SystemCapabilities) {
+			merged[k] = v
+		}
+		// user capabilities override system capabilities of the same name
+		for k, v := range lo.FromPtr(a.UserCapabilities) {
+			merged[k] = v
+		}
+		for k, v := range merged {
+			if values[k] == nil {
+				values[k] = map[string]int{}
+			}
+			values[k][v]++
+		}
+	}
+
+	capabilities := make([]capability, 0, len(values))
+	for name, counts := range values {
+		c := capability{
+			Name:        name,
+			TotalAgents: len(agents),
+		}
+		best := -1
+		for v, n := range counts {
+			c.AgentCount += n
+			if n > best || (n == best && v < c.Value) {
+				c.Value, best = v, n
+			}
+		}
+		capabilities = append(capabilities, c)
+	}
+	sort.Slice(capabilities, func(i, j int) bool {
+		return capabilities[i].Name < capabilities[j].Name
+	})
+	return capabilities
+}
@@ -0,0 +1,30 @@
+package demands
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateCapabilities(t *testing.T) {
+	agents := []taskagent.TaskAgent{
+		{
+			SystemCapabilities: &map[string]string{"Agent.OS": "Linux", "docker": "/usr/bin/docker"},
+			UserCapabilities:   &map[string]string{"gpu": "true"},
+		},
+		{
+			SystemCapabilities: &map[string]string{"Agent.OS": "Linux"},
+		},
+		{
+			SystemCapabilities: &map[string]string{"Agent.OS": "Windows_NT", "docker": "/usr/local/bin/docker"},
+			UserCapabilities:   &map[string]string{"Agent.OS": "Darwin"},
+		},
+	}
+
+	assert.Equal(t, []capability{
+		{Name: "Agent.OS", Value: "Linux", AgentCount: 3, TotalAgents: 3},
+		{Name: "docker", Value: "/usr/bin/docker", AgentCount: 2, TotalAgents: 3},
+		{Name: "gpu", Value: "true", AgentCount: 1, TotalAgents: 3},
+	}, aggregateCapabilities(agents))
+}
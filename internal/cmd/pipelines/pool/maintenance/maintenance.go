@@ -0,0 +1,21 @@
+package maintenance
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool/maintenance/start"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool/maintenance/status"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdMaintenance(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "maintenance <command>",
+		Short: "Manage maintenance jobs of agent pools",
+		Long: `Maintenance jobs clean up the working directories and repositories of the agents of a pool.
+The maintenance settings of a pool are configured in the Azure DevOps web UI.`,
+	}
+
+	cmd.AddCommand(start.NewCmdStart(ctx))
+	cmd.AddCommand(status.NewCmdStatus(ctx))
+	return cmd
+}
@@ -0,0 +1,168 @@
+package shared
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// maintenanceJobsLocationID is the location of the maintenance jobs of an agent pool, which are not
+// exposed by the taskagent client of the SDK.
+var maintenanceJobsLocationID = uuid.MustParse("15e7ab6e-abce-4601-a6d8-e111fe148f46")
+
+const apiVersion = "7.1-preview.1"
+
+// PollInterval is the interval in which the status of a maintenance job is checked while waiting for it
+var PollInterval = 5 * time.Second
+
+// Client manages the maintenance jobs of agent pools.
+type Client interface {
+	// QueueMaintenanceJob starts a maintenance job for the pool.
+	QueueMaintenanceJob(ctx context.Context, poolID int) (*taskagent.TaskAgentPoolMaintenanceJob, error)
+	// GetMaintenanceJob returns a maintenance job of the pool.
+	GetMaintenanceJob(ctx context.Context, poolID, jobID int) (*taskagent.TaskAgentPoolMaintenanceJob, error)
+	// GetMaintenanceJobs returns the maintenance jobs of the pool.
+	GetMaintenanceJobs(ctx context.Context, poolID int) ([]taskagent.TaskAgentPoolMaintenanceJob, error)
+}
+
+type maintenanceClient struct {
+	client *azuredevops.Client
+}
+
+func NewClient(ctx context.Context, conn *azuredevops.Connection) (Client, error) {
+	c, err := conn.GetClientByResourceAreaId(ctx, taskagent.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &maintenanceClient{client: c}, nil
+}
+
+func (c *maintenanceClient) QueueMaintenanceJob(ctx context.Context, poolID int) (*taskagent.TaskAgentPoolMaintenanceJob, error) {
+	body, err := json.Marshal(taskagent.TaskAgentPoolMaintenanceJob{
+		Pool: &taskagent.TaskAgentPoolReference{Id: &poolID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Send(ctx, http.MethodPost, maintenanceJobsLocationID, apiVersion, map[string]string{
+		"poolId": strconv.Itoa(poolID),
+	}, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	var job taskagent.TaskAgentPoolMaintenanceJob
+	err = c.client.UnmarshalBody(resp, &job)
+	return &job, err
+}
+
+func (c *maintenanceClient) GetMaintenanceJob(ctx context.Context, poolID, jobID int) (*taskagent.TaskAgentPoolMaintenanceJob, error) {
+	resp, err := c.client.Send(ctx, http.MethodGet, maintenanceJobsLocationID, apiVersion, map[string]string{
+		"poolId": strconv.Itoa(poolID),
+		"jobId":  strconv.Itoa(jobID),
+	}, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	var job taskagent.TaskAgentPoolMaintenanceJob
+	err = c.client.UnmarshalBody(resp, &job)
+	return &job, err
+}
+
+func (c *maintenanceClient) GetMaintenanceJobs(ctx context.Context, poolID int) ([]taskagent.TaskAgentPoolMaintenanceJob, error) {
+	resp, err := c.client.Send(ctx, http.MethodGet, maintenanceJobsLocationID, apiVersion, map[string]string{
+		"poolId": strconv.Itoa(poolID),
+	}, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []taskagent.TaskAgentPoolMaintenanceJob
+	err = c.client.UnmarshalCollectionBody(resp, &jobs)
+	return jobs, err
+}
+
+// IsFinished reports whether the maintenance job has completed.
+func IsFinished(job *taskagent.TaskAgentPoolMaintenanceJob) bool {
+	return lo.FromPtr(job.Status) == taskagent.TaskAgentPoolMaintenanceJobStatusValues.Completed
+}
+
+// State returns the result of a completed maintenance job and the status of all other jobs.
+func State(job *taskagent.TaskAgentPoolMaintenanceJob) string {
+	if IsFinished(job) && job.Result != nil {
+		return string(*job.Result)
+	}
+	return string(lo.FromPtr(job.Status))
+}
+
+// AgentCounts returns the number of agents for which the maintenance job succeeded and failed.
+func AgentCounts(job *taskagent.TaskAgentPoolMaintenanceJob) (succeeded, failed int) {
+	for _, a := range lo.FromPtr(job.TargetAgents) {
+		switch lo.FromPtr(a.Result) {
+		case taskagent.TaskAgentPoolMaintenanceJobResultValues.Succeeded:
+			succeeded++
+		case taskagent.TaskAgentPoolMaintenanceJobResultValues.Failed:
+			failed++
+		}
+	}
+	return
+}
+
+// WaitForJob polls the maintenance job until it has completed and returns the completed job.
+func WaitForJob(ctx context.Context, client Client, poolID, jobID int) (*taskagent.TaskAgentPoolMaintenanceJob, error) {
+	ticker := time.NewTicker(PollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := client.GetMaintenanceJob(ctx, poolID, jobID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get maintenance job %d: %w", jobID, err)
+		}
+		if IsFinished(job) {
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// AddJobRows adds the maintenance jobs to the printer. Times are printed relative to now if tty is true.
+func AddJobRows(tp printer.Printer, jobs []taskagent.TaskAgentPoolMaintenanceJob, tty bool, now time.Time) {
+	timeField := func(t *azuredevops.Time) {
+		switch {
+		case t == nil:
+			tp.AddField("")
+		case tty:
+			tp.AddField(text.FuzzyAgo(now, t.Time))
+		default:
+			tp.AddField(t.Time.Format(time.RFC3339))
+		}
+	}
+
+	tp.AddColumns("Job ID", "State", "Queued On", "Started", "Finished", "Succeeded", "Failed")
+	for i := range jobs {
+		job := &jobs[i]
+		succeeded, failed := AgentCounts(job)
+		tp.AddField(strconv.Itoa(lo.FromPtr(job.JobId)), printer.WithTruncate(nil))
+		tp.AddField(State(job))
+		timeField(job.QueueTime)
+		timeField(job.StartTime)
+		timeField(job.FinishTime)
+		tp.AddField(strconv.Itoa(succeeded), printer.WithTruncate(nil))
+		tp.AddField(strconv.Itoa(failed), printer.WithTruncate(nil))
+		tp.EndRow()
+	}
+}
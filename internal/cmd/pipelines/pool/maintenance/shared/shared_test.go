@@ -0,0 +1,63 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	Client
+	statuses []taskagent.TaskAgentPoolMaintenanceJobStatus
+	calls    int
+}
+
+func (c *fakeClient) GetMaintenanceJob(_ context.Context, _, jobID int) (*taskagent.TaskAgentPoolMaintenanceJob, error) {
+	status := c.statuses[c.calls]
+	c.calls++
+	return &taskagent.TaskAgentPoolMaintenanceJob{
+		JobId:  &jobID,
+		Status: &status,
+		Result: lo.Ternary(status == taskagent.TaskAgentPoolMaintenanceJobStatusValues.Completed, &taskagent.TaskAgentPoolMaintenanceJobResultValues.Succeeded, nil),
+	}, nil
+}
+
+func TestWaitForJob(t *testing.T) {
+	PollInterval = time.Millisecond
+	defer func() { PollInterval = 5 * time.Second }()
+
+	client := &fakeClient{statuses: []taskagent.TaskAgentPoolMaintenanceJobStatus{
+		taskagent.TaskAgentPoolMaintenanceJobStatusValues.Queued,
+		taskagent.TaskAgentPoolMaintenanceJobStatusValues.InProgress,
+		taskagent.TaskAgentPoolMaintenanceJobStatusValues.Completed,
+	}}
+	job, err := WaitForJob(context.Background(), client, 1, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 3, client.calls)
+	assert.Equal(t, "succeeded", State(job))
+}
+
+func TestStateAndAgentCounts(t *testing.T) {
+	job := &taskagent.TaskAgentPoolMaintenanceJob{
+		Status: &taskagent.TaskAgentPoolMaintenanceJobStatusValues.InProgress,
+		TargetAgents: &[]taskagent.TaskAgentPoolMaintenanceJobTargetAgent{
+			{Result: &taskagent.TaskAgentPoolMaintenanceJobResultValues.Succeeded},
+			{Result: &taskagent.TaskAgentPoolMaintenanceJobResultValues.Succeeded},
+			{Result: &taskagent.TaskAgentPoolMaintenanceJobResultValues.Failed},
+			{},
+		},
+	}
+	assert.Equal(t, "inProgress", State(job))
+	succeeded, failed := AgentCounts(job)
+	assert.Equal(t, 2, succeeded)
+	assert.Equal(t, 1, failed)
+
+	job.Status = &taskagent.TaskAgentPoolMaintenanceJobStatusValues.Completed
+	job.Result = &taskagent.TaskAgentPoolMaintenanceJobResultValues.Failed
+	assert.Equal(t, "failed", State(job))
+}
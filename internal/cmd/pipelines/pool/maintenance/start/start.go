@@ -0,0 +1,97 @@
+package start
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool/maintenance/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type startOptions struct {
+	organizationName string
+	poolID           int
+	format           string
+}
+
+func NewCmdStart(ctx util.CmdContext) *cobra.Command {
+	opts := &startOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "start <pool-id> [organization]",
+		Short: "Start a maintenance job for an agent pool",
+		Long: heredoc.Doc(`
+			Start a maintenance job for an agent pool using the maintenance settings of the pool.
+
+			The command returns once the job is queued; use "maintenance status --watch" to wait
+			for it to complete.
+		`),
+		Example: heredoc.Doc(`
+			# start a maintenance job for the agent pool with ID 12
+			azdo pipelines pool maintenance start 12 myorg
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid pool ID: %s", args[0])
+			}
+			opts.poolID = id
+			if len(args) > 1 {
+				opts.organizationName = args[1]
+			}
+			return runStart(ctx, opts)
+		},
+	}
+
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runStart(ctx util.CmdContext, opts *startOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := shared.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	job, err := client.QueueMaintenanceJob(rctx, opts.poolID)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to start maintenance job for agent pool %d: %w", opts.poolID, err)
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(job)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	shared.AddJobRows(tp, []taskagent.TaskAgentPoolMaintenanceJob{*job}, iostrms.IsStdoutTTY(), time.Now())
+	return tp.Render()
+}
@@ -0,0 +1,138 @@
+package status
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool/maintenance/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type statusOptions struct {
+	organizationName string
+	poolID           int
+	jobID            int
+	watch            bool
+	format           string
+}
+
+func NewCmdStatus(ctx util.CmdContext) *cobra.Command {
+	opts := &statusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "status <pool-id> [organization]",
+		Short: "Show the maintenance jobs of an agent pool",
+		Long: heredoc.Doc(`
+			Show the maintenance jobs of an agent pool, most recent first.
+
+			With --watch the command waits until the job given by --job-id, or the most recent job
+			of the pool, has completed and shows it afterwards.
+		`),
+		Example: heredoc.Doc(`
+			# show the maintenance jobs of the agent pool with ID 12
+			azdo pipelines pool maintenance status 12 myorg
+
+			# wait for the most recent maintenance job to complete
+			azdo pipelines pool maintenance status 12 myorg --watch
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid pool ID: %s", args[0])
+			}
+			opts.poolID = id
+			if len(args) > 1 {
+				opts.organizationName = args[1]
+			}
+			if opts.jobID < 0 {
+				return util.FlagErrorf("invalid job ID: %d", opts.jobID)
+			}
+			return runStatus(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.jobID, "job-id", 0, "Only show the maintenance job with this ID")
+	cmd.Flags().BoolVarP(&opts.watch, "watch", "w", false, "Wait until the maintenance job has completed")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runStatus(ctx util.CmdContext, opts *statusOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := shared.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	var jobs []taskagent.TaskAgentPoolMaintenanceJob
+	if opts.jobID > 0 {
+		job, err := client.GetMaintenanceJob(rctx, opts.poolID, opts.jobID)
+		if err != nil {
+			return fmt.Errorf("failed to get maintenance job %d of agent pool %d: %w", opts.jobID, opts.poolID, err)
+		}
+		jobs = []taskagent.TaskAgentPoolMaintenanceJob{*job}
+	} else {
+		jobs, err = client.GetMaintenanceJobs(rctx, opts.poolID)
+		if err != nil {
+			return fmt.Errorf("failed to get maintenance jobs of agent pool %d: %w", opts.poolID, err)
+		}
+		sort.Slice(jobs, func(i, j int) bool {
+			return lo.FromPtr(jobs[i].JobId) > lo.FromPtr(jobs[j].JobId)
+		})
+	}
+	if len(jobs) == 0 {
+		iostrms.StopProgressIndicator()
+		return util.NewNoResultsError(fmt.Sprintf("No maintenance jobs found for agent pool %d", opts.poolID))
+	}
+
+	if opts.watch {
+		job := &jobs[0]
+		if !shared.IsFinished(job) {
+			job, err = shared.WaitForJob(rctx, client, opts.poolID, *job.JobId)
+			if err != nil {
+				return
+			}
+		}
+		jobs = []taskagent.TaskAgentPoolMaintenanceJob{*job}
+	}
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(jobs)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	shared.AddJobRows(tp, jobs, iostrms.IsStdoutTTY(), time.Now())
+	return tp.Render()
+}
@@ -0,0 +1,23 @@
+package pool
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool/create"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool/delete"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool/demands"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/pool/maintenance"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdPool(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool <command>",
+		Short: "Manage agent pools",
+	}
+
+	cmd.AddCommand(demands.NewCmdPoolAgentDemands(ctx))
+	cmd.AddCommand(create.NewCmdPoolCreate(ctx))
+	cmd.AddCommand(delete.NewCmdPoolDelete(ctx))
+	cmd.AddCommand(maintenance.NewCmdMaintenance(ctx))
+	return cmd
+}
@@ -0,0 +1,149 @@
+package cleancache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// cleanVariable is the variable which makes the agent clean the working directory of the pipeline
+// before the run.
+const cleanVariable = "Build.Clean"
+
+type cleanCacheOptions struct {
+	scope        string
+	definitionID int
+	branch       string
+	clearAll     bool
+	clearSource  bool
+	clearOutputs bool
+}
+
+func NewCmdCleanCache(ctx util.CmdContext) *cobra.Command {
+	opts := &cleanCacheOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "clean-cache [organization/]project",
+		Short: "Queue a run of a pipeline on a clean working directory",
+		Long: heredoc.Doc(`
+			Queue a run of a pipeline whose agent cleans the working directory of the pipeline before
+			the run, e.g. because stale sources or build outputs of earlier runs break the build.
+
+			With --clear-all the whole working directory is deleted, which is the default. With
+			--clear-source only the sources and with --clear-outputs only the build outputs are
+			deleted. The clean option is passed to the agent with the Build.Clean variable, so it
+			applies to this run only.
+		`),
+		Example: heredoc.Doc(`
+			# run pipeline 12 on a clean working directory
+			azdo pipelines run clean-cache myproject --pipeline-id 12
+
+			# run pipeline 12 on a feature branch after deleting the build outputs
+			azdo pipelines run clean-cache myorg/myproject --pipeline-id 12 --branch feature/login --clear-outputs
+		`),
+		Args: util.ExactArgs(1, "cannot clean pipeline: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			if opts.definitionID < 1 {
+				return util.FlagErrorf("invalid pipeline ID: %d", opts.definitionID)
+			}
+			if err := util.MutuallyExclusive("specify only one of --clear-all, --clear-source or --clear-outputs", opts.clearAll, opts.clearSource, opts.clearOutputs); err != nil {
+				return err
+			}
+
+			return runCleanCache(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.definitionID, "pipeline-id", 0, "ID of the pipeline")
+	cmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "Branch to build (default: default branch of the pipeline)")
+	cmd.Flags().BoolVar(&opts.clearAll, "clear-all", false, "Delete the whole working directory (default)")
+	cmd.Flags().BoolVar(&opts.clearSource, "clear-source", false, "Delete the sources")
+	cmd.Flags().BoolVar(&opts.clearOutputs, "clear-outputs", false, "Delete the build outputs")
+	_ = cmd.MarkFlagRequired("pipeline-id")
+
+	return cmd
+}
+
+func runCleanCache(ctx util.CmdContext, opts *cleanCacheOptions) (err error) {
+	parameters, err := shared.MergeParameters("", map[string]string{cleanVariable: cleanOption(opts)})
+	if err != nil {
+		return
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	run := &build.Build{
+		Definition: &build.DefinitionReference{
+			Id: &opts.definitionID,
+		},
+		Reason:     &build.BuildReasonValues.Manual,
+		Parameters: parameters,
+	}
+	if opts.branch != "" {
+		run.SourceBranch = lo.ToPtr(util.NormalizeBranchRef(opts.branch))
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	queued, err := client.QueueBuild(rctx, build.QueueBuildArgs{
+		Build:   run,
+		Project: &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue run of pipeline %d: %w", opts.definitionID, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		pipeline := strconv.Itoa(opts.definitionID)
+		if queued.Definition != nil && queued.Definition.Name != nil {
+			pipeline = *queued.Definition.Name
+		}
+		fmt.Fprintf(iostrms.ErrOut, "%s Queued run %d of pipeline %s on %s with clean option %s\n", cs.SuccessIcon(), *queued.Id, pipeline, util.ShortBranchName(lo.FromPtr(queued.SourceBranch)), cleanOption(opts))
+	}
+	fmt.Fprintln(iostrms.Out, shared.RunWebURL(conn, project, *queued.Id))
+	return nil
+}
+
+// cleanOption returns the value of the Build.Clean variable for the options. The agent calls the
+// build outputs "binary".
+func cleanOption(opts *cleanCacheOptions) string {
+	switch {
+	case opts.clearSource:
+		return "source"
+	case opts.clearOutputs:
+		return "binary"
+	default:
+		return "all"
+	}
+}
@@ -0,0 +1,14 @@
+package cleancache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCleanOption(t *testing.T) {
+	assert.Equal(t, "all", cleanOption(&cleanCacheOptions{}))
+	assert.Equal(t, "all", cleanOption(&cleanCacheOptions{clearAll: true}))
+	assert.Equal(t, "source", cleanOption(&cleanCacheOptions{clearSource: true}))
+	assert.Equal(t, "binary", cleanOption(&cleanCacheOptions{clearOutputs: true}))
+}
@@ -0,0 +1,367 @@
+package compare
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// Types of timeline records
+const (
+	recordTypeStage = "Stage"
+	recordTypeJob   = "Job"
+	recordTypeTask  = "Task"
+)
+
+type compareOptions struct {
+	runIDs [2]int
+	scope  string
+	format string
+}
+
+// comparison is the result of comparing two runs
+type comparison struct {
+	Runs          [2]runSummary    `json:"runs"`
+	ResultChanges []resultChange   `json:"resultChanges"`
+	Durations     []durationChange `json:"durations"`
+	AddedTasks    []taskRef        `json:"addedTasks"`
+	RemovedTasks  []taskRef        `json:"removedTasks"`
+}
+
+type runSummary struct {
+	ID       int    `json:"id"`
+	Number   string `json:"number"`
+	Pipeline string `json:"pipeline"`
+	Branch   string `json:"branch"`
+	Result   string `json:"result"`
+}
+
+// resultChange is a task with different results in the two runs
+type resultChange struct {
+	Task    string `json:"task"`
+	Result1 string `json:"result1"`
+	Result2 string `json:"result2"`
+}
+
+// durationChange holds the durations of a stage, or of a job for pipelines without stages, in the two runs
+type durationChange struct {
+	Name      string  `json:"name"`
+	Duration1 float64 `json:"duration1Seconds"`
+	Duration2 float64 `json:"duration2Seconds"`
+}
+
+// taskRef is a task which only exists in one of the runs
+type taskRef struct {
+	Task   string `json:"task"`
+	Result string `json:"result"`
+}
+
+// timelineRecord is a timeline record identified by the names of itself and its ancestors
+type timelineRecord struct {
+	path     string
+	result   string
+	duration time.Duration
+}
+
+func NewCmdCompare(ctx util.CmdContext) *cobra.Command {
+	opts := &compareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "compare <run-id> <run-id> [organization/]project",
+		Short: "Compare the results of two pipeline runs",
+		Long: heredoc.Doc(`
+			Compare the timelines of two pipeline runs.
+
+			The comparison lists the tasks whose result differs between the runs, the duration of
+			each stage in both runs and the tasks which only exist in one of the runs. Pipelines
+			without stages are compared by job durations instead. Tasks are matched by the names of
+			their stage, job and task.
+		`),
+		Example: heredoc.Doc(`
+			# compare a failed run with the last successful run
+			azdo pipelines run compare 1234 1240 myproject
+
+			# compare two runs and process the result with jq
+			azdo pipelines run compare 1234 1240 myorg/myproject --format json | jq .resultChanges
+		`),
+		Args: util.ExactArgs(3, "cannot compare runs: two run IDs and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			for i := range opts.runIDs {
+				id, err := shared.ParseRunID(args[i])
+				if err != nil {
+					return err
+				}
+				opts.runIDs[i] = id
+			}
+			opts.scope = args[2]
+
+			return runCompare(ctx, opts)
+		},
+	}
+
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runCompare(ctx util.CmdContext, opts *compareOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	var runs [2]runSummary
+	var records [2][]build.TimelineRecord
+	for i, id := range opts.runIDs {
+		run, err := client.GetBuild(rctx, build.GetBuildArgs{
+			Project: &project,
+			BuildId: lo.ToPtr(id),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get run %d: %w", id, err)
+		}
+		runs[i] = runSummary{
+			ID:     id,
+			Number: lo.FromPtr(run.BuildNumber),
+			Branch: util.ShortBranchName(lo.FromPtr(run.SourceBranch)),
+			Result: string(lo.FromPtr(run.Result)),
+		}
+		if run.Definition != nil {
+			runs[i].Pipeline = lo.FromPtr(run.Definition.Name)
+		}
+		if run.Result == nil {
+			runs[i].Result = string(lo.FromPtr(run.Status))
+		}
+
+		timeline, err := client.GetBuildTimeline(rctx, build.GetBuildTimelineArgs{
+			Project: &project,
+			BuildId: lo.ToPtr(id),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get timeline of run %d: %w", id, err)
+		}
+		if timeline != nil {
+			records[i] = lo.FromPtr(timeline.Records)
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	c := compareTimelines(records[0], records[1])
+	c.Runs = runs
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(c)
+	}
+
+	if runs[0].Pipeline != runs[1].Pipeline {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.ErrOut, "%s Runs belong to different pipelines: %s and %s\n", cs.WarningIcon(), runs[0].Pipeline, runs[1].Pipeline)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Change", "Name", fmt.Sprintf("Run %d", runs[0].ID), fmt.Sprintf("Run %d", runs[1].ID), "Difference")
+	addRow := func(change, name, v1, v2, diff string) {
+		tp.AddField(change)
+		tp.AddField(name)
+		tp.AddField(v1)
+		tp.AddField(v2)
+		tp.AddField(diff)
+		tp.EndRow()
+	}
+	addRow("run", runs[0].Pipeline, runs[0].Result, runs[1].Result, "")
+	for _, r := range c.ResultChanges {
+		addRow("result", r.Task, r.Result1, r.Result2, "")
+	}
+	for _, d := range c.Durations {
+		d1 := seconds(d.Duration1)
+		d2 := seconds(d.Duration2)
+		diff := d2 - d1
+		sign := "+"
+		if diff < 0 {
+			sign = "-"
+			diff = -diff
+		}
+		addRow("duration", d.Name, d1.String(), d2.String(), sign+diff.String())
+	}
+	for _, t := range c.AddedTasks {
+		addRow("added", t.Task, "", t.Result, "")
+	}
+	for _, t := range c.RemovedTasks {
+		addRow("removed", t.Task, t.Result, "", "")
+	}
+	return tp.Render()
+}
+
+// compareTimelines compares the timeline records of two runs.
+func compareTimelines(records1, records2 []build.TimelineRecord) comparison {
+	c := comparison{
+		ResultChanges: []resultChange{},
+		Durations:     []durationChange{},
+		AddedTasks:    []taskRef{},
+		RemovedTasks:  []taskRef{},
+	}
+
+	tasks1 := indexRecords(records1, recordTypeTask)
+	tasks2 := indexRecords(records2, recordTypeTask)
+	for _, t1 := range tasks1 {
+		t2, ok := findRecord(tasks2, t1.path)
+		if !ok {
+			c.RemovedTasks = append(c.RemovedTasks, taskRef{Task: t1.path, Result: t1.result})
+			continue
+		}
+		if t1.result != t2.result {
+			c.ResultChanges = append(c.ResultChanges, resultChange{Task: t1.path, Result1: t1.result, Result2: t2.result})
+		}
+	}
+	for _, t2 := range tasks2 {
+		if _, ok := findRecord(tasks1, t2.path); !ok {
+			c.AddedTasks = append(c.AddedTasks, taskRef{Task: t2.path, Result: t2.result})
+		}
+	}
+
+	recordType := recordTypeStage
+	if !hasRecordType(records1, recordTypeStage) && !hasRecordType(records2, recordTypeStage) {
+		recordType = recordTypeJob
+	}
+	stages1 := indexRecords(records1, recordType)
+	stages2 := indexRecords(records2, recordType)
+	for _, s1 := range stages1 {
+		if s2, ok := findRecord(stages2, s1.path); ok {
+			c.Durations = append(c.Durations, durationChange{
+				Name:      s1.path,
+				Duration1: s1.duration.Seconds(),
+				Duration2: s2.duration.Seconds(),
+			})
+		}
+	}
+	return c
+}
+
+// indexRecords returns the records of the given type in timeline order. Records with the same path
+// get a "#n" suffix to tell them apart.
+func indexRecords(records []build.TimelineRecord, recordType string) []timelineRecord {
+	byID := map[uuid.UUID]*build.TimelineRecord{}
+	for i := range records {
+		if records[i].Id != nil {
+			byID[*records[i].Id] = &records[i]
+		}
+	}
+
+	var selected []*build.TimelineRecord
+	for i := range records {
+		if lo.FromPtr(records[i].Type) == recordType {
+			selected = append(selected, &records[i])
+		}
+	}
+	orders := map[*build.TimelineRecord][]int{}
+	for _, r := range selected {
+		orders[r] = recordOrder(byID, r)
+	}
+	sort.SliceStable(selected, func(i, j int) bool {
+		return lessOrder(orders[selected[i]], orders[selected[j]])
+	})
+
+	result := make([]timelineRecord, 0, len(selected))
+	seen := map[string]int{}
+	for _, r := range selected {
+		path := recordPath(byID, r)
+		seen[path]++
+		if n := seen[path]; n > 1 {
+			path = fmt.Sprintf("%s #%d", path, n)
+		}
+		tr := timelineRecord{
+			path:   path,
+			result: string(lo.FromPtr(r.Result)),
+		}
+		if r.Result == nil {
+			tr.result = string(lo.FromPtr(r.State))
+		}
+		if r.StartTime != nil && r.FinishTime != nil {
+			tr.duration = r.FinishTime.Time.Sub(r.StartTime.Time)
+		}
+		result = append(result, tr)
+	}
+	return result
+}
+
+// recordPath returns the names of the record and its stage and job ancestors separated by " / ".
+// Phases and checkpoints are skipped because their names are not shown in the UI.
+func recordPath(byID map[uuid.UUID]*build.TimelineRecord, r *build.TimelineRecord) string {
+	names := []string{lo.FromPtr(r.Name)}
+	for p := parent(byID, r); p != nil; p = parent(byID, p) {
+		if t := lo.FromPtr(p.Type); t == recordTypeStage || t == recordTypeJob {
+			names = append([]string{lo.FromPtr(p.Name)}, names...)
+		}
+	}
+	return strings.Join(names, " / ")
+}
+
+// recordOrder returns the orders of the record and its ancestors starting at the root.
+func recordOrder(byID map[uuid.UUID]*build.TimelineRecord, r *build.TimelineRecord) []int {
+	orders := []int{lo.FromPtr(r.Order)}
+	for p := parent(byID, r); p != nil; p = parent(byID, p) {
+		orders = append([]int{lo.FromPtr(p.Order)}, orders...)
+	}
+	return orders
+}
+
+func lessOrder(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+func parent(byID map[uuid.UUID]*build.TimelineRecord, r *build.TimelineRecord) *build.TimelineRecord {
+	if r.ParentId == nil {
+		return nil
+	}
+	return byID[*r.ParentId]
+}
+
+func findRecord(records []timelineRecord, path string) (timelineRecord, bool) {
+	return lo.Find(records, func(r timelineRecord) bool { return r.path == path })
+}
+
+func hasRecordType(records []build.TimelineRecord, recordType string) bool {
+	return lo.ContainsBy(records, func(r build.TimelineRecord) bool { return lo.FromPtr(r.Type) == recordType })
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second)).Round(time.Second)
+}
@@ -0,0 +1,80 @@
+package compare
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordBuilder struct {
+	records []build.TimelineRecord
+	start   time.Time
+}
+
+func (b *recordBuilder) add(parent *uuid.UUID, recordType, name string, order int, result build.TaskResult, duration time.Duration) *uuid.UUID {
+	id := uuid.New()
+	b.records = append(b.records, build.TimelineRecord{
+		Id:         &id,
+		ParentId:   parent,
+		Type:       lo.ToPtr(recordType),
+		Name:       lo.ToPtr(name),
+		Order:      lo.ToPtr(order),
+		Result:     lo.ToPtr(result),
+		StartTime:  &azuredevops.Time{Time: b.start},
+		FinishTime: &azuredevops.Time{Time: b.start.Add(duration)},
+	})
+	return &id
+}
+
+func TestCompareTimelines(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ok, failed := build.TaskResultValues.Succeeded, build.TaskResultValues.Failed
+
+	run1 := &recordBuilder{start: start}
+	stage := run1.add(nil, recordTypeStage, "Build", 1, ok, 5*time.Minute)
+	phase := run1.add(stage, "Phase", "Phase 1", 1, ok, 5*time.Minute)
+	job := run1.add(phase, recordTypeJob, "Compile", 1, ok, 5*time.Minute)
+	run1.add(job, recordTypeTask, "Restore", 1, ok, time.Minute)
+	run1.add(job, recordTypeTask, "Bash", 2, ok, time.Minute)
+	run1.add(job, recordTypeTask, "Bash", 3, ok, time.Minute)
+	run1.add(job, recordTypeTask, "Lint", 4, ok, time.Minute)
+
+	run2 := &recordBuilder{start: start}
+	stage = run2.add(nil, recordTypeStage, "Build", 1, failed, 7*time.Minute)
+	phase = run2.add(stage, "Phase", "Phase 1", 1, failed, 7*time.Minute)
+	job = run2.add(phase, recordTypeJob, "Compile", 1, failed, 7*time.Minute)
+	run2.add(job, recordTypeTask, "Restore", 1, ok, time.Minute)
+	run2.add(job, recordTypeTask, "Bash", 2, ok, time.Minute)
+	run2.add(job, recordTypeTask, "Bash", 3, failed, time.Minute)
+	run2.add(job, recordTypeTask, "Test", 4, ok, time.Minute)
+
+	c := compareTimelines(run1.records, run2.records)
+
+	assert.Equal(t, []resultChange{
+		{Task: "Build / Compile / Bash #2", Result1: "succeeded", Result2: "failed"},
+	}, c.ResultChanges)
+	assert.Equal(t, []durationChange{
+		{Name: "Build", Duration1: 300, Duration2: 420},
+	}, c.Durations)
+	assert.Equal(t, []taskRef{{Task: "Build / Compile / Test", Result: "succeeded"}}, c.AddedTasks)
+	assert.Equal(t, []taskRef{{Task: "Build / Compile / Lint", Result: "succeeded"}}, c.RemovedTasks)
+}
+
+func TestCompareTimelinesWithoutStages(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ok := build.TaskResultValues.Succeeded
+
+	run1 := &recordBuilder{start: start}
+	run1.add(nil, recordTypeJob, "Job", 1, ok, time.Minute)
+	run2 := &recordBuilder{start: start}
+	run2.add(nil, recordTypeJob, "Job", 1, ok, 90*time.Second)
+
+	c := compareTimelines(run1.records, run2.records)
+	assert.Equal(t, []durationChange{{Name: "Job", Duration1: 60, Duration2: 90}}, c.Durations)
+	assert.Empty(t, c.ResultChanges)
+}
@@ -0,0 +1,208 @@
+package downloadartifact
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelines"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/archive"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// Types of artifacts which can be downloaded
+const (
+	artifactTypeBuild    = "build"
+	artifactTypePipeline = "pipeline"
+)
+
+type downloadArtifactOptions struct {
+	runID        int
+	artifactName string
+	scope        string
+	outputDir    string
+	extract      bool
+	artifactType string
+}
+
+func NewCmdDownloadArtifact(ctx util.CmdContext) *cobra.Command {
+	opts := &downloadArtifactOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "download-artifact <run-id> <artifact-name> [organization/]project",
+		Short: "Download an artifact of a pipeline run",
+		Long: heredoc.Doc(`
+			Download an artifact published by a pipeline run as zip archive.
+
+			Build artifacts are published with the "PublishBuildArtifacts" task, pipeline artifacts
+			with the "PublishPipelineArtifact" task or the "publish" step. Use --artifact-type to
+			select the kind of artifact to download.
+		`),
+		Example: heredoc.Doc(`
+			# download the build artifact "drop" of run 1234
+			azdo pipelines run download-artifact 1234 drop myproject
+
+			# download and extract the pipeline artifact "bin" of run 1234
+			azdo pipelines run download-artifact 1234 bin myorg/myproject --artifact-type pipeline --extract --output-dir out
+		`),
+		Args: util.ExactArgs(3, "cannot download artifact: run ID, artifact name and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.artifactName = args[1]
+			opts.scope = args[2]
+
+			return runDownloadArtifact(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputDir, "output-dir", "D", ".", "Directory to write the artifact to")
+	cmd.Flags().BoolVarP(&opts.extract, "extract", "x", false, "Extract the artifact into the output directory")
+	util.StringEnumFlag(cmd, &opts.artifactType, "artifact-type", "", artifactTypeBuild, []string{artifactTypeBuild, artifactTypePipeline}, "Type of the artifact")
+
+	return cmd
+}
+
+func runDownloadArtifact(ctx util.CmdContext, opts *downloadArtifactOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	var content io.ReadCloser
+	switch opts.artifactType {
+	case artifactTypePipeline:
+		content, err = openPipelineArtifact(rctx, conn, project, opts)
+	default:
+		content, err = openBuildArtifact(rctx, conn, project, opts)
+	}
+	if err != nil {
+		return
+	}
+	defer content.Close()
+
+	if err := os.MkdirAll(opts.outputDir, 0o755); err != nil {
+		return err
+	}
+
+	var f *os.File
+	if opts.extract {
+		f, err = os.CreateTemp("", "azdo-artifact-*.zip")
+		if err != nil {
+			return
+		}
+		defer os.Remove(f.Name())
+	} else {
+		f, err = os.Create(filepath.Join(opts.outputDir, opts.artifactName+".zip"))
+		if err != nil {
+			return
+		}
+	}
+	defer f.Close()
+
+	progress := util.NewProgressWriter(iostrms, fmt.Sprintf("Downloading %s", opts.artifactName))
+	size, err := progress.Copy(f, content)
+	if err != nil {
+		return fmt.Errorf("failed to download artifact %s: %w", opts.artifactName, err)
+	}
+
+	path := f.Name()
+	if opts.extract {
+		iostrms.StartProgressIndicatorWithLabel(fmt.Sprintf("Extracting %s", opts.artifactName))
+		if err := archive.ExtractZip(f, size, opts.outputDir); err != nil {
+			return fmt.Errorf("failed to extract artifact %s: %w", opts.artifactName, err)
+		}
+		path = opts.outputDir
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.ErrOut, "%s Downloaded artifact %s of run %d (%s)\n", cs.SuccessIcon(), cs.Bold(opts.artifactName), opts.runID, text.FormatBytes(size))
+	}
+	fmt.Fprintln(iostrms.Out, path)
+	return
+}
+
+func openBuildArtifact(ctx context.Context, conn *azuredevops.Connection, project string, opts *downloadArtifactOptions) (io.ReadCloser, error) {
+	client, err := build.NewClient(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	r, err := client.GetArtifactContentZip(ctx, build.GetArtifactContentZipArgs{
+		Project:      &project,
+		BuildId:      &opts.runID,
+		ArtifactName: &opts.artifactName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artifact %s of run %d: %w", opts.artifactName, opts.runID, err)
+	}
+	return r, nil
+}
+
+func openPipelineArtifact(ctx context.Context, conn *azuredevops.Connection, project string, opts *downloadArtifactOptions) (io.ReadCloser, error) {
+	buildClient, err := build.NewClient(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	run, err := buildClient.GetBuild(ctx, build.GetBuildArgs{
+		Project: &project,
+		BuildId: &opts.runID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get run %d: %w", opts.runID, err)
+	}
+
+	client := pipelines.NewClient(ctx, conn)
+	artifact, err := client.GetArtifact(ctx, pipelines.GetArtifactArgs{
+		Project:      &project,
+		PipelineId:   run.Definition.Id,
+		RunId:        &opts.runID,
+		ArtifactName: &opts.artifactName,
+		Expand:       &pipelines.GetArtifactExpandOptionsValues.SignedContent,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get artifact %s of run %d: %w", opts.artifactName, opts.runID, err)
+	}
+	if artifact.SignedContent == nil || artifact.SignedContent.Url == nil {
+		return nil, fmt.Errorf("artifact %s of run %d has no download URL", opts.artifactName, opts.runID)
+	}
+
+	// the signed URL already grants access, so the request must not carry the credentials of the connection
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, *artifact.SignedContent.Url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := conn.GetClientByUrl(*artifact.SignedContent.Url).SendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download artifact %s: %w", opts.artifactName, err)
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,238 @@
+package downloadlog
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+var invalidFileNameChars = regexp.MustCompile(`[^\w.\- ]+`)
+
+type downloadLogOptions struct {
+	runID     int
+	scope     string
+	outputDir string
+	zip       bool
+}
+
+func NewCmdDownloadLog(ctx util.CmdContext) *cobra.Command {
+	opts := &downloadLogOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "download-log <run-id> [organization/]project",
+		Short: "Download the logs of a pipeline run",
+		Long: heredoc.Doc(`
+			Download all logs of a pipeline run.
+
+			Each log is written to a file named after the job or task which produced it. With --zip
+			all logs are written into a single zip archive instead.
+		`),
+		Example: heredoc.Doc(`
+			# download the logs of run 1234 into the current directory
+			azdo pipelines run download-log 1234 myproject
+
+			# download the logs of run 1234 as zip archive into the logs directory
+			azdo pipelines run download-log 1234 myorg/myproject --output-dir logs --zip
+		`),
+		Args: util.ExactArgs(2, "cannot download logs: run ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.scope = args[1]
+
+			return runDownloadLog(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.outputDir, "output-dir", "D", ".", "Directory to write the logs to")
+	cmd.Flags().BoolVar(&opts.zip, "zip", false, "Write all logs into a single zip archive")
+
+	return cmd
+}
+
+func runDownloadLog(ctx util.CmdContext, opts *downloadLogOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	logs, err := client.GetBuildLogs(rctx, build.GetBuildLogsArgs{
+		Project: &project,
+		BuildId: &opts.runID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get logs of run %d: %w", opts.runID, err)
+	}
+	if logs == nil || len(*logs) == 0 {
+		iostrms.StopProgressIndicator()
+		return util.NewNoResultsError(fmt.Sprintf("No logs found for run %d", opts.runID))
+	}
+
+	names, err := logFileNames(rctx, client, project, opts.runID, *logs)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(opts.outputDir, 0o755); err != nil {
+		return err
+	}
+
+	progress := util.NewProgressWriter(iostrms, "Downloading logs")
+	var written []string
+	if opts.zip {
+		path := filepath.Join(opts.outputDir, fmt.Sprintf("run-%d-logs.zip", opts.runID))
+		if err := writeZip(rctx, client, project, opts.runID, *logs, names, path, progress); err != nil {
+			return err
+		}
+		written = append(written, path)
+	} else {
+		for _, l := range *logs {
+			path := filepath.Join(opts.outputDir, names[*l.Id])
+			if err := writeLogFile(rctx, client, project, opts.runID, *l.Id, path, progress); err != nil {
+				return err
+			}
+			written = append(written, path)
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.ErrOut, "%s Downloaded %s of run %d (%s)\n", cs.SuccessIcon(), text.Pluralize(len(*logs), "log"), opts.runID, text.FormatBytes(progress.Written()))
+	}
+	for _, path := range written {
+		fmt.Fprintln(iostrms.Out, path)
+	}
+	return
+}
+
+// logFileNames returns unique file names for the logs, derived from the names of the timeline
+// records which produced them.
+func logFileNames(ctx context.Context, client build.Client, project string, runID int, logs []build.BuildLog) (map[int]string, error) {
+	timeline, err := client.GetBuildTimeline(ctx, build.GetBuildTimelineArgs{
+		Project: &project,
+		BuildId: &runID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline of run %d: %w", runID, err)
+	}
+	recordNames := map[int]string{}
+	if timeline != nil && timeline.Records != nil {
+		for _, r := range *timeline.Records {
+			if r.Log != nil && r.Log.Id != nil && r.Name != nil {
+				recordNames[*r.Log.Id] = *r.Name
+			}
+		}
+	}
+
+	names := make(map[int]string, len(logs))
+	used := map[string]bool{}
+	for _, l := range logs {
+		name := strings.TrimSpace(invalidFileNameChars.ReplaceAllString(recordNames[*l.Id], "_"))
+		if name == "" {
+			name = "log-" + strconv.Itoa(*l.Id)
+		}
+		if used[strings.ToLower(name)] {
+			name = fmt.Sprintf("%s-%d", name, *l.Id)
+		}
+		used[strings.ToLower(name)] = true
+		names[*l.Id] = name + ".log"
+	}
+	return names, nil
+}
+
+func getLog(ctx context.Context, client build.Client, project string, runID, logID int) (io.ReadCloser, error) {
+	r, err := client.GetBuildLog(ctx, build.GetBuildLogArgs{
+		Project: &project,
+		BuildId: &runID,
+		LogId:   &logID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get log %d of run %d: %w", logID, runID, err)
+	}
+	return r, nil
+}
+
+func writeLogFile(ctx context.Context, client build.Client, project string, runID, logID int, path string, progress *util.ProgressWriter) error {
+	r, err := getLog(ctx, client, project, runID, logID)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = progress.Copy(f, r)
+	return err
+}
+
+func writeZip(ctx context.Context, client build.Client, project string, runID int, logs []build.BuildLog, names map[int]string, path string, progress *util.ProgressWriter) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for _, l := range logs {
+		w, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     names[*l.Id],
+			Method:   zip.Deflate,
+			Modified: lo.FromPtr(l.LastChangedOn).Time,
+		})
+		if err != nil {
+			return err
+		}
+		r, err := getLog(ctx, client, project, runID, *l.Id)
+		if err != nil {
+			return err
+		}
+		_, err = progress.Copy(w, r)
+		r.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
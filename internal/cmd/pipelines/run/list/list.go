@@ -0,0 +1,178 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+type listOptions struct {
+	scope       string
+	mine        bool
+	pipelineIDs []int
+	branch      string
+	status      string
+	result      string
+	limit       int
+	format      string
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List pipeline runs",
+		Long: heredoc.Doc(`
+			List the runs of the pipelines of a project, most recent first.
+		`),
+		Example: heredoc.Doc(`
+			# list my recent pipeline runs
+			azdo pipelines run list myproject --mine
+
+			# list the failed runs of pipeline 12 on the main branch
+			azdo pipelines run list myorg/myproject --pipeline-id 12 --branch main --result failed
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list runs: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.mine, "mine", false, "Only list runs requested by the current user")
+	cmd.Flags().IntSliceVar(&opts.pipelineIDs, "pipeline-id", nil, "Only list runs of these pipelines")
+	cmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "Only list runs of this branch")
+	util.StringEnumFlag(cmd, &opts.status, "status", "s", "", []string{
+		string(build.BuildStatusValues.InProgress),
+		string(build.BuildStatusValues.Completed),
+		string(build.BuildStatusValues.Cancelling),
+		string(build.BuildStatusValues.Postponed),
+		string(build.BuildStatusValues.NotStarted),
+	}, "Only list runs with this status")
+	util.StringEnumFlag(cmd, &opts.result, "result", "r", "", []string{
+		string(build.BuildResultValues.Succeeded),
+		string(build.BuildResultValues.PartiallySucceeded),
+		string(build.BuildResultValues.Failed),
+		string(build.BuildResultValues.Canceled),
+	}, "Only list runs with this result")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 20, "Maximum number of runs to list")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	if opts.limit < 1 {
+		return util.FlagErrorf("invalid value for --limit: %d", opts.limit)
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	args := build.GetBuildsArgs{
+		Project:    &project,
+		Top:        &opts.limit,
+		QueryOrder: &build.BuildQueryOrderValues.QueueTimeDescending,
+	}
+	if opts.mine {
+		user, err := util.GetAuthenticatedUser(rctx, conn)
+		if err != nil {
+			return err
+		}
+		args.RequestedFor = lo.ToPtr(user.Id.String())
+	}
+	if len(opts.pipelineIDs) > 0 {
+		args.Definitions = &opts.pipelineIDs
+	}
+	if opts.branch != "" {
+		args.BranchName = lo.ToPtr(util.NormalizeBranchRef(opts.branch))
+	}
+	if opts.status != "" {
+		args.StatusFilter = lo.ToPtr(build.BuildStatus(opts.status))
+	}
+	if opts.result != "" {
+		args.ResultFilter = lo.ToPtr(build.BuildResult(opts.result))
+	}
+
+	res, err := client.GetBuilds(rctx, args)
+	if err != nil {
+		return fmt.Errorf("failed to get runs of project %s: %w", project, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if len(res.Value) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No runs found in project %s", project))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(res.Value)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	tp.AddColumns("ID", "Number", "Pipeline", "Branch", "Status", "Result", "Requested For", "Queued")
+	for _, b := range res.Value {
+		tp.AddField(strconv.Itoa(*b.Id), printer.WithTruncate(nil))
+		tp.AddField(lo.FromPtr(b.BuildNumber))
+		if b.Definition != nil {
+			tp.AddField(lo.FromPtr(b.Definition.Name))
+		} else {
+			tp.AddField("")
+		}
+		tp.AddField(util.ShortBranchName(lo.FromPtr(b.SourceBranch)))
+		tp.AddField(string(lo.FromPtr(b.Status)))
+		tp.AddField(string(lo.FromPtr(b.Result)))
+		if b.RequestedFor != nil {
+			tp.AddField(lo.FromPtr(b.RequestedFor.DisplayName))
+		} else {
+			tp.AddField("")
+		}
+		switch {
+		case b.QueueTime == nil:
+			tp.AddField("")
+		case iostrms.IsStdoutTTY():
+			tp.AddField(text.FuzzyAgo(now, b.QueueTime.Time))
+		default:
+			tp.AddField(b.QueueTime.Time.Format(time.RFC3339))
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,198 @@
+package rerun
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+type rerunOptions struct {
+	runID            int
+	scope            string
+	failedStagesOnly bool
+	variables        []string
+}
+
+func NewCmdRerun(ctx util.CmdContext) *cobra.Command {
+	opts := &rerunOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rerun <run-id> [organization/]project",
+		Short: "Run a pipeline run again",
+		Long: heredoc.Doc(`
+			Run a pipeline run again.
+
+			By default a new run of the same pipeline is queued for the same branch and commit as the
+			original run, with the same parameters. Variables given with --variables are added to or
+			override the variables of the original run.
+
+			With --failed-stages-only no new run is queued. Instead the failed and canceled stages of
+			the original run are retried, which requires a multi-stage YAML pipeline.
+		`),
+		Example: heredoc.Doc(`
+			# run pipeline run 1234 again
+			azdo pipelines run rerun 1234 myproject
+
+			# run pipeline run 1234 again with debug logging
+			azdo pipelines run rerun 1234 myorg/myproject --variables system.debug=true
+
+			# retry the failed stages of pipeline run 1234
+			azdo pipelines run rerun 1234 myproject --failed-stages-only
+		`),
+		Args: util.ExactArgs(2, "cannot rerun: run ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.scope = args[1]
+
+			if err := util.MutuallyExclusive("--variables is not supported with --failed-stages-only", opts.failedStagesOnly, len(opts.variables) > 0); err != nil {
+				return err
+			}
+
+			return runRerun(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.failedStagesOnly, "failed-stages-only", false, "Retry the failed stages of the run instead of queuing a new run")
+	cmd.Flags().StringArrayVar(&opts.variables, "variables", nil, "Variable in the form KEY=VALUE; can be repeated")
+
+	return cmd
+}
+
+func runRerun(ctx util.CmdContext, opts *rerunOptions) (err error) {
+	variables, err := shared.ParseVariables(opts.variables)
+	if err != nil {
+		return
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	original, err := client.GetBuild(rctx, build.GetBuildArgs{
+		Project: &project,
+		BuildId: &opts.runID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get run %d: %w", opts.runID, err)
+	}
+
+	cs := iostrms.ColorScheme()
+	if opts.failedStagesOnly {
+		stages, err := retryFailedStages(rctx, client, project, opts.runID)
+		if err != nil {
+			return err
+		}
+		iostrms.StopProgressIndicator()
+
+		if iostrms.IsStdoutTTY() {
+			fmt.Fprintf(iostrms.ErrOut, "%s Retrying %s of run %d: %s\n", cs.SuccessIcon(), text.Pluralize(len(stages), "stage"), opts.runID, strings.Join(stages, ", "))
+		}
+		fmt.Fprintln(iostrms.Out, shared.RunWebURL(conn, project, opts.runID))
+		return nil
+	}
+
+	parameters, err := shared.MergeParameters(lo.FromPtr(original.Parameters), variables)
+	if err != nil {
+		return fmt.Errorf("failed to parse the parameters of run %d: %w", opts.runID, err)
+	}
+	queued, err := client.QueueBuild(rctx, build.QueueBuildArgs{
+		Build: &build.Build{
+			Definition: &build.DefinitionReference{
+				Id: original.Definition.Id,
+			},
+			SourceBranch:       original.SourceBranch,
+			SourceVersion:      original.SourceVersion,
+			Parameters:         parameters,
+			TemplateParameters: original.TemplateParameters,
+		},
+		Project: &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue run of pipeline %s: %w", lo.FromPtr(original.Definition.Name), err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		fmt.Fprintf(iostrms.ErrOut, "%s Queued run %d of pipeline %s\n", cs.SuccessIcon(), *queued.Id, lo.FromPtr(original.Definition.Name))
+	}
+	fmt.Fprintln(iostrms.Out, shared.RunWebURL(conn, project, *queued.Id))
+	return nil
+}
+
+// retryFailedStages retries the failed and canceled stages of the run and returns their names.
+func retryFailedStages(ctx context.Context, client build.Client, project string, runID int) ([]string, error) {
+	timeline, err := client.GetBuildTimeline(ctx, build.GetBuildTimelineArgs{
+		Project: &project,
+		BuildId: &runID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline of run %d: %w", runID, err)
+	}
+	var records []build.TimelineRecord
+	if timeline != nil {
+		records = lo.FromPtr(timeline.Records)
+	}
+	stages := lo.Filter(records, func(r build.TimelineRecord, _ int) bool {
+		return lo.FromPtr(r.Type) == "Stage"
+	})
+	if len(stages) == 0 {
+		return nil, fmt.Errorf("run %d has no stages; --failed-stages-only requires a multi-stage YAML pipeline", runID)
+	}
+	failed := lo.Filter(stages, func(r build.TimelineRecord, _ int) bool {
+		result := lo.FromPtr(r.Result)
+		return result == build.TaskResultValues.Failed || result == build.TaskResultValues.Canceled
+	})
+	if len(failed) == 0 {
+		return nil, fmt.Errorf("run %d has no failed stages", runID)
+	}
+
+	names := make([]string, 0, len(failed))
+	for _, s := range failed {
+		err := client.UpdateStage(ctx, build.UpdateStageArgs{
+			UpdateParameters: &build.UpdateStageParameters{
+				State: &build.StageUpdateTypeValues.Retry,
+			},
+			BuildId:      &runID,
+			StageRefName: s.Identifier,
+			Project:      &project,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to retry stage %s of run %d: %w", lo.FromPtr(s.Name), runID, err)
+		}
+		names = append(names, lo.FromPtr(s.Name))
+	}
+	return names, nil
+}
@@ -0,0 +1,41 @@
+package run
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/cleancache"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/compare"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/downloadartifact"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/downloadlog"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/rerun"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/tag"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/tags"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/testresults"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/trigger"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/untag"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/variable"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/view"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdRun(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run <command>",
+		Short: "Work with pipeline runs",
+	}
+
+	cmd.AddCommand(cleancache.NewCmdCleanCache(ctx))
+	cmd.AddCommand(compare.NewCmdCompare(ctx))
+	cmd.AddCommand(downloadlog.NewCmdDownloadLog(ctx))
+	cmd.AddCommand(downloadartifact.NewCmdDownloadArtifact(ctx))
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(rerun.NewCmdRerun(ctx))
+	cmd.AddCommand(tag.NewCmdTag(ctx))
+	cmd.AddCommand(tags.NewCmdTags(ctx))
+	cmd.AddCommand(testresults.NewCmdTestResults(ctx))
+	cmd.AddCommand(trigger.NewCmdTrigger(ctx))
+	cmd.AddCommand(untag.NewCmdUntag(ctx))
+	cmd.AddCommand(variable.NewCmdVariable(ctx))
+	cmd.AddCommand(view.NewCmdView(ctx))
+	return cmd
+}
@@ -0,0 +1,62 @@
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// ParseRunID parses a pipeline run ID argument. The ID may be prefixed with "#".
+func ParseRunID(arg string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+	if err != nil || id < 1 {
+		return 0, util.FlagErrorf("invalid run ID: %s", arg)
+	}
+	return id, nil
+}
+
+// RunWebURL returns the URL of the results page of a pipeline run.
+func RunWebURL(conn *azuredevops.Connection, project string, runID int) string {
+	return fmt.Sprintf("%s/%s/_build/results?buildId=%d", conn.BaseUrl, url.PathEscape(project), runID)
+}
+
+// ParseVariables parses variables given in the form KEY=VALUE.
+func ParseVariables(values []string) (map[string]string, error) {
+	variables := make(map[string]string, len(values))
+	for _, v := range values {
+		key, value, ok := strings.Cut(v, "=")
+		if !ok || strings.TrimSpace(key) == "" {
+			return nil, util.FlagErrorf("invalid variable %q; expected KEY=VALUE", v)
+		}
+		variables[strings.TrimSpace(key)] = value
+	}
+	return variables, nil
+}
+
+// MergeParameters adds the variables to the parameters of a run, which are a JSON object serialized
+// into a string. It returns nil if there are no parameters.
+func MergeParameters(parameters string, variables map[string]string) (*string, error) {
+	merged := map[string]string{}
+	if parameters != "" {
+		if err := json.Unmarshal([]byte(parameters), &merged); err != nil {
+			return nil, err
+		}
+	}
+	for k, v := range variables {
+		merged[k] = v
+	}
+	if len(merged) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	result := string(data)
+	return &result, nil
+}
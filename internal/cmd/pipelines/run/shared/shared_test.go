@@ -0,0 +1,42 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeParameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		parameters string
+		variables  map[string]string
+		want       *string
+	}{
+		{name: "nothing"},
+		{
+			name:       "original parameters",
+			parameters: `{"env":"test"}`,
+			want:       strPtr(`{"env":"test"}`),
+		},
+		{
+			name:       "variables override parameters",
+			parameters: `{"env":"test","region":"eu"}`,
+			variables:  map[string]string{"env": "prod", "system.debug": "true"},
+			want:       strPtr(`{"env":"prod","region":"eu","system.debug":"true"}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MergeParameters(tt.parameters, tt.variables)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
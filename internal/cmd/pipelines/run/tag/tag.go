@@ -0,0 +1,95 @@
+package tag
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type tagOptions struct {
+	runID int
+	tag   string
+	scope string
+}
+
+func NewCmdTag(ctx util.CmdContext) *cobra.Command {
+	opts := &tagOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tag <run-id> <tag> [organization/]project",
+		Short: "Add a tag to a pipeline run",
+		Long: heredoc.Doc(`
+			Add a tag to a pipeline run, e.g. to mark the runs which were released.
+
+			Adding a tag which the run already has is not an error.
+		`),
+		Example: heredoc.Doc(`
+			# mark run 1234 as released
+			azdo pipelines run tag 1234 released myproject
+		`),
+		Args: util.ExactArgs(3, "cannot tag run: run ID, tag and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.tag = strings.TrimSpace(args[1])
+			opts.scope = args[2]
+
+			if opts.tag == "" {
+				return util.FlagErrorf("tag must not be empty")
+			}
+
+			return runTag(ctx, opts)
+		},
+	}
+
+	return cmd
+}
+
+func runTag(ctx util.CmdContext, opts *tagOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	_, err = client.AddBuildTag(rctx, build.AddBuildTagArgs{
+		Project: &project,
+		BuildId: &opts.runID,
+		Tag:     &opts.tag,
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to add tag %s to run %d: %w", opts.tag, opts.runID, err)
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Added tag %s to run %d\n", cs.SuccessIcon(), cs.Bold(opts.tag), opts.runID)
+	}
+	return
+}
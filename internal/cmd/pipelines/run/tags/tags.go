@@ -0,0 +1,102 @@
+package tags
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type tagsOptions struct {
+	runID  int
+	scope  string
+	format string
+}
+
+func NewCmdTags(ctx util.CmdContext) *cobra.Command {
+	opts := &tagsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tags <run-id> [organization/]project",
+		Short: "List the tags of a pipeline run",
+		Example: heredoc.Doc(`
+			# list the tags of run 1234
+			azdo pipelines run tags 1234 myproject
+		`),
+		Args: util.ExactArgs(2, "cannot list tags: run ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.scope = args[1]
+
+			return runTags(ctx, opts)
+		},
+	}
+
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runTags(ctx util.CmdContext, opts *tagsOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	res, err := client.GetBuildTags(rctx, build.GetBuildTagsArgs{
+		Project: &project,
+		BuildId: &opts.runID,
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get tags of run %d: %w", opts.runID, err)
+	}
+	tags := lo.FromPtr(res)
+	if len(tags) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("Run %d has no tags", opts.runID))
+	}
+	sort.Strings(tags)
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(tags)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Tag")
+	for _, t := range tags {
+		tp.AddField(t)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,202 @@
+package testresults
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	testshared "github.com/tmeckel/azdo-cli/internal/cmd/test/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// resultsPageSize is the number of test results fetched with a single request
+const resultsPageSize = 1000
+
+type testResultsOptions struct {
+	runID   int
+	scope   string
+	outcome string
+	limit   int
+	format  string
+}
+
+type testResult struct {
+	TestRun      string  `json:"testRun"`
+	TestRunID    int     `json:"testRunId"`
+	Name         string  `json:"name"`
+	Outcome      string  `json:"outcome"`
+	DurationInMs float64 `json:"durationInMs"`
+	ErrorMessage string  `json:"errorMessage,omitempty"`
+}
+
+func NewCmdTestResults(ctx util.CmdContext) *cobra.Command {
+	opts := &testResultsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "test-results <run-id> [organization/]project",
+		Short: "List the test results of a pipeline run",
+		Long: heredoc.Doc(`
+			List the results of the tests published by a pipeline run.
+
+			The results of all test runs of the pipeline run are listed. Use --outcome failed to only
+			list the failed tests, for example to report test failures from a CI bot.
+		`),
+		Example: heredoc.Doc(`
+			# list the failed tests of run 1234
+			azdo pipelines run test-results 1234 myproject --outcome failed
+
+			# print all test results of run 1234 as JSON
+			azdo pipelines run test-results 1234 myorg/myproject --limit 500 --format json
+		`),
+		Args: util.ExactArgs(2, "cannot list test results: run ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.scope = args[1]
+
+			return runTestResults(ctx, opts)
+		},
+	}
+
+	util.StringEnumFlag(cmd, &opts.outcome, "outcome", "", "all", []string{"failed", "passed", "all"}, "Only list test results with this outcome")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 50, "Maximum number of test results to list")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runTestResults(ctx util.CmdContext, opts *testResultsOptions) (err error) {
+	if opts.limit < 1 {
+		return util.FlagErrorf("invalid value for --limit: %d", opts.limit)
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := test.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	testRuns, err := client.GetTestRuns(rctx, test.GetTestRunsArgs{
+		Project:  &project,
+		BuildUri: lo.ToPtr(testshared.BuildURI(opts.runID)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get test runs of run %d: %w", opts.runID, err)
+	}
+	if len(lo.FromPtr(testRuns)) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No test runs found for run %d", opts.runID))
+	}
+
+	results, err := getTestResults(rctx, client, project, *testRuns, outcomes(opts.outcome), opts.limit)
+	if err != nil {
+		return
+	}
+	iostrms.StopProgressIndicator()
+
+	if len(results) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No test results found for run %d", opts.runID))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(results)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Test Run", "Name", "Outcome", "Duration", "Error")
+	for _, r := range results {
+		tp.AddField(r.TestRun)
+		tp.AddField(r.Name)
+		tp.AddField(r.Outcome)
+		tp.AddField((time.Duration(r.DurationInMs) * time.Millisecond).String())
+		msg, _, _ := strings.Cut(r.ErrorMessage, "\n")
+		tp.AddField(msg)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// outcomes returns the test outcomes matching the value of --outcome, or nil for all outcomes.
+func outcomes(outcome string) *[]test.TestOutcome {
+	switch outcome {
+	case "failed":
+		return &testshared.FailedOutcomes
+	case "passed":
+		return &[]test.TestOutcome{test.TestOutcomeValues.Passed}
+	}
+	return nil
+}
+
+func getTestResults(ctx context.Context, client test.Client, project string, testRuns []test.TestRun, outcomes *[]test.TestOutcome, limit int) ([]testResult, error) {
+	var results []testResult
+	for _, tr := range testRuns {
+		for skip := 0; len(results) < limit; skip += resultsPageSize {
+			page, err := client.GetTestResults(ctx, test.GetTestResultsArgs{
+				Project:  &project,
+				RunId:    tr.Id,
+				Outcomes: outcomes,
+				Skip:     lo.ToPtr(skip),
+				Top:      lo.ToPtr(resultsPageSize),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get results of test run %d: %w", lo.FromPtr(tr.Id), err)
+			}
+			for _, r := range lo.FromPtr(page) {
+				results = append(results, newTestResult(&tr, &r))
+			}
+			if len(lo.FromPtr(page)) < resultsPageSize {
+				break
+			}
+		}
+		if len(results) >= limit {
+			return results[:limit], nil
+		}
+	}
+	return results, nil
+}
+
+func newTestResult(tr *test.TestRun, r *test.TestCaseResult) testResult {
+	name := lo.FromPtr(r.AutomatedTestName)
+	if name == "" {
+		name = lo.FromPtr(r.TestCaseTitle)
+	}
+	return testResult{
+		TestRun:      lo.FromPtr(tr.Name),
+		TestRunID:    lo.FromPtr(tr.Id),
+		Name:         name,
+		Outcome:      lo.FromPtr(r.Outcome),
+		DurationInMs: lo.FromPtr(r.DurationInMs),
+		ErrorMessage: strings.TrimSpace(lo.FromPtr(r.ErrorMessage)),
+	}
+}
@@ -0,0 +1,40 @@
+package testresults
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutcomes(t *testing.T) {
+	assert.Nil(t, outcomes("all"))
+	assert.Equal(t, []test.TestOutcome{test.TestOutcomeValues.Passed}, *outcomes("passed"))
+	assert.Contains(t, *outcomes("failed"), test.TestOutcomeValues.Failed)
+}
+
+func TestNewTestResult(t *testing.T) {
+	tr := &test.TestRun{Id: lo.ToPtr(7), Name: lo.ToPtr("Unit tests")}
+
+	got := newTestResult(tr, &test.TestCaseResult{
+		TestCaseTitle: lo.ToPtr("Parse"),
+		Outcome:       lo.ToPtr("Failed"),
+		DurationInMs:  lo.ToPtr(12.0),
+		ErrorMessage:  lo.ToPtr("expected 1, got 2\n"),
+	})
+	assert.Equal(t, testResult{
+		TestRun:      "Unit tests",
+		TestRunID:    7,
+		Name:         "Parse",
+		Outcome:      "Failed",
+		DurationInMs: 12,
+		ErrorMessage: "expected 1, got 2",
+	}, got)
+
+	got = newTestResult(tr, &test.TestCaseResult{
+		AutomatedTestName: lo.ToPtr("pkg.TestParse"),
+		TestCaseTitle:     lo.ToPtr("Parse"),
+	})
+	assert.Equal(t, "pkg.TestParse", got.Name)
+}
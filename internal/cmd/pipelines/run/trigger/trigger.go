@@ -0,0 +1,186 @@
+package trigger
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type triggerOptions struct {
+	definitionID int
+	scope        string
+	branch       string
+	commit       string
+	variables    []string
+	ciTrigger    bool
+	prTrigger    bool
+	sourceBranch string
+	targetBranch string
+}
+
+func NewCmdTrigger(ctx util.CmdContext) *cobra.Command {
+	opts := &triggerOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "trigger <pipeline-id> [organization/]project",
+		Short: "Queue a run of a pipeline",
+		Long: heredoc.Doc(`
+			Queue a run of a pipeline.
+
+			Without --branch the default branch of the pipeline is built.
+
+			With --ci-trigger the run is queued as if it was triggered by a push to --branch, and
+			with --pr-trigger as if it was triggered by a pull request from --source-branch into
+			--target-branch. This changes the reason of the run, which pipelines can evaluate in
+			conditions. Azure DevOps does not create a pull request merge commit for these runs, so
+			the source branch itself is built. Not all pipeline definitions accept runs with a pull
+			request reason that are queued manually; those runs are rejected by the service.
+		`),
+		Example: heredoc.Doc(`
+			# run pipeline 12 on its default branch
+			azdo pipelines run trigger 12 myproject
+
+			# run pipeline 12 on a feature branch with an additional variable
+			azdo pipelines run trigger 12 myorg/myproject --branch feature/login --variables system.debug=true
+
+			# simulate a CI run of the main branch
+			azdo pipelines run trigger 12 myproject --ci-trigger --branch main
+
+			# simulate a pull request validation run
+			azdo pipelines run trigger 12 myproject --pr-trigger --source-branch feature/login --target-branch main
+		`),
+		Args: util.ExactArgs(2, "cannot trigger pipeline: pipeline ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid pipeline ID: %s", args[0])
+			}
+			opts.definitionID = id
+			opts.scope = args[1]
+
+			if err := util.MutuallyExclusive("specify only one of --ci-trigger or --pr-trigger", opts.ciTrigger, opts.prTrigger); err != nil {
+				return err
+			}
+			if opts.prTrigger {
+				if opts.sourceBranch == "" || opts.targetBranch == "" {
+					return util.FlagErrorf("--source-branch and --target-branch required with --pr-trigger")
+				}
+				if opts.branch != "" {
+					return util.FlagErrorf("--branch is not supported with --pr-trigger; use --source-branch")
+				}
+			} else if opts.sourceBranch != "" || opts.targetBranch != "" {
+				return util.FlagErrorf("--source-branch and --target-branch require --pr-trigger")
+			}
+			if opts.ciTrigger && opts.branch == "" {
+				return util.FlagErrorf("--branch required with --ci-trigger")
+			}
+
+			return runTrigger(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "Branch to build")
+	cmd.Flags().StringVar(&opts.commit, "commit", "", "Commit to build instead of the head of the branch")
+	cmd.Flags().StringArrayVar(&opts.variables, "variables", nil, "Variable in the form KEY=VALUE; can be repeated")
+	cmd.Flags().BoolVar(&opts.ciTrigger, "ci-trigger", false, "Queue the run with the reason of a CI trigger")
+	cmd.Flags().BoolVar(&opts.prTrigger, "pr-trigger", false, "Queue the run with the reason of a pull request trigger")
+	cmd.Flags().StringVar(&opts.sourceBranch, "source-branch", "", "Source branch of the simulated pull request")
+	cmd.Flags().StringVar(&opts.targetBranch, "target-branch", "", "Target branch of the simulated pull request")
+
+	return cmd
+}
+
+func runTrigger(ctx util.CmdContext, opts *triggerOptions) (err error) {
+	variables, err := shared.ParseVariables(opts.variables)
+	if err != nil {
+		return
+	}
+	parameters, err := shared.MergeParameters("", variables)
+	if err != nil {
+		return
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	run := newBuild(opts)
+	run.Parameters = parameters
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	queued, err := client.QueueBuild(rctx, build.QueueBuildArgs{
+		Build:   run,
+		Project: &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to queue run of pipeline %d: %w", opts.definitionID, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		pipeline := strconv.Itoa(opts.definitionID)
+		if queued.Definition != nil && queued.Definition.Name != nil {
+			pipeline = *queued.Definition.Name
+		}
+		fmt.Fprintf(iostrms.ErrOut, "%s Queued run %d of pipeline %s on %s\n", cs.SuccessIcon(), *queued.Id, pipeline, util.ShortBranchName(lo.FromPtr(queued.SourceBranch)))
+	}
+	fmt.Fprintln(iostrms.Out, shared.RunWebURL(conn, project, *queued.Id))
+	return nil
+}
+
+// newBuild returns the build to queue for the options.
+func newBuild(opts *triggerOptions) *build.Build {
+	b := &build.Build{
+		Definition: &build.DefinitionReference{
+			Id: &opts.definitionID,
+		},
+		Reason: &build.BuildReasonValues.Manual,
+	}
+	if opts.branch != "" {
+		b.SourceBranch = lo.ToPtr(util.NormalizeBranchRef(opts.branch))
+	}
+	if opts.commit != "" {
+		b.SourceVersion = &opts.commit
+	}
+	switch {
+	case opts.ciTrigger:
+		b.Reason = &build.BuildReasonValues.IndividualCI
+	case opts.prTrigger:
+		source := util.NormalizeBranchRef(opts.sourceBranch)
+		target := util.NormalizeBranchRef(opts.targetBranch)
+		b.Reason = &build.BuildReasonValues.PullRequest
+		b.SourceBranch = &source
+		b.TriggerInfo = &map[string]string{
+			"pr.sourceBranch": source,
+			"pr.targetBranch": target,
+		}
+	}
+	return b
+}
@@ -0,0 +1,57 @@
+package trigger
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBuild(t *testing.T) {
+	definition := &build.DefinitionReference{Id: lo.ToPtr(12)}
+
+	tests := []struct {
+		name string
+		opts triggerOptions
+		want *build.Build
+	}{
+		{
+			name: "manual",
+			opts: triggerOptions{definitionID: 12},
+			want: &build.Build{
+				Definition: definition,
+				Reason:     &build.BuildReasonValues.Manual,
+			},
+		},
+		{
+			name: "ci",
+			opts: triggerOptions{definitionID: 12, ciTrigger: true, branch: "main", commit: "abc123"},
+			want: &build.Build{
+				Definition:    definition,
+				Reason:        &build.BuildReasonValues.IndividualCI,
+				SourceBranch:  lo.ToPtr("refs/heads/main"),
+				SourceVersion: lo.ToPtr("abc123"),
+			},
+		},
+		{
+			name: "pull request",
+			opts: triggerOptions{definitionID: 12, prTrigger: true, sourceBranch: "feature/login", targetBranch: "main"},
+			want: &build.Build{
+				Definition:   definition,
+				Reason:       &build.BuildReasonValues.PullRequest,
+				SourceBranch: lo.ToPtr("refs/heads/feature/login"),
+				TriggerInfo: &map[string]string{
+					"pr.sourceBranch": "refs/heads/feature/login",
+					"pr.targetBranch": "refs/heads/main",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, newBuild(&tt.opts))
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package untag
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type untagOptions struct {
+	runID  int
+	tag    string
+	scope  string
+	dryRun bool
+}
+
+func NewCmdUntag(ctx util.CmdContext) *cobra.Command {
+	opts := &untagOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "untag <run-id> <tag> [organization/]project",
+		Short: "Remove a tag from a pipeline run",
+		Example: heredoc.Doc(`
+			# remove the released tag from run 1234
+			azdo pipelines run untag 1234 released myproject
+
+			# show which tag would be removed
+			azdo pipelines run untag 1234 released myproject --dry-run
+		`),
+		Args: util.ExactArgs(3, "cannot untag run: run ID, tag and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.tag = strings.TrimSpace(args[1])
+			opts.scope = args[2]
+
+			return runUntag(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be removed without removing it")
+
+	return cmd
+}
+
+func runUntag(ctx util.CmdContext, opts *untagOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	// deleting a tag the run does not have succeeds silently, so the tags are checked first
+	tags, err := client.GetBuildTags(rctx, build.GetBuildTagsArgs{
+		Project: &project,
+		BuildId: &opts.runID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get tags of run %d: %w", opts.runID, err)
+	}
+	if !lo.ContainsBy(lo.FromPtr(tags), func(t string) bool { return strings.EqualFold(t, opts.tag) }) {
+		return fmt.Errorf("run %d has no tag %s", opts.runID, opts.tag)
+	}
+
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("remove tag %s from run %d", opts.tag, opts.runID), func() error {
+		_, err := client.DeleteBuildTag(rctx, build.DeleteBuildTagArgs{
+			Project: &project,
+			BuildId: &opts.runID,
+			Tag:     &opts.tag,
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove tag %s from run %d: %w", opts.tag, opts.runID, err)
+	}
+	iostrms.StopProgressIndicator()
+	if opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Removed tag %s from run %d\n", cs.SuccessIcon(), cs.Bold(opts.tag), opts.runID)
+	}
+	return
+}
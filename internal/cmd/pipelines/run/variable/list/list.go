@@ -0,0 +1,184 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// secretMask is printed instead of the value of secret variables
+const secretMask = "***"
+
+type listOptions struct {
+	runID  int
+	scope  string
+	format string
+}
+
+type runVariable struct {
+	Name     string  `json:"name"`
+	Value    *string `json:"value"`
+	IsSecret bool    `json:"isSecret"`
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <run-id> [organization/]project",
+		Short: "List the variables of a pipeline run",
+		Long: heredoc.Doc(`
+			List the variables a pipeline run was queued with.
+
+			These are the variables of the pipeline definition at the revision the run used,
+			overridden by the variables set when the run was queued. Variables defined in the YAML
+			file of a pipeline and variables of linked variable groups are not included, as they are
+			not stored with the run. The values of secret variables are not returned by Azure DevOps.
+		`),
+		Example: heredoc.Doc(`
+			# list the variables of run 1234
+			azdo pipelines run variable list 1234 myproject
+
+			# print the variables of run 1234 as JSON
+			azdo pipelines run variable list 1234 myorg/myproject --format json
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(2, "cannot list variables: run ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.scope = args[1]
+
+			return runList(ctx, opts)
+		},
+	}
+
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	run, err := client.GetBuild(rctx, build.GetBuildArgs{
+		Project: &project,
+		BuildId: &opts.runID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get run %d: %w", opts.runID, err)
+	}
+	if run.Definition == nil || run.Definition.Id == nil {
+		return fmt.Errorf("run %d has no pipeline definition", opts.runID)
+	}
+	definition, err := client.GetDefinition(rctx, build.GetDefinitionArgs{
+		Project:      &project,
+		DefinitionId: run.Definition.Id,
+		Revision:     run.Definition.Revision,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get pipeline %s: %w", lo.FromPtr(run.Definition.Name), err)
+	}
+
+	variables, err := mergeVariables(lo.FromPtr(definition.Variables), lo.FromPtr(run.Parameters))
+	if err != nil {
+		return fmt.Errorf("failed to parse the parameters of run %d: %w", opts.runID, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if len(variables) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("Run %d has no variables", opts.runID))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(variables)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("Name", "Value", "Is Secret")
+	for _, v := range variables {
+		tp.AddField(v.Name)
+		tp.AddField(lo.Ternary(v.IsSecret, secretMask, lo.FromPtr(v.Value)))
+		tp.AddField(strconv.FormatBool(v.IsSecret))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// mergeVariables returns the variables of the definition overridden by the queue time variables of
+// the run, which are a JSON object serialized into a string, sorted by name. Variable names are case
+// insensitive.
+func mergeVariables(definition map[string]build.BuildDefinitionVariable, parameters string) ([]runVariable, error) {
+	byName := map[string]*runVariable{}
+	for name, v := range definition {
+		isSecret := lo.FromPtr(v.IsSecret)
+		byName[strings.ToLower(name)] = &runVariable{
+			Name:     name,
+			Value:    lo.Ternary(isSecret, nil, v.Value),
+			IsSecret: isSecret,
+		}
+	}
+	if parameters != "" {
+		var queued map[string]string
+		if err := json.Unmarshal([]byte(parameters), &queued); err != nil {
+			return nil, err
+		}
+		for name, value := range queued {
+			value := value
+			if v, ok := byName[strings.ToLower(name)]; ok {
+				if !v.IsSecret {
+					v.Value = &value
+				}
+				continue
+			}
+			byName[strings.ToLower(name)] = &runVariable{Name: name, Value: &value}
+		}
+	}
+
+	variables := make([]runVariable, 0, len(byName))
+	for _, v := range byName {
+		variables = append(variables, *v)
+	}
+	sort.Slice(variables, func(i, j int) bool {
+		return strings.ToLower(variables[i].Name) < strings.ToLower(variables[j].Name)
+	})
+	return variables, nil
+}
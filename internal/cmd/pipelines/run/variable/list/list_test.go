@@ -0,0 +1,30 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeVariables(t *testing.T) {
+	definition := map[string]build.BuildDefinitionVariable{
+		"system.debug":  {Value: lo.ToPtr("false"), AllowOverride: lo.ToPtr(true)},
+		"configuration": {Value: lo.ToPtr("release")},
+		"apiKey":        {IsSecret: lo.ToPtr(true)},
+	}
+
+	variables, err := mergeVariables(definition, `{"System.Debug":"true","apiKey":"s3cr3t","target":"staging"}`)
+	require.NoError(t, err)
+	assert.Equal(t, []runVariable{
+		{Name: "apiKey", IsSecret: true},
+		{Name: "configuration", Value: lo.ToPtr("release")},
+		{Name: "system.debug", Value: lo.ToPtr("true")},
+		{Name: "target", Value: lo.ToPtr("staging")},
+	}, variables)
+
+	_, err = mergeVariables(definition, "not json")
+	assert.Error(t, err)
+}
@@ -0,0 +1,17 @@
+package variable
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/variable/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdVariable(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "variable <command>",
+		Short: "Inspect the variables of pipeline runs",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	return cmd
+}
@@ -0,0 +1,264 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/run/shared"
+	testshared "github.com/tmeckel/azdo-cli/internal/cmd/test/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+)
+
+// resultsPageSize is the number of test results fetched with a single request
+const resultsPageSize = 1000
+
+type viewOptions struct {
+	runID      int
+	scope      string
+	withTests  bool
+	testDetail bool
+	format     string
+}
+
+// testSummary sums up the test results of all test runs of a pipeline run.
+type testSummary struct {
+	Total    int           `json:"total"`
+	Passed   int           `json:"passed"`
+	Failed   int           `json:"failed"`
+	Skipped  int           `json:"skipped"`
+	Failures []testFailure `json:"failures,omitempty"`
+}
+
+type testFailure struct {
+	TestRun      string `json:"testRun"`
+	Name         string `json:"name"`
+	Outcome      string `json:"outcome"`
+	ErrorMessage string `json:"errorMessage,omitempty"`
+}
+
+type viewResult struct {
+	*build.Build
+	Tests *testSummary `json:"tests,omitempty"`
+}
+
+func NewCmdView(ctx util.CmdContext) *cobra.Command {
+	opts := &viewOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "view <run-id> [organization/]project",
+		Short: "View a pipeline run",
+		Long: heredoc.Doc(`
+			Display the status, result, branch and timing of a pipeline run.
+
+			With --with-tests the number of total, passed, failed and skipped tests of the test runs
+			published by the pipeline run is shown. --test-detail additionally lists the failed tests.
+		`),
+		Example: heredoc.Doc(`
+			# view run 1234
+			azdo pipelines run view 1234 myproject
+
+			# view run 1234 including the failed tests
+			azdo pipelines run view 1234 myorg/myproject --test-detail
+
+			# print run 1234 and its test counts as JSON
+			azdo pipelines run view 1234 myproject --with-tests --format json
+		`),
+		Args: util.ExactArgs(2, "cannot view run: run ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParseRunID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.runID = id
+			opts.scope = args[1]
+
+			return runView(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.withTests, "with-tests", false, "Show a summary of the test results of the run")
+	cmd.Flags().BoolVar(&opts.testDetail, "test-detail", false, "List the failed tests of the run; implies --with-tests")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runView(ctx util.CmdContext, opts *viewOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	run, err := client.GetBuild(rctx, build.GetBuildArgs{
+		Project: &project,
+		BuildId: &opts.runID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get run %d: %w", opts.runID, err)
+	}
+
+	res := viewResult{Build: run}
+	if opts.withTests || opts.testDetail {
+		testClient, err := test.NewClient(rctx, conn)
+		if err != nil {
+			return err
+		}
+		res.Tests, err = getTestSummary(rctx, testClient, project, run, opts.testDetail)
+		if err != nil {
+			return err
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(res)
+	}
+
+	cs := iostrms.ColorScheme()
+	out := iostrms.Out
+	definition := ""
+	if run.Definition != nil {
+		definition = lo.FromPtr(run.Definition.Name)
+	}
+	fmt.Fprintf(out, "%s %s\n", cs.Bold(definition), cs.Gray("#"+lo.FromPtr(run.BuildNumber)))
+	fields := []struct{ label, value string }{
+		{"Status", string(lo.FromPtr(run.Status))},
+		{"Result", string(lo.FromPtr(run.Result))},
+		{"Reason", string(lo.FromPtr(run.Reason))},
+		{"Branch", util.ShortBranchName(lo.FromPtr(run.SourceBranch))},
+		{"Commit", lo.FromPtr(run.SourceVersion)},
+	}
+	if run.RequestedFor != nil {
+		fields = append(fields, struct{ label, value string }{"Requested For", lo.FromPtr(run.RequestedFor.DisplayName)})
+	}
+	if run.StartTime != nil {
+		fields = append(fields, struct{ label, value string }{"Started", run.StartTime.Time.Format(time.RFC3339)})
+		if run.FinishTime != nil {
+			fields = append(fields, struct{ label, value string }{"Duration", run.FinishTime.Time.Sub(run.StartTime.Time).Round(time.Second).String()})
+		}
+	}
+	for _, f := range fields {
+		if f.value != "" {
+			fmt.Fprintf(out, "%s: %s\n", cs.Bold(f.label), f.value)
+		}
+	}
+
+	if res.Tests != nil {
+		fmt.Fprintln(out)
+		printTestSummary(out, cs, res.Tests, opts.testDetail)
+	}
+
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, cs.Gray("View this run on Azure DevOps: "+shared.RunWebURL(conn, project, opts.runID)))
+	return nil
+}
+
+func getTestSummary(ctx context.Context, client test.Client, project string, run *build.Build, withFailures bool) (*testSummary, error) {
+	testRuns, err := client.GetTestRuns(ctx, test.GetTestRunsArgs{
+		Project:           &project,
+		BuildUri:          run.Uri,
+		IncludeRunDetails: lo.ToPtr(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test runs of run %d: %w", lo.FromPtr(run.Id), err)
+	}
+	summary := summarizeTestRuns(lo.FromPtr(testRuns))
+	if !withFailures || summary.Failed == 0 {
+		return summary, nil
+	}
+
+	for _, tr := range lo.FromPtr(testRuns) {
+		for skip := 0; ; skip += resultsPageSize {
+			results, err := client.GetTestResults(ctx, test.GetTestResultsArgs{
+				Project:  &project,
+				RunId:    tr.Id,
+				Outcomes: &testshared.FailedOutcomes,
+				Skip:     &skip,
+				Top:      lo.ToPtr(resultsPageSize),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get results of test run %d: %w", lo.FromPtr(tr.Id), err)
+			}
+			for _, r := range lo.FromPtr(results) {
+				name := lo.FromPtr(r.AutomatedTestName)
+				if name == "" {
+					name = lo.FromPtr(r.TestCaseTitle)
+				}
+				summary.Failures = append(summary.Failures, testFailure{
+					TestRun:      lo.FromPtr(tr.Name),
+					Name:         name,
+					Outcome:      lo.FromPtr(r.Outcome),
+					ErrorMessage: strings.TrimSpace(lo.FromPtr(r.ErrorMessage)),
+				})
+			}
+			if len(lo.FromPtr(results)) < resultsPageSize {
+				break
+			}
+		}
+	}
+	return summary, nil
+}
+
+// summarizeTestRuns sums up the test counts of the test runs.
+func summarizeTestRuns(testRuns []test.TestRun) *testSummary {
+	summary := &testSummary{}
+	for i := range testRuns {
+		total, passed, failed := testshared.CountTests(&testRuns[i])
+		summary.Total += total
+		summary.Passed += passed
+		summary.Failed += failed
+		summary.Skipped += total - passed - failed
+	}
+	return summary
+}
+
+func printTestSummary(out io.Writer, cs *iostreams.ColorScheme, summary *testSummary, withFailures bool) {
+	fmt.Fprintln(out, cs.Bold("Tests"))
+	if summary.Total == 0 {
+		fmt.Fprintln(out, "No test results")
+		return
+	}
+	fmt.Fprintf(out, "Total: %d, Passed: %d, Failed: %d, Skipped: %d\n", summary.Total, summary.Passed, summary.Failed, summary.Skipped)
+	if !withFailures || len(summary.Failures) == 0 {
+		return
+	}
+	fmt.Fprintln(out)
+	for _, f := range summary.Failures {
+		fmt.Fprintf(out, "%s %s %s\n", cs.FailureIcon(), f.Name, cs.Gray("("+f.TestRun+")"))
+		if f.ErrorMessage != "" {
+			for _, line := range strings.Split(f.ErrorMessage, "\n") {
+				fmt.Fprintf(out, "    %s\n", line)
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarizeTestRuns(t *testing.T) {
+	stat := func(outcome string, count int) test.RunStatistic {
+		return test.RunStatistic{Outcome: lo.ToPtr(outcome), Count: lo.ToPtr(count)}
+	}
+	testRuns := []test.TestRun{
+		{
+			RunStatistics: &[]test.RunStatistic{
+				stat("Passed", 40),
+				stat("Failed", 3),
+				stat("Timeout", 1),
+				stat("NotExecuted", 2),
+			},
+		},
+		{
+			TotalTests:      lo.ToPtr(10),
+			PassedTests:     lo.ToPtr(8),
+			UnanalyzedTests: lo.ToPtr(1),
+		},
+	}
+
+	assert.Equal(t, &testSummary{
+		Total:   56,
+		Passed:  48,
+		Failed:  5,
+		Skipped: 3,
+	}, summarizeTestRuns(testRuns))
+}
+
+func TestSummarizeTestRunsEmpty(t *testing.T) {
+	assert.Equal(t, &testSummary{}, summarizeTestRuns(nil))
+}
@@ -0,0 +1,180 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinepermissions"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	scope             string
+	name              string
+	description       string
+	variables         []string
+	secrets           []string
+	authorize         bool
+	createIfNotExists bool
+	format            string
+}
+
+func NewCmdCreate(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create [organization/]project",
+		Short: "Create a variable group",
+		Long: heredoc.Doc(`
+			Create a variable group of type Vsts with the given variables.
+
+			With --create-if-not-exists the command succeeds without changes when a variable group
+			with the same name already exists in the project, which makes it safe to use in
+			provisioning scripts that are run repeatedly.
+		`),
+		Example: heredoc.Doc(`
+			# create a variable group with two variables
+			azdo pipelines variable-group create myproject --name settings --variable environment=production --secret password=s3cr3t
+
+			# create the variable group only if it does not exist yet
+			azdo pipelines variable-group create myorg/myproject --name settings --variable environment=production --create-if-not-exists
+		`),
+		Args: util.ExactArgs(1, "cannot create variable group: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			return runCreate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.name, "name", "n", "", "Name of the variable group")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the variable group")
+	cmd.Flags().StringArrayVar(&opts.variables, "variable", nil, "Variable in the form NAME=VALUE; can be repeated")
+	cmd.Flags().StringArrayVar(&opts.secrets, "secret", nil, "Secret variable in the form NAME=VALUE; can be repeated")
+	cmd.Flags().BoolVar(&opts.authorize, "authorize", false, "Grant access permission to all pipelines to use the variable group")
+	cmd.Flags().BoolVar(&opts.createIfNotExists, "create-if-not-exists", false, "Do nothing if a variable group with the same name already exists")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func runCreate(ctx util.CmdContext, opts *createOptions) (err error) {
+	variables := map[string]interface{}{}
+	for _, v := range opts.variables {
+		name, value, err := parseVariable(v)
+		if err != nil {
+			return util.FlagErrorf("invalid value for --variable: %w", err)
+		}
+		variables[name] = taskagent.VariableValue{Value: &value}
+	}
+	for _, v := range opts.secrets {
+		name, value, err := parseVariable(v)
+		if err != nil {
+			return util.FlagErrorf("invalid value for --secret: %w", err)
+		}
+		variables[name] = taskagent.VariableValue{Value: &value, IsSecret: lo.ToPtr(true)}
+	}
+	if len(variables) == 0 {
+		return util.FlagErrorf("at least one --variable or --secret is required")
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, projectName, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	if opts.createIfNotExists {
+		groups, err := client.GetVariableGroups(rctx, taskagent.GetVariableGroupsArgs{
+			Project:   &projectName,
+			GroupName: &opts.name,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get variable groups of project %s: %w", projectName, err)
+		}
+		// the group name filter supports wildcards, so only an exact match counts
+		existing, ok := lo.Find(lo.FromPtr(groups), func(g taskagent.VariableGroup) bool {
+			return strings.EqualFold(lo.FromPtr(g.Name), opts.name)
+		})
+		if ok {
+			iostrms.StopProgressIndicator()
+			fmt.Fprintf(iostrms.ErrOut, "Variable group %s already exists, ID=%d\n", opts.name, *existing.Id)
+			return nil
+		}
+	}
+
+	project, err := coreClient.GetProject(rctx, core.GetProjectArgs{
+		ProjectId: &projectName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get project %s: %w", projectName, err)
+	}
+
+	params := &taskagent.VariableGroupParameters{
+		Name:                           &opts.name,
+		Type:                           lo.ToPtr(shared.GroupTypeVsts),
+		Variables:                      &variables,
+		VariableGroupProjectReferences: shared.ProjectReferences(project, opts.name, opts.description),
+	}
+	if opts.description != "" {
+		params.Description = &opts.description
+	}
+	created, err := client.AddVariableGroup(rctx, taskagent.AddVariableGroupArgs{
+		VariableGroupParameters: params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create variable group %s: %w", opts.name, err)
+	}
+
+	if opts.authorize {
+		permissionsClient, err := pipelinepermissions.NewClient(rctx, conn)
+		if err != nil {
+			return err
+		}
+		err = shared.GrantAllPipelinesAccessToVariableGroup(rctx, permissionsClient, project.Id.String(), *created.Id)
+		if err != nil {
+			return err
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	return shared.PrintVariableGroup(ctx, created, opts.format)
+}
+
+func parseVariable(s string) (name, value string, err error) {
+	name, value, ok := strings.Cut(s, "=")
+	if !ok || name == "" {
+		return "", "", fmt.Errorf("%q is not in the form NAME=VALUE", s)
+	}
+	return name, value, nil
+}
@@ -0,0 +1,246 @@
+package importcmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinepermissions"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"gopkg.in/yaml.v3"
+)
+
+type importOptions struct {
+	scope        string
+	file         string
+	groupType    string
+	nameOverride string
+	authorize    bool
+	format       string
+}
+
+// variableGroupFile is the content of an import file. It has the same shape as a variable group
+// returned by the service, so the JSON of an existing group can be imported again.
+type variableGroupFile struct {
+	Name         string                   `json:"name"`
+	Description  string                   `json:"description"`
+	Type         string                   `json:"type"`
+	ProviderData map[string]interface{}   `json:"providerData"`
+	Variables    map[string]variableValue `json:"variables"`
+}
+
+type variableValue struct {
+	Value      *string `json:"value"`
+	IsSecret   bool    `json:"isSecret"`
+	IsReadOnly bool    `json:"isReadOnly"`
+}
+
+func NewCmdImport(ctx util.CmdContext) *cobra.Command {
+	opts := &importOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import [organization/]project <file>",
+		Short: "Create a variable group from a JSON or YAML file",
+		Long: heredoc.Doc(`
+			Create a variable group from the definition in a JSON or YAML file.
+
+			The file has the same format as the JSON of a variable group returned by Azure DevOps:
+
+			    name: my-group
+			    description: Shared settings
+			    type: Vsts
+			    variables:
+			      environment:
+			        value: production
+			      password:
+			        value: s3cr3t
+			        isSecret: true
+
+			Groups of type AzureKeyVault require providerData with the serviceEndpointId and the
+			vault name. Use "-" to read the file from standard input.
+		`),
+		Example: heredoc.Doc(`
+			# create a variable group from a YAML file
+			azdo pipelines variable-group import myproject group.yml
+
+			# create a copy of a group under a new name and allow all pipelines to use it
+			azdo pipelines variable-group import myorg/myproject group.json --name-override my-copy --authorize
+		`),
+		Args: util.ExactArgs(2, "cannot import variable group: project and file required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+			opts.file = args[1]
+
+			return runImport(ctx, opts)
+		},
+	}
+
+	util.StringEnumFlag(cmd, &opts.groupType, "type", "", "", []string{shared.GroupTypeVsts, shared.GroupTypeAzureKeyVault}, "Type of the variable group; overrides the type in the file")
+	cmd.Flags().StringVar(&opts.nameOverride, "name-override", "", "Name of the variable group; overrides the name in the file")
+	cmd.Flags().BoolVar(&opts.authorize, "authorize", false, "Grant access permission to all pipelines to use the variable group")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runImport(ctx util.CmdContext, opts *importOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	content, err := iostrms.ReadUserFile(opts.file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.file, err)
+	}
+	group, err := parseVariableGroup(content)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", opts.file, err)
+	}
+	if opts.nameOverride != "" {
+		group.Name = opts.nameOverride
+	}
+	if opts.groupType != "" {
+		group.Type = opts.groupType
+	}
+	if group.Type == "" {
+		group.Type = shared.GroupTypeVsts
+	}
+	if err := validateVariableGroup(group); err != nil {
+		return fmt.Errorf("invalid variable group in %s: %w", opts.file, err)
+	}
+
+	organizationName, projectName, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	project, err := coreClient.GetProject(rctx, core.GetProjectArgs{
+		ProjectId: &projectName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get project %s: %w", projectName, err)
+	}
+
+	params := group.parameters(project)
+	created, err := client.AddVariableGroup(rctx, taskagent.AddVariableGroupArgs{
+		VariableGroupParameters: params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create variable group %s: %w", group.Name, err)
+	}
+
+	if opts.authorize {
+		permissionsClient, err := pipelinepermissions.NewClient(rctx, conn)
+		if err != nil {
+			return err
+		}
+		err = shared.GrantAllPipelinesAccessToVariableGroup(rctx, permissionsClient, project.Id.String(), *created.Id)
+		if err != nil {
+			return err
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	return shared.PrintVariableGroup(ctx, created, opts.format)
+}
+
+// parseVariableGroup parses the content of an import file. Content which is valid JSON is parsed
+// as JSON, any other content as YAML.
+func parseVariableGroup(content []byte) (*variableGroupFile, error) {
+	if !json.Valid(content) {
+		var doc interface{}
+		if err := yaml.Unmarshal(content, &doc); err != nil {
+			return nil, err
+		}
+		var err error
+		content, err = json.Marshal(doc)
+		if err != nil {
+			return nil, err
+		}
+	}
+	group := &variableGroupFile{}
+	if err := json.Unmarshal(content, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func validateVariableGroup(group *variableGroupFile) error {
+	if group.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	switch group.Type {
+	case shared.GroupTypeVsts:
+		if len(group.Variables) == 0 {
+			return fmt.Errorf("at least one variable is required")
+		}
+	case shared.GroupTypeAzureKeyVault:
+		for _, key := range []string{"serviceEndpointId", "vault"} {
+			if v, _ := group.ProviderData[key].(string); v == "" {
+				return fmt.Errorf("providerData.%s is required for variable groups of type %s", key, shared.GroupTypeAzureKeyVault)
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported type %q", group.Type)
+	}
+
+	names := lo.Keys(group.Variables)
+	sort.Strings(names)
+	for _, name := range names {
+		v := group.Variables[name]
+		if v.IsSecret && v.Value == nil && group.Type == shared.GroupTypeVsts {
+			return fmt.Errorf("secret variable %s has no value", name)
+		}
+	}
+	return nil
+}
+
+func (g *variableGroupFile) parameters(project *core.TeamProject) *taskagent.VariableGroupParameters {
+	variables := make(map[string]interface{}, len(g.Variables))
+	for name, v := range g.Variables {
+		variables[name] = taskagent.VariableValue{
+			Value:      v.Value,
+			IsSecret:   lo.ToPtr(v.IsSecret),
+			IsReadOnly: lo.ToPtr(v.IsReadOnly),
+		}
+	}
+	params := &taskagent.VariableGroupParameters{
+		Name:                           &g.Name,
+		Type:                           &g.Type,
+		Variables:                      &variables,
+		VariableGroupProjectReferences: shared.ProjectReferences(project, g.Name, g.Description),
+	}
+	if g.Description != "" {
+		params.Description = &g.Description
+	}
+	if len(g.ProviderData) > 0 {
+		params.ProviderData = g.ProviderData
+	}
+	return params
+}
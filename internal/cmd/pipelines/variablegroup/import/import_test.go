@@ -0,0 +1,119 @@
+package importcmd
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/shared"
+)
+
+func TestParseVariableGroup(t *testing.T) {
+	want := &variableGroupFile{
+		Name:        "settings",
+		Description: "Shared settings",
+		Type:        "Vsts",
+		Variables: map[string]variableValue{
+			"environment": {Value: lo.ToPtr("production")},
+			"password":    {Value: lo.ToPtr("s3cr3t"), IsSecret: true},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name: "json",
+			content: `{
+				"id": 12,
+				"name": "settings",
+				"description": "Shared settings",
+				"type": "Vsts",
+				"variables": {
+					"environment": {"value": "production"},
+					"password": {"value": "s3cr3t", "isSecret": true}
+				}
+			}`,
+		},
+		{
+			name: "yaml",
+			content: `
+name: settings
+description: Shared settings
+type: Vsts
+variables:
+  environment:
+    value: production
+  password:
+    value: s3cr3t
+    isSecret: true
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseVariableGroup([]byte(tt.content))
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestValidateVariableGroup(t *testing.T) {
+	tests := []struct {
+		name    string
+		group   variableGroupFile
+		wantErr string
+	}{
+		{
+			name: "valid",
+			group: variableGroupFile{
+				Name:      "settings",
+				Type:      shared.GroupTypeVsts,
+				Variables: map[string]variableValue{"a": {Value: lo.ToPtr("b")}},
+			},
+		},
+		{
+			name:    "missing name",
+			group:   variableGroupFile{Type: shared.GroupTypeVsts},
+			wantErr: "name is required",
+		},
+		{
+			name:    "no variables",
+			group:   variableGroupFile{Name: "settings", Type: shared.GroupTypeVsts},
+			wantErr: "at least one variable is required",
+		},
+		{
+			name: "secret without value",
+			group: variableGroupFile{
+				Name:      "settings",
+				Type:      shared.GroupTypeVsts,
+				Variables: map[string]variableValue{"password": {IsSecret: true}},
+			},
+			wantErr: "secret variable password has no value",
+		},
+		{
+			name: "key vault without provider data",
+			group: variableGroupFile{
+				Name:         "secrets",
+				Type:         shared.GroupTypeAzureKeyVault,
+				ProviderData: map[string]interface{}{"vault": "myvault"},
+			},
+			wantErr: "providerData.serviceEndpointId is required for variable groups of type AzureKeyVault",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVariableGroup(&tt.group)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
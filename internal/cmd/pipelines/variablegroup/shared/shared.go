@@ -0,0 +1,133 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinepermissions"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// Types of variable groups
+const (
+	GroupTypeVsts          = "Vsts"
+	GroupTypeAzureKeyVault = "AzureKeyVault"
+)
+
+// variableGroupResourceType is the pipeline permissions resource type of variable groups
+const variableGroupResourceType = "variablegroup"
+
+// ProjectReferences returns the project references of a variable group which belongs to the project.
+func ProjectReferences(project *core.TeamProject, name, description string) *[]taskagent.VariableGroupProjectReference {
+	ref := taskagent.VariableGroupProjectReference{
+		Name: &name,
+		ProjectReference: &taskagent.ProjectReference{
+			Id:   project.Id,
+			Name: project.Name,
+		},
+	}
+	if description != "" {
+		ref.Description = &description
+	}
+	return &[]taskagent.VariableGroupProjectReference{ref}
+}
+
+// GrantAllPipelinesAccessToVariableGroup authorizes all pipelines of the project to use the variable group.
+func GrantAllPipelinesAccessToVariableGroup(ctx context.Context, client pipelinepermissions.Client, project string, groupID int) error {
+	_, err := client.UpdatePipelinePermisionsForResource(ctx, pipelinepermissions.UpdatePipelinePermisionsForResourceArgs{
+		ResourceAuthorization: &pipelinepermissions.ResourcePipelinePermissions{
+			AllPipelines: &pipelinepermissions.Permission{
+				Authorized: lo.ToPtr(true),
+			},
+		},
+		Project:      &project,
+		ResourceType: lo.ToPtr(variableGroupResourceType),
+		ResourceId:   lo.ToPtr(strconv.Itoa(groupID)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to grant all pipelines access to variable group %d: %w", groupID, err)
+	}
+	return nil
+}
+
+// PrintVariableGroup renders the variable group as table or as JSON.
+func PrintVariableGroup(ctx util.CmdContext, group *taskagent.VariableGroup, format string) error {
+	if format == "json" {
+		iostrms, err := ctx.IOStreams()
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(iostrms.Out).Encode(group)
+	}
+
+	tp, err := ctx.Printer(format)
+	if err != nil {
+		return err
+	}
+	tp.AddColumns("ID", "Name", "Type", "Variables")
+	tp.AddField(strconv.Itoa(*group.Id), printer.WithTruncate(nil))
+	tp.AddField(lo.FromPtr(group.Name))
+	tp.AddField(lo.FromPtr(group.Type))
+	tp.AddField(strconv.Itoa(len(lo.FromPtr(group.Variables))), printer.WithTruncate(nil))
+	tp.EndRow()
+	return tp.Render()
+}
+
+// UpdateParameters returns the parameters which update the variable group with its current values.
+func UpdateParameters(group *taskagent.VariableGroup) *taskagent.VariableGroupParameters {
+	return &taskagent.VariableGroupParameters{
+		Description:                    group.Description,
+		Name:                           group.Name,
+		ProviderData:                   group.ProviderData,
+		Type:                           group.Type,
+		VariableGroupProjectReferences: group.VariableGroupProjectReferences,
+		Variables:                      group.Variables,
+	}
+}
+
+// GetVariable returns the variable of the group with the given name. The names of variables are
+// case-insensitive. The value of a secret variable is not returned by the API and therefore nil.
+func GetVariable(group *taskagent.VariableGroup, name string) (string, *taskagent.VariableValue, error) {
+	for k, v := range lo.FromPtr(group.Variables) {
+		if !strings.EqualFold(k, name) {
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", nil, err
+		}
+		var value taskagent.VariableValue
+		if err := json.Unmarshal(b, &value); err != nil {
+			return "", nil, fmt.Errorf("failed to parse variable %s: %w", k, err)
+		}
+		return k, &value, nil
+	}
+	return "", nil, nil
+}
+
+// SetVariable sets the variable of the group. An existing variable with the same name is replaced.
+func SetVariable(group *taskagent.VariableGroup, name string, value taskagent.VariableValue) {
+	RemoveVariable(group, name)
+	variables := lo.FromPtr(group.Variables)
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+	variables[name] = value
+	group.Variables = &variables
+}
+
+// RemoveVariable removes the variable from the group. The names of variables are case-insensitive.
+func RemoveVariable(group *taskagent.VariableGroup, name string) {
+	for k := range lo.FromPtr(group.Variables) {
+		if strings.EqualFold(k, name) {
+			delete(*group.Variables, k)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetVariable(t *testing.T) {
+	group := &taskagent.VariableGroup{
+		Variables: &map[string]interface{}{
+			"Environment": map[string]interface{}{"value": "production"},
+			"password":    map[string]interface{}{"value": nil, "isSecret": true},
+		},
+	}
+
+	name, value, err := GetVariable(group, "environment")
+	require.NoError(t, err)
+	assert.Equal(t, "Environment", name)
+	assert.Equal(t, "production", lo.FromPtr(value.Value))
+
+	name, value, err = GetVariable(group, "password")
+	require.NoError(t, err)
+	assert.Equal(t, "password", name)
+	assert.Nil(t, value.Value)
+	assert.True(t, lo.FromPtr(value.IsSecret))
+
+	_, value, err = GetVariable(group, "missing")
+	require.NoError(t, err)
+	assert.Nil(t, value)
+}
+
+func TestSetAndRemoveVariable(t *testing.T) {
+	group := &taskagent.VariableGroup{}
+	SetVariable(group, "Environment", taskagent.VariableValue{Value: lo.ToPtr("staging")})
+	SetVariable(group, "environment", taskagent.VariableValue{Value: lo.ToPtr("production")})
+	assert.Equal(t, map[string]interface{}{
+		"environment": taskagent.VariableValue{Value: lo.ToPtr("production")},
+	}, *group.Variables)
+
+	RemoveVariable(group, "ENVIRONMENT")
+	assert.Empty(t, *group.Variables)
+}
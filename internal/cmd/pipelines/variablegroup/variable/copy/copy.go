@@ -0,0 +1,146 @@
+package copycmd
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type copyOptions struct {
+	variable    string
+	scope       string
+	fromGroupID int
+	toGroupID   int
+	rename      string
+}
+
+func NewCmdCopy(ctx util.CmdContext) *cobra.Command {
+	opts := &copyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "copy <variable> [organization/]project",
+		Short: "Copy a variable from one variable group to another",
+		Long: heredoc.Doc(`
+			Copy a variable from one variable group to another variable group of the same project.
+
+			The copy fails if the destination group already contains a variable with the same name.
+
+			Azure DevOps never returns the values of secret variables. A secret variable is therefore
+			copied as a secret without a value, and its value must be set in the destination group
+			afterwards.
+		`),
+		Example: heredoc.Doc(`
+			# copy the variable environment from variable group 12 to variable group 15
+			azdo pipelines variable-group variable copy environment myproject --from-group-id 12 --to-group-id 15
+
+			# copy the variable and give it a new name in the destination group
+			azdo pipelines variable-group variable copy environment myorg/myproject --from-group-id 12 --to-group-id 15 --rename stage
+		`),
+		Args: util.ExactArgs(2, "cannot copy variable: variable name and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.variable = args[0]
+			opts.scope = args[1]
+
+			return runCopy(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.fromGroupID, "from-group-id", 0, "ID of the variable group to copy the variable from")
+	cmd.Flags().IntVar(&opts.toGroupID, "to-group-id", 0, "ID of the variable group to copy the variable to")
+	cmd.Flags().StringVar(&opts.rename, "rename", "", "Name of the variable in the destination group")
+	_ = cmd.MarkFlagRequired("from-group-id")
+	_ = cmd.MarkFlagRequired("to-group-id")
+
+	return cmd
+}
+
+func runCopy(ctx util.CmdContext, opts *copyOptions) (err error) {
+	if opts.fromGroupID < 1 {
+		return util.FlagErrorf("invalid variable group ID: %d", opts.fromGroupID)
+	}
+	if opts.toGroupID < 1 {
+		return util.FlagErrorf("invalid variable group ID: %d", opts.toGroupID)
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	source, err := client.GetVariableGroup(rctx, taskagent.GetVariableGroupArgs{
+		Project: &project,
+		GroupId: &opts.fromGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get variable group %d: %w", opts.fromGroupID, err)
+	}
+	name, value, err := shared.GetVariable(source, opts.variable)
+	if err != nil {
+		return
+	}
+	if value == nil {
+		return fmt.Errorf("variable %s does not exist in variable group %s", opts.variable, *source.Name)
+	}
+	if opts.rename != "" {
+		name = opts.rename
+	}
+
+	dest, err := client.GetVariableGroup(rctx, taskagent.GetVariableGroupArgs{
+		Project: &project,
+		GroupId: &opts.toGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get variable group %d: %w", opts.toGroupID, err)
+	}
+	existing, _, err := shared.GetVariable(dest, name)
+	if err != nil {
+		return
+	}
+	if existing != "" {
+		return fmt.Errorf("variable %s already exists in variable group %s", existing, *dest.Name)
+	}
+
+	shared.SetVariable(dest, name, *value)
+
+	_, err = client.UpdateVariableGroup(rctx, taskagent.UpdateVariableGroupArgs{
+		VariableGroupParameters: shared.UpdateParameters(dest),
+		GroupId:                 &opts.toGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update variable group %s: %w", *dest.Name, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	cs := iostrms.ColorScheme()
+	if value.IsSecret != nil && *value.IsSecret {
+		fmt.Fprintf(iostrms.ErrOut, "%s Secret variable %s was copied without its value; set the value in variable group %s\n", cs.WarningIcon(), name, *dest.Name)
+	}
+	if iostrms.IsStdoutTTY() {
+		fmt.Fprintf(iostrms.Out, "%s Copied variable %s from %s to %s\n", cs.SuccessIcon(), cs.Bold(name), *source.Name, *dest.Name)
+	}
+	return
+}
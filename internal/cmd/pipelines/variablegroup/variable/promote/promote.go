@@ -0,0 +1,183 @@
+package promote
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type promoteOptions struct {
+	variable         string
+	scope            string
+	fromGroupID      int
+	toGroupID        int
+	toProject        string
+	deleteFromSource bool
+	dryRun           bool
+}
+
+func NewCmdPromote(ctx util.CmdContext) *cobra.Command {
+	opts := &promoteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "promote <variable> [organization/]project",
+		Short: "Move a variable to a variable group shared across projects",
+		Long: heredoc.Doc(`
+			Move a variable from a variable group of a project to a variable group which is shared with
+			other projects of the organization, so the variable is available to all of them.
+
+			Azure DevOps has no organization-level variable groups; a variable group belongs to a
+			project and can be shared with other projects. The destination group is read through the
+			project given by --to-project, which defaults to the project of the source group. A warning
+			is printed if the destination group is not shared with other projects.
+
+			The variable stays in the source group unless --delete-from-source is given. Azure DevOps
+			never returns the values of secret variables, so a secret variable is promoted without its
+			value and cannot be deleted from the source group.
+		`),
+		Example: heredoc.Doc(`
+			# promote the variable registry from variable group 12 to the shared variable group 3
+			azdo pipelines variable-group variable promote registry myproject --from-group-id 12 --to-org-group-id 3
+
+			# move the variable, reading the shared group through the project platform
+			azdo pipelines variable-group variable promote registry myorg/myproject --from-group-id 12 --to-org-group-id 3 --to-project platform --delete-from-source
+
+			# show what moving the variable would change
+			azdo pipelines variable-group variable promote registry myproject --from-group-id 12 --to-org-group-id 3 --delete-from-source --dry-run
+		`),
+		Args: util.ExactArgs(2, "cannot promote variable: variable name and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.variable = args[0]
+			opts.scope = args[1]
+
+			return runPromote(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.fromGroupID, "from-group-id", 0, "ID of the variable group to move the variable from")
+	cmd.Flags().IntVar(&opts.toGroupID, "to-org-group-id", 0, "ID of the shared variable group to move the variable to")
+	cmd.Flags().StringVar(&opts.toProject, "to-project", "", "Project of the shared variable group (default: project of the source group)")
+	cmd.Flags().BoolVar(&opts.deleteFromSource, "delete-from-source", false, "Delete the variable from the source group")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be changed without changing the variable groups")
+	_ = cmd.MarkFlagRequired("from-group-id")
+	_ = cmd.MarkFlagRequired("to-org-group-id")
+
+	return cmd
+}
+
+func runPromote(ctx util.CmdContext, opts *promoteOptions) (err error) {
+	if opts.fromGroupID < 1 {
+		return util.FlagErrorf("invalid variable group ID: %d", opts.fromGroupID)
+	}
+	if opts.toGroupID < 1 {
+		return util.FlagErrorf("invalid variable group ID: %d", opts.toGroupID)
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	toProject := lo.Ternary(opts.toProject != "", opts.toProject, project)
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	source, err := client.GetVariableGroup(rctx, taskagent.GetVariableGroupArgs{
+		Project: &project,
+		GroupId: &opts.fromGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get variable group %d: %w", opts.fromGroupID, err)
+	}
+	name, value, err := shared.GetVariable(source, opts.variable)
+	if err != nil {
+		return
+	}
+	if value == nil {
+		return fmt.Errorf("variable %s does not exist in variable group %s", opts.variable, *source.Name)
+	}
+	isSecret := lo.FromPtr(value.IsSecret)
+	if isSecret && opts.deleteFromSource {
+		return fmt.Errorf("cannot delete secret variable %s from variable group %s: its value cannot be read and would be lost", name, *source.Name)
+	}
+
+	dest, err := client.GetVariableGroup(rctx, taskagent.GetVariableGroupArgs{
+		Project: &toProject,
+		GroupId: &opts.toGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get variable group %d: %w", opts.toGroupID, err)
+	}
+	existing, _, err := shared.GetVariable(dest, name)
+	if err != nil {
+		return
+	}
+	if existing != "" {
+		return fmt.Errorf("variable %s already exists in variable group %s", existing, *dest.Name)
+	}
+
+	shared.SetVariable(dest, name, *value)
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("add variable %s to variable group %s", name, *dest.Name), func() error {
+		_, err := client.UpdateVariableGroup(rctx, taskagent.UpdateVariableGroupArgs{
+			VariableGroupParameters: shared.UpdateParameters(dest),
+			GroupId:                 &opts.toGroupID,
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update variable group %s: %w", *dest.Name, err)
+	}
+
+	if opts.deleteFromSource {
+		shared.RemoveVariable(source, name)
+		err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("delete variable %s from variable group %s", name, *source.Name), func() error {
+			_, err := client.UpdateVariableGroup(rctx, taskagent.UpdateVariableGroupArgs{
+				VariableGroupParameters: shared.UpdateParameters(source),
+				GroupId:                 &opts.fromGroupID,
+			})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("added variable %s to variable group %s but failed to delete it from variable group %s: %w", name, *dest.Name, *source.Name, err)
+		}
+	}
+	iostrms.StopProgressIndicator()
+	if opts.dryRun {
+		return
+	}
+
+	cs := iostrms.ColorScheme()
+	if len(lo.FromPtr(dest.VariableGroupProjectReferences)) < 2 {
+		fmt.Fprintf(iostrms.ErrOut, "%s Variable group %s is not shared with other projects\n", cs.WarningIcon(), *dest.Name)
+	}
+	if isSecret {
+		fmt.Fprintf(iostrms.ErrOut, "%s Secret variable %s was promoted without its value; set the value in variable group %s\n", cs.WarningIcon(), name, *dest.Name)
+	}
+	if iostrms.IsStdoutTTY() {
+		verb := lo.Ternary(opts.deleteFromSource, "Moved", "Copied")
+		fmt.Fprintf(iostrms.Out, "%s %s variable %s from %s to %s\n", cs.SuccessIcon(), verb, cs.Bold(name), *source.Name, *dest.Name)
+	}
+	return
+}
@@ -0,0 +1,147 @@
+package setsecret
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/taskagent"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type setSecretOptions struct {
+	scope       string
+	groupID     int
+	name        string
+	value       string
+	promptValue bool
+}
+
+func NewCmdSetSecret(ctx util.CmdContext) *cobra.Command {
+	opts := &setSecretOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set-secret [organization/]project",
+		Short: "Set a secret variable of a variable group",
+		Long: heredoc.Doc(`
+			Set the value of a secret variable of a variable group.
+
+			The variable is created if it does not exist. An existing variable is turned into a
+			secret variable.
+
+			A value passed with --value may be kept in the history of your shell; use --prompt-value
+			to enter the value interactively instead.
+		`),
+		Example: heredoc.Doc(`
+			# set the secret variable apiKey of variable group 12
+			azdo pipelines variable-group variable set-secret myproject --group-id 12 --name apiKey --value s3cr3t
+
+			# enter the value of the secret interactively
+			azdo pipelines variable-group variable set-secret myorg/myproject --group-id 12 --name apiKey --prompt-value
+		`),
+		Args: util.ExactArgs(1, "cannot set secret variable: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+			if !cmd.Flags().Changed("value") && !opts.promptValue {
+				return util.FlagErrorf("one of --value or --prompt-value is required")
+			}
+
+			return runSetSecret(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.groupID, "group-id", 0, "ID of the variable group")
+	cmd.Flags().StringVar(&opts.name, "name", "", "Name of the variable")
+	cmd.Flags().StringVar(&opts.value, "value", "", "Value of the variable")
+	cmd.Flags().BoolVar(&opts.promptValue, "prompt-value", false, "Prompt for the value of the variable")
+	cmd.MarkFlagsMutuallyExclusive("value", "prompt-value")
+	_ = cmd.MarkFlagRequired("group-id")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func runSetSecret(ctx util.CmdContext, opts *setSecretOptions) (err error) {
+	if opts.groupID < 1 {
+		return util.FlagErrorf("invalid variable group ID: %d", opts.groupID)
+	}
+	if opts.name == "" {
+		return util.FlagErrorf("--name must not be empty")
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	if opts.promptValue {
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--prompt-value requires an interactive terminal; use --value instead")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		opts.value, err = p.Password(fmt.Sprintf("Value of %s:", opts.name))
+		if err != nil {
+			return err
+		}
+	}
+
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := taskagent.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	group, err := client.GetVariableGroup(rctx, taskagent.GetVariableGroupArgs{
+		Project: &project,
+		GroupId: &opts.groupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get variable group %d: %w", opts.groupID, err)
+	}
+	name, _, err := shared.GetVariable(group, opts.name)
+	if err != nil {
+		return
+	}
+	if name == "" {
+		name = opts.name
+	}
+
+	shared.SetVariable(group, name, taskagent.VariableValue{
+		Value:    &opts.value,
+		IsSecret: lo.ToPtr(true),
+	})
+
+	_, err = client.UpdateVariableGroup(rctx, taskagent.UpdateVariableGroupArgs{
+		VariableGroupParameters: shared.UpdateParameters(group),
+		GroupId:                 &opts.groupID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update variable group %s: %w", *group.Name, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Set secret variable %s of variable group %s\n", cs.SuccessIcon(), cs.Bold(name), *group.Name)
+	}
+	return
+}
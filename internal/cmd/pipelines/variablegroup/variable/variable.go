@@ -0,0 +1,21 @@
+package variable
+
+import (
+	"github.com/spf13/cobra"
+	copycmd "github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/variable/copy"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/variable/promote"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/variable/setsecret"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdVariable(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "variable <command>",
+		Short: "Manage the variables of variable groups",
+	}
+
+	cmd.AddCommand(copycmd.NewCmdCopy(ctx))
+	cmd.AddCommand(promote.NewCmdPromote(ctx))
+	cmd.AddCommand(setsecret.NewCmdSetSecret(ctx))
+	return cmd
+}
@@ -0,0 +1,21 @@
+package variablegroup
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/create"
+	importcmd "github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/import"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/variablegroup/variable"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdVariableGroup(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "variable-group <command>",
+		Short: "Manage variable groups",
+	}
+
+	cmd.AddCommand(create.NewCmdCreate(ctx))
+	cmd.AddCommand(importcmd.NewCmdImport(ctx))
+	cmd.AddCommand(variable.NewCmdVariable(ctx))
+	return cmd
+}
@@ -0,0 +1,314 @@
+package validate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelines"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// yamlProcessType is the process type of pipelines which are defined in YAML
+const yamlProcessType = 2
+
+// locationPattern matches the location prefix of a YAML error, e.g. "/azure-pipelines.yml (Line: 3, Col: 5): "
+var locationPattern = regexp.MustCompile(`^(?:(\S+) )?\(Line: (\d+), Col: (\d+)\): `)
+
+type validateOptions struct {
+	file       string
+	scope      string
+	pipelineID int
+	format     string
+}
+
+// validationError is an error found in a pipeline YAML document.
+type validationError struct {
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e validationError) String() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Message)
+}
+
+func NewCmdValidate(ctx util.CmdContext) *cobra.Command {
+	opts := &validateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "validate <file> [organization/]project",
+		Short: "Validate a pipeline YAML file",
+		Long: heredoc.Doc(`
+			Validate a pipeline YAML file without committing it.
+
+			The file is expanded by Azure Pipelines as if it were the YAML of the pipeline given by
+			--pipeline-id, so templates are resolved from the repository of that pipeline.
+
+			Without --pipeline-id, the YAML pipeline of the project which is defined in the repository
+			of the current directory is used. If the repository has several YAML pipelines, the one
+			whose YAML file is the validated file is used.
+		`),
+		Example: heredoc.Doc(`
+			# validate azure-pipelines.yml in the context of the pipeline of the current repository
+			azdo pipelines yaml validate azure-pipelines.yml myproject
+
+			# validate the YAML in the context of pipeline 12
+			azdo pipelines yaml validate ci.yml myproject --pipeline-id 12
+
+			# print the validation errors as JSON
+			azdo pipelines yaml validate ci.yml myorg/myproject --pipeline-id 12 --format json
+		`),
+		Args: util.ExactArgs(2, "cannot validate: file and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.file = args[0]
+			opts.scope = args[1]
+
+			if cmd.Flags().Changed("pipeline-id") && opts.pipelineID < 1 {
+				return util.FlagErrorf("invalid pipeline ID: %d", opts.pipelineID)
+			}
+
+			return runValidate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.pipelineID, "pipeline-id", 0, "ID of the pipeline in whose context the file is validated")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "", []string{"json"}, "Output format of validation errors")
+
+	return cmd
+}
+
+func runValidate(ctx util.CmdContext, opts *validateOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	content, err := iostrms.ReadUserFile(opts.file)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.file, err)
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client := pipelines.NewClient(rctx, conn)
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	pipelineID := opts.pipelineID
+	if pipelineID == 0 {
+		pipelineID, err = resolvePipeline(ctx, rctx, conn, project, opts.file)
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = client.Preview(rctx, pipelines.PreviewArgs{
+		RunParameters: &pipelines.RunPipelineParameters{
+			PreviewRun:   lo.ToPtr(true),
+			YamlOverride: lo.ToPtr(string(content)),
+		},
+		Project:    &project,
+		PipelineId: &pipelineID,
+	})
+	iostrms.StopProgressIndicator()
+	if err == nil {
+		if opts.format == "json" {
+			return json.NewEncoder(iostrms.Out).Encode([]validationError{})
+		}
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Valid\n", cs.SuccessIcon())
+		return nil
+	}
+
+	message, ok := validationMessage(err)
+	if !ok {
+		return fmt.Errorf("failed to validate %s: %w", opts.file, err)
+	}
+	errs := parseValidationErrors(message)
+	if opts.format == "json" {
+		if err := json.NewEncoder(iostrms.Out).Encode(errs); err != nil {
+			return err
+		}
+		return util.ErrSilent
+	}
+	cs := iostrms.ColorScheme()
+	for _, e := range errs {
+		fmt.Fprintf(iostrms.ErrOut, "%s %s\n", cs.FailureIcon(), e)
+	}
+	return util.ErrSilent
+}
+
+// resolvePipeline returns the ID of the YAML pipeline of the project which is defined in the
+// repository of the current directory. If there are several, the pipeline whose YAML file is file
+// is returned.
+func resolvePipeline(ctx util.CmdContext, rctx context.Context, conn *azuredevops.Connection, project, file string) (int, error) {
+	repoCtx, err := ctx.RepoContext()
+	if err != nil {
+		return 0, err
+	}
+	repo, err := repoCtx.Repo()
+	if err != nil {
+		return 0, util.FlagErrorf("no --pipeline-id specified and %s", err)
+	}
+
+	gitClient, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	repository, err := gitClient.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      lo.ToPtr(repo.Project()),
+		RepositoryId: lo.ToPtr(repo.Name()),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get repository %s: %w", repo.Name(), err)
+	}
+
+	buildClient, err := build.NewClient(rctx, conn)
+	if err != nil {
+		return 0, err
+	}
+	var definitions []build.BuildDefinitionReference
+	continuationToken := ""
+	for {
+		res, err := buildClient.GetDefinitions(rctx, build.GetDefinitionsArgs{
+			Project:           &project,
+			RepositoryId:      lo.ToPtr(repository.Id.String()),
+			RepositoryType:    lo.ToPtr("TfsGit"),
+			ProcessType:       lo.ToPtr(yamlProcessType),
+			ContinuationToken: lo.EmptyableToPtr(continuationToken),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to list pipelines of repository %s: %w", repo.Name(), err)
+		}
+		definitions = append(definitions, res.Value...)
+		continuationToken = res.ContinuationToken
+		if continuationToken == "" {
+			break
+		}
+	}
+
+	switch len(definitions) {
+	case 0:
+		return 0, util.FlagErrorf("project %s has no YAML pipeline for repository %s; specify one with --pipeline-id", project, repo.Name())
+	case 1:
+		return *definitions[0].Id, nil
+	}
+
+	path, err := repositoryPath(ctx, rctx, file)
+	if err != nil {
+		return 0, err
+	}
+	for _, d := range definitions {
+		definition, err := buildClient.GetDefinition(rctx, build.GetDefinitionArgs{
+			Project:      &project,
+			DefinitionId: d.Id,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get pipeline %d: %w", *d.Id, err)
+		}
+		if yamlFilename(definition.Process) == path {
+			return *d.Id, nil
+		}
+	}
+	return 0, util.FlagErrorf("repository %s has %d YAML pipelines and none uses %s; specify one with --pipeline-id", repo.Name(), len(definitions), file)
+}
+
+// repositoryPath returns the path of file relative to the root of the repository of the current directory.
+func repositoryPath(ctx util.CmdContext, rctx context.Context, file string) (string, error) {
+	gitClient, err := ctx.GitClient()
+	if err != nil {
+		return "", err
+	}
+	root, err := gitClient.ToplevelDir(rctx)
+	if err != nil {
+		return "", err
+	}
+	abs, err := filepath.Abs(file)
+	if err != nil {
+		return "", err
+	}
+	path, err := filepath.Rel(root, abs)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(path), nil
+}
+
+// yamlFilename returns the path of the YAML file of a pipeline process relative to the root of
+// the repository, or an empty string if the process is not a YAML process.
+func yamlFilename(process any) string {
+	b, err := json.Marshal(process)
+	if err != nil {
+		return ""
+	}
+	var p build.YamlProcess
+	if err := json.Unmarshal(b, &p); err != nil || lo.FromPtr(p.Type) != yamlProcessType {
+		return ""
+	}
+	return strings.TrimPrefix(lo.FromPtr(p.YamlFilename), "/")
+}
+
+// validationMessage returns the message of the error if the service rejected the YAML document.
+func validationMessage(err error) (string, bool) {
+	var wrapped azuredevops.WrappedError
+	var wrappedPtr *azuredevops.WrappedError
+	switch {
+	case errors.As(err, &wrappedPtr):
+		wrapped = *wrappedPtr
+	case errors.As(err, &wrapped):
+	default:
+		return "", false
+	}
+	if lo.FromPtr(wrapped.StatusCode) != http.StatusBadRequest || wrapped.Message == nil {
+		return "", false
+	}
+	return *wrapped.Message, true
+}
+
+// parseValidationErrors splits the error message returned by the service into single errors and
+// extracts the location of each error.
+func parseValidationErrors(message string) []validationError {
+	var errs []validationError
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		e := validationError{Message: line}
+		if m := locationPattern.FindStringSubmatch(line); m != nil {
+			e.File = m[1]
+			e.Line, _ = strconv.Atoi(m[2])
+			e.Column, _ = strconv.Atoi(m[3])
+			e.Message = line[len(m[0]):]
+		}
+		errs = append(errs, e)
+	}
+	return errs
+}
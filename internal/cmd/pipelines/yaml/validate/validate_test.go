@@ -0,0 +1,76 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseValidationErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []validationError
+	}{
+		{
+			name:    "error with location",
+			message: "/azure-pipelines.yml (Line: 3, Col: 5): Unexpected value 'stepz'",
+			want: []validationError{
+				{File: "/azure-pipelines.yml", Line: 3, Column: 5, Message: "Unexpected value 'stepz'"},
+			},
+		},
+		{
+			name:    "multiple errors",
+			message: "/ci.yml (Line: 1, Col: 1): A sequence was not expected\n/ci.yml (Line: 7, Col: 12): Mapping values are not allowed in this context.\n",
+			want: []validationError{
+				{File: "/ci.yml", Line: 1, Column: 1, Message: "A sequence was not expected"},
+				{File: "/ci.yml", Line: 7, Column: 12, Message: "Mapping values are not allowed in this context."},
+			},
+		},
+		{
+			name:    "error without location",
+			message: "Could not find template 'build.yml'",
+			want: []validationError{
+				{Message: "Could not find template 'build.yml'"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseValidationErrors(tt.message))
+		})
+	}
+}
+
+func TestYAMLFilename(t *testing.T) {
+	tests := []struct {
+		name    string
+		process any
+		want    string
+	}{
+		{
+			name:    "yaml process",
+			process: map[string]any{"type": 2, "yamlFilename": "/pipelines/ci.yml"},
+			want:    "pipelines/ci.yml",
+		},
+		{
+			name:    "yaml process without leading slash",
+			process: map[string]any{"type": 2, "yamlFilename": "azure-pipelines.yml"},
+			want:    "azure-pipelines.yml",
+		},
+		{
+			name:    "designer process",
+			process: map[string]any{"type": 1},
+		},
+		{
+			name: "no process",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, yamlFilename(tt.process))
+		})
+	}
+}
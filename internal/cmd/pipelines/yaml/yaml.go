@@ -0,0 +1,17 @@
+package yaml
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines/yaml/validate"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdYaml(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "yaml <command>",
+		Short: "Work with pipeline YAML files",
+	}
+
+	cmd.AddCommand(validate.NewCmdValidate(ctx))
+	return cmd
+}
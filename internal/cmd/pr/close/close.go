@@ -0,0 +1,159 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// reopenOnPushComment is added to pull requests which are closed with --reopen-on-push
+const reopenOnPushComment = "This pull request was set aside and will be picked up again when new changes are pushed to the source branch. Auto-complete has been cancelled."
+
+type closeOptions struct {
+	organizationName string
+	pullRequestID    int
+	comment          string
+	reopenOnPush     bool
+	dryRun           bool
+}
+
+func NewCmdClose(ctx util.CmdContext) *cobra.Command {
+	opts := &closeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "close <id>",
+		Short: "Close a pull request",
+		Long: heredoc.Doc(`
+			Close a pull request by abandoning it.
+
+			With --reopen-on-push the pull request is not abandoned. Instead it stays active, its
+			auto-complete is cancelled and a comment is added which notes that work continues with
+			the next push. Azure DevOps has no native reopen-on-push feature, so this is only a
+			comment-based reminder for reviewers; nothing happens automatically on push.
+		`),
+		Example: heredoc.Doc(`
+			# abandon pull request 42
+			azdo pr close 42
+
+			# abandon pull request 42 and explain why
+			azdo pr close 42 --organization myorg --comment "Superseded by !43"
+
+			# set pull request 42 aside until the next push
+			azdo pr close 42 --reopen-on-push
+		`),
+		Args: util.ExactArgs(1, "cannot close: pull request ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+
+			return runClose(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	cmd.Flags().StringVarP(&opts.comment, "comment", "c", "", "Comment to add to the pull request")
+	cmd.Flags().BoolVar(&opts.reopenOnPush, "reopen-on-push", false, "Keep the pull request active, cancel auto-complete and leave a comment instead of abandoning it")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be done without closing the pull request")
+
+	return cmd
+}
+
+func runClose(ctx util.CmdContext, opts *closeOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	if err != nil {
+		return
+	}
+	if *pr.Status != git.PullRequestStatusValues.Active {
+		return fmt.Errorf("pull request %d is %s", opts.pullRequestID, *pr.Status)
+	}
+
+	update := &git.GitPullRequest{
+		Status: &git.PullRequestStatusValues.Abandoned,
+	}
+	if opts.reopenOnPush {
+		// setting the empty identity as the one who enabled auto-complete cancels auto-complete
+		update = &git.GitPullRequest{
+			Status: &git.PullRequestStatusValues.Active,
+			AutoCompleteSetBy: &webapi.IdentityRef{
+				Id: lo.ToPtr(uuid.Nil.String()),
+			},
+		}
+	}
+
+	action := fmt.Sprintf("abandon pull request !%d %s", *pr.PullRequestId, *pr.Title)
+	if opts.reopenOnPush {
+		action = fmt.Sprintf("cancel auto-complete of pull request !%d %s and set it aside until the next push", *pr.PullRequestId, *pr.Title)
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, action, func() (err error) {
+		if opts.comment != "" {
+			if _, err := shared.AddComment(rctx, client, pr, opts.comment); err != nil {
+				return err
+			}
+		}
+		pr, err = client.UpdatePullRequest(rctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: update,
+			RepositoryId:           lo.ToPtr(pr.Repository.Id.String()),
+			PullRequestId:          &opts.pullRequestID,
+			Project:                lo.ToPtr(pr.Repository.Project.Id.String()),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to close pull request %d: %w", opts.pullRequestID, err)
+		}
+		if opts.reopenOnPush {
+			if _, err := shared.AddComment(rctx, client, pr, reopenOnPushComment); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil || opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		if opts.reopenOnPush {
+			fmt.Fprintf(iostrms.Out, "%s Set pull request !%d %s aside until the next push\n", cs.SuccessIcon(), *pr.PullRequestId, *pr.Title)
+		} else {
+			fmt.Fprintf(iostrms.Out, "%s Closed pull request !%d %s\n", cs.SuccessIcon(), *pr.PullRequestId, *pr.Title)
+		}
+	}
+	return
+}
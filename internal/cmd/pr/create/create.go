@@ -0,0 +1,235 @@
+package create
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/webapi"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	repository   string
+	sourceBranch string
+	targetBranch string
+	title        string
+	description  string
+	draft        bool
+	labels       []string
+	reviewers    []string
+	autoComplete bool
+	strategy     string
+	deleteSource bool
+	verbose      bool
+}
+
+func NewCmdCreate(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a pull request",
+		Long: heredoc.Doc(`
+			Create a pull request on Azure DevOps.
+
+			When the source branch is not specified, the currently checked out branch is used. When the
+			target branch is not specified, the default branch of the repository is used.
+
+			With --auto-complete the pull request is merged as soon as all policies are satisfied.
+			When --merge-strategy is not given, the strategy configured with "pr.merge.strategy" is
+			used, e.g. "azdo config set pr.merge.strategy squash".
+
+			Reviewers are given by email address, account name or display name. A display name which
+			matches several users is rejected.
+
+			With --verbose the request sent to create the pull request is printed to standard error,
+			and if the request fails, so is the error response of Azure DevOps. This helps to diagnose
+			policy violations or identities which could not be resolved.
+		`),
+		Example: heredoc.Doc(`
+			# create a pull request for the current branch
+			azdo pr create --repo myproject/myrepo --title "Fix the parser"
+
+			# create a draft pull request with labels
+			azdo pr create --repo myorg/myproject/myrepo --source-branch feature --title "WIP" --draft --label bug --label parser
+
+			# create a pull request with reviewers
+			azdo pr create --repo myproject/myrepo --title "Fix the parser" --reviewer alice@example.com --reviewer bob@example.com
+
+			# create a pull request which is squash merged once all policies are satisfied
+			azdo pr create --repo myproject/myrepo --title "Fix the parser" --auto-complete --merge-strategy squash
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !opts.autoComplete && (opts.strategy != "" || opts.deleteSource) {
+				return util.FlagErrorf("--merge-strategy and --delete-source-branch require --auto-complete")
+			}
+			return runCreate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.repository, "repo", "R", "", "Repository in the form [ORGANIZATION/]PROJECT/REPOSITORY")
+	cmd.Flags().StringVarP(&opts.sourceBranch, "source-branch", "s", "", "The branch that contains the commits for the pull request (default: current branch)")
+	cmd.Flags().StringVarP(&opts.targetBranch, "target-branch", "t", "", "The branch into which the changes should be merged (default: default branch of the repository)")
+	cmd.Flags().StringVar(&opts.title, "title", "", "Title of the pull request")
+	cmd.Flags().StringVarP(&opts.description, "description", "d", "", "Description of the pull request")
+	cmd.Flags().BoolVar(&opts.draft, "draft", false, "Create the pull request as draft")
+	cmd.Flags().StringArrayVar(&opts.labels, "label", nil, "Add a label to the pull request; can be repeated")
+	cmd.Flags().StringArrayVar(&opts.reviewers, "reviewer", nil, "Request a review from this user; can be repeated")
+	cmd.Flags().BoolVar(&opts.autoComplete, "auto-complete", false, "Merge the pull request once all policies are satisfied")
+	util.StringEnumFlag(cmd, &opts.strategy, "merge-strategy", "", "", shared.MergeStrategies, "Strategy to merge the pull request with when it is auto-completed (default: pr.merge.strategy configuration)")
+	cmd.Flags().BoolVar(&opts.deleteSource, "delete-source-branch", false, "Delete the source branch when the pull request is auto-completed")
+	cmd.Flags().BoolVar(&opts.verbose, "verbose", false, "Print the request and the error response to standard error")
+	_ = cmd.MarkFlagRequired("repo")
+	_ = cmd.MarkFlagRequired("title")
+
+	return cmd
+}
+
+func runCreate(ctx util.CmdContext, opts *createOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, repository, err := util.ParseRepositoryScope(ctx, opts.repository)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &repository,
+	})
+	if err != nil {
+		return
+	}
+
+	sourceBranch := opts.sourceBranch
+	if sourceBranch == "" {
+		gitClient, err := ctx.GitClient()
+		if err != nil {
+			return err
+		}
+		sourceBranch, err = gitClient.CurrentBranch(rctx)
+		if err != nil {
+			return fmt.Errorf("failed to determine source branch: %w", err)
+		}
+	}
+	targetBranch := opts.targetBranch
+	if targetBranch == "" {
+		if repo.DefaultBranch == nil {
+			return util.FlagErrorf("repository %s has no default branch; specify the target branch with --target-branch", *repo.Name)
+		}
+		targetBranch = *repo.DefaultBranch
+	}
+
+	toCreate := &git.GitPullRequest{
+		SourceRefName: lo.ToPtr(util.NormalizeBranchRef(sourceBranch)),
+		TargetRefName: lo.ToPtr(util.NormalizeBranchRef(targetBranch)),
+		Title:         &opts.title,
+		Description:   &opts.description,
+		IsDraft:       &opts.draft,
+	}
+	if opts.autoComplete {
+		strategy := git.GitPullRequestMergeStrategy(opts.strategy)
+		if strategy == "" {
+			strategy, err = shared.DefaultMergeStrategy(ctx, organizationName)
+			if err != nil {
+				return
+			}
+		}
+		toCreate.CompletionOptions = &git.GitPullRequestCompletionOptions{
+			MergeStrategy:      &strategy,
+			DeleteSourceBranch: &opts.deleteSource,
+		}
+	}
+	if len(opts.labels) > 0 {
+		labels := make([]core.WebApiTagDefinition, 0, len(opts.labels))
+		for _, l := range lo.Uniq(opts.labels) {
+			labels = append(labels, core.WebApiTagDefinition{
+				Name: lo.ToPtr(strings.TrimSpace(l)),
+			})
+		}
+		toCreate.Labels = &labels
+	}
+	if len(opts.reviewers) > 0 {
+		identityClient, err := identity.NewClient(rctx, conn)
+		if err != nil {
+			return err
+		}
+		ids, err := resolveReviewers(rctx, identityClient, opts.reviewers)
+		if err != nil {
+			return err
+		}
+		toCreate.Reviewers = lo.ToPtr(lo.Map(ids, func(id identity.Identity, _ int) git.IdentityRefWithVote {
+			return git.IdentityRefWithVote{Id: lo.ToPtr(id.Id.String())}
+		}))
+	}
+
+	args := git.CreatePullRequestArgs{
+		GitPullRequestToCreate: toCreate,
+		RepositoryId:           lo.ToPtr(repo.Id.String()),
+		Project:                &project,
+	}
+	if opts.verbose {
+		if err := logJSON(iostrms.ErrOut, "Request", args); err != nil {
+			return err
+		}
+	}
+	pr, err := client.CreatePullRequest(rctx, args)
+	if err != nil {
+		if res := responseError(err); opts.verbose && res != nil {
+			_ = logJSON(iostrms.ErrOut, "Response", res)
+		}
+		return fmt.Errorf("failed to create pull request: %w", err)
+	}
+
+	if opts.autoComplete {
+		// auto-complete can only be enabled on an existing pull request
+		user, err := util.GetAuthenticatedUser(rctx, conn)
+		if err != nil {
+			return err
+		}
+		pr, err = client.UpdatePullRequest(rctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: &git.GitPullRequest{
+				AutoCompleteSetBy: &webapi.IdentityRef{
+					Id: lo.ToPtr(user.Id.String()),
+				},
+				CompletionOptions: toCreate.CompletionOptions,
+			},
+			RepositoryId:  lo.ToPtr(repo.Id.String()),
+			PullRequestId: pr.PullRequestId,
+			Project:       &project,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to enable auto-complete of pull request: %w", err)
+		}
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.ErrOut, "%s Created pull request !%d %s\n", cs.SuccessIcon(), *pr.PullRequestId, *pr.Title)
+	}
+	fmt.Fprintln(iostrms.Out, shared.PullRequestWebURL(repo, *pr.PullRequestId))
+	return
+}
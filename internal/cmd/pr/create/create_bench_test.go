@@ -0,0 +1,42 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func BenchmarkResolveReviewers(b *testing.B) {
+	ctx := context.Background()
+	client, reviewers := newReviewers(20)
+
+	b.Run("serial", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, r := range reviewers {
+				if _, err := resolveReviewer(ctx, client, r); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := resolveReviewers(ctx, client, reviewers); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// newReviewers returns a client with n users, which simulates the latency of the API, and their email addresses.
+func newReviewers(n int) (*fakeIdentityClient, []string) {
+	client := &fakeIdentityClient{latency: time.Millisecond}
+	reviewers := make([]string, n)
+	for i := range reviewers {
+		reviewers[i] = fmt.Sprintf("user%d@example.com", i)
+		client.identities = append(client.identities, newIdentity(fmt.Sprintf("User %d", i), reviewers[i]))
+	}
+	return client, reviewers
+}
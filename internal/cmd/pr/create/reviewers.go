@@ -0,0 +1,120 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/samber/lo"
+)
+
+// maxConcurrentLookups is the maximum number of reviewers which are resolved concurrently when the
+// batch lookup did not resolve all of them
+const maxConcurrentLookups = 8
+
+// resolveReviewers returns the identities of the reviewers, which are given as email address,
+// account name or display name, in the order of the reviewers.
+//
+// All reviewers are resolved with a single request, as the identities API accepts a comma
+// separated list of filter values. Reviewers which are not matched unambiguously by the result of
+// the batch, e.g. because a display name matches several identities, are resolved with individual
+// requests.
+func resolveReviewers(ctx context.Context, client identity.Client, reviewers []string) ([]identity.Identity, error) {
+	reviewers = lo.Uniq(lo.Map(reviewers, func(r string, _ int) string { return strings.TrimSpace(r) }))
+	if len(reviewers) == 0 {
+		return nil, nil
+	}
+
+	batch, err := readIdentities(ctx, client, strings.Join(reviewers, ","))
+	if err != nil {
+		return nil, err
+	}
+	resolved := make([]*identity.Identity, len(reviewers))
+	for i, r := range reviewers {
+		matches := lo.Filter(batch, func(id identity.Identity, _ int) bool { return identityMatches(&id, r) })
+		if len(matches) == 1 {
+			resolved[i] = &matches[0]
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(reviewers))
+	sem := make(chan struct{}, maxConcurrentLookups)
+	for i, r := range reviewers {
+		if resolved[i] != nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, reviewer string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			resolved[i], errs[i] = resolveReviewer(ctx, client, reviewer)
+		}(i, r)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return lo.Map(resolved, func(id *identity.Identity, _ int) identity.Identity { return *id }), nil
+}
+
+// resolveReviewer returns the single identity matching the reviewer.
+func resolveReviewer(ctx context.Context, client identity.Client, reviewer string) (*identity.Identity, error) {
+	ids, err := readIdentities(ctx, client, reviewer)
+	if err != nil {
+		return nil, err
+	}
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("no user found for reviewer %s", reviewer)
+	case 1:
+		return &ids[0], nil
+	default:
+		return nil, fmt.Errorf("reviewer %s matches %d users; use the email address instead", reviewer, len(ids))
+	}
+}
+
+func readIdentities(ctx context.Context, client identity.Client, filterValue string) ([]identity.Identity, error) {
+	res, err := client.ReadIdentities(ctx, identity.ReadIdentitiesArgs{
+		SearchFilter: lo.ToPtr("General"),
+		FilterValue:  &filterValue,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve reviewers: %w", err)
+	}
+	// inactive identities are users which were removed from the organization
+	return lo.Filter(lo.FromPtr(res), func(id identity.Identity, _ int) bool { return id.IsActive == nil || *id.IsActive }), nil
+}
+
+// identityMatches reports whether the reviewer is the email address, account name or display name
+// of the identity.
+func identityMatches(id *identity.Identity, reviewer string) bool {
+	candidates := []string{
+		lo.FromPtr(id.ProviderDisplayName),
+		lo.FromPtr(id.CustomDisplayName),
+		identityProperty(id, "Mail"),
+		identityProperty(id, "Account"),
+	}
+	return lo.ContainsBy(candidates, func(c string) bool { return c != "" && strings.EqualFold(c, reviewer) })
+}
+
+// identityProperty returns a string property of the identity. Properties are returned by the API in
+// the form {"Mail": {"$type": "System.String", "$value": "user@example.com"}}.
+func identityProperty(id *identity.Identity, name string) string {
+	props, ok := id.Properties.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	prop, ok := props[name].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	value, _ := prop["$value"].(string)
+	return value
+}
@@ -0,0 +1,91 @@
+package create
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeIdentityClient struct {
+	identity.Client
+	identities []identity.Identity
+	// unbatched are the filter values which are only resolved by individual requests
+	unbatched map[string]bool
+	latency   time.Duration
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (c *fakeIdentityClient) ReadIdentities(_ context.Context, args identity.ReadIdentitiesArgs) (*[]identity.Identity, error) {
+	c.mu.Lock()
+	c.calls++
+	c.mu.Unlock()
+	time.Sleep(c.latency)
+
+	values := strings.Split(*args.FilterValue, ",")
+	var res []identity.Identity
+	for _, v := range values {
+		if len(values) > 1 && c.unbatched[v] {
+			continue
+		}
+		res = append(res, lo.Filter(c.identities, func(id identity.Identity, _ int) bool { return identityMatches(&id, v) })...)
+	}
+	return &res, nil
+}
+
+func newIdentity(name, mail string) identity.Identity {
+	return identity.Identity{
+		Id:                  lo.ToPtr(uuid.New()),
+		ProviderDisplayName: &name,
+		Properties: map[string]interface{}{
+			"Mail": map[string]interface{}{"$type": "System.String", "$value": mail},
+		},
+	}
+}
+
+func TestResolveReviewers(t *testing.T) {
+	alice := newIdentity("Alice Smith", "alice@example.com")
+	bob := newIdentity("Bob Jones", "bob@example.com")
+	sam1 := newIdentity("Sam", "sam1@example.com")
+	sam2 := newIdentity("Sam", "sam2@example.com")
+
+	t.Run("batch", func(t *testing.T) {
+		client := &fakeIdentityClient{identities: []identity.Identity{alice, bob}}
+		ids, err := resolveReviewers(context.Background(), client, []string{"bob@example.com", "Alice Smith", "bob@example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, []identity.Identity{bob, alice}, ids)
+		assert.Equal(t, 1, client.calls)
+	})
+
+	t.Run("partial batch", func(t *testing.T) {
+		client := &fakeIdentityClient{
+			identities: []identity.Identity{alice, bob},
+			unbatched:  map[string]bool{"bob@example.com": true},
+		}
+		ids, err := resolveReviewers(context.Background(), client, []string{"alice@example.com", "bob@example.com"})
+		require.NoError(t, err)
+		assert.Equal(t, []identity.Identity{alice, bob}, ids)
+		assert.Equal(t, 2, client.calls)
+	})
+
+	t.Run("ambiguous", func(t *testing.T) {
+		client := &fakeIdentityClient{identities: []identity.Identity{alice, sam1, sam2}}
+		_, err := resolveReviewers(context.Background(), client, []string{"alice@example.com", "Sam"})
+		assert.EqualError(t, err, "reviewer Sam matches 2 users; use the email address instead")
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		client := &fakeIdentityClient{identities: []identity.Identity{alice}}
+		_, err := resolveReviewers(context.Background(), client, []string{"carol@example.com"})
+		assert.EqualError(t, err, "no user found for reviewer carol@example.com")
+	})
+}
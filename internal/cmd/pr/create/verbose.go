@@ -0,0 +1,71 @@
+package create
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/samber/lo"
+)
+
+// sensitiveKeys are parts of keys whose values are scrubbed from verbose output
+var sensitiveKeys = []string{"password", "secret", "token"}
+
+// logJSON writes v as indented JSON to w, preceded by the label. Values of keys which look like
+// credentials are replaced by "***".
+func logJSON(w io.Writer, label string, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	b, err = json.MarshalIndent(scrub(data), "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s:\n%s\n", label, b)
+	return err
+}
+
+func scrub(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if isSensitive(k) {
+				v[k] = "***"
+				continue
+			}
+			v[k] = scrub(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = scrub(e)
+		}
+	}
+	return v
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	return lo.ContainsBy(sensitiveKeys, func(s string) bool { return strings.Contains(key, s) })
+}
+
+// responseError returns the error returned by Azure DevOps for a failed request, which holds the
+// body of the response, or nil if err is no such error.
+func responseError(err error) any {
+	var wrapped azuredevops.WrappedError
+	if errors.As(err, &wrapped) {
+		return wrapped
+	}
+	var wrappedPtr *azuredevops.WrappedError
+	if errors.As(err, &wrappedPtr) {
+		return wrappedPtr
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package create
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogJSON(t *testing.T) {
+	var out bytes.Buffer
+	err := logJSON(&out, "Request", map[string]any{
+		"title": "Fix the parser",
+		"auth": map[string]any{
+			"Password":    "s3cr3t",
+			"accessToken": "abc",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, `Request:
+{
+  "auth": {
+    "Password": "***",
+    "accessToken": "***"
+  },
+  "title": "Fix the parser"
+}
+`, out.String())
+}
+
+func TestResponseError(t *testing.T) {
+	wrapped := azuredevops.WrappedError{Message: lo.ToPtr("TF401179: An active pull request already exists")}
+	assert.Equal(t, wrapped, responseError(fmt.Errorf("failed: %w", wrapped)))
+	assert.Equal(t, &wrapped, responseError(&wrapped))
+	assert.Nil(t, responseError(fmt.Errorf("failed")))
+}
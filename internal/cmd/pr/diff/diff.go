@@ -0,0 +1,197 @@
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// pageSize is the maximum number of changes returned by a single request
+const pageSize = 2000
+
+type diffOptions struct {
+	organizationName string
+	pullRequestID    int
+	nameOnly         bool
+	format           string
+}
+
+// fileChange is a file changed by the pull request.
+type fileChange struct {
+	Path         string `json:"path"`
+	ChangeType   string `json:"changeType"`
+	OriginalPath string `json:"originalPath,omitempty"`
+}
+
+func NewCmdDiff(ctx util.CmdContext) *cobra.Command {
+	opts := &diffOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diff <id>",
+		Short: "List the files changed by a pull request",
+		Long: heredoc.Doc(`
+			List the files changed by a pull request together with the kind of change.
+
+			The changes of the latest iteration of the pull request are compared to the common commit
+			of the source and target branch. The change list is retrieved from Azure DevOps, so no
+			local git repository is required, which makes the command usable in CI pipelines.
+		`),
+		Example: heredoc.Doc(`
+			# list the files changed by pull request 42 with the kind of change
+			azdo pr diff 42
+
+			# list only the paths of the changed files
+			azdo pr diff 42 --name-only --organization myorg
+
+			# print the changed files as JSON
+			azdo pr diff 42 --format json
+		`),
+		Args: util.ExactArgs(1, "cannot show diff: pull request ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+
+			return runDiff(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	cmd.Flags().BoolVar(&opts.nameOnly, "name-only", false, "Print only the paths of the changed files")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "text", []string{"json", "text"}, "Output format")
+	cmd.MarkFlagsMutuallyExclusive("name-only", "format")
+
+	return cmd
+}
+
+func runDiff(ctx util.CmdContext, opts *diffOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	if err != nil {
+		return
+	}
+	changes, err := getChanges(rctx, client, pr)
+	if err != nil {
+		return
+	}
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(changes)
+	}
+	for _, c := range changes {
+		if opts.nameOnly {
+			fmt.Fprintln(iostrms.Out, c.Path)
+		} else {
+			fmt.Fprintf(iostrms.Out, "%s\t%s\n", c.ChangeType, c.Path)
+		}
+	}
+	return nil
+}
+
+// getChanges returns the files changed by the latest iteration of the pull request.
+func getChanges(ctx context.Context, client git.Client, pr *git.GitPullRequest) ([]fileChange, error) {
+	repositoryID := pr.Repository.Id.String()
+	project := pr.Repository.Project.Id.String()
+
+	iterations, err := client.GetPullRequestIterations(ctx, git.GetPullRequestIterationsArgs{
+		RepositoryId:  &repositoryID,
+		PullRequestId: pr.PullRequestId,
+		Project:       &project,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get iterations of pull request %d: %w", *pr.PullRequestId, err)
+	}
+	if len(lo.FromPtr(iterations)) == 0 {
+		return nil, nil
+	}
+	iterationID := lo.Max(lo.Map(*iterations, func(it git.GitPullRequestIteration, _ int) int {
+		return lo.FromPtr(it.Id)
+	}))
+
+	var changes []fileChange
+	for skip := 0; ; {
+		res, err := client.GetPullRequestIterationChanges(ctx, git.GetPullRequestIterationChangesArgs{
+			RepositoryId:  &repositoryID,
+			PullRequestId: pr.PullRequestId,
+			IterationId:   &iterationID,
+			Project:       &project,
+			Top:           lo.ToPtr(pageSize),
+			Skip:          &skip,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get changes of pull request %d: %w", *pr.PullRequestId, err)
+		}
+		parsed, err := parseChanges(lo.FromPtr(res.ChangeEntries))
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, parsed...)
+		if lo.FromPtr(res.NextSkip) == 0 {
+			break
+		}
+		skip = *res.NextSkip
+	}
+	return changes, nil
+}
+
+// parseChanges converts the changes of a pull request iteration into the changed files. The item of
+// a change is untyped in the API, so its path is decoded from JSON. Changes of folders are skipped.
+func parseChanges(entries []git.GitPullRequestChange) ([]fileChange, error) {
+	result := make([]fileChange, 0, len(entries))
+	for _, e := range entries {
+		b, err := json.Marshal(e.Item)
+		if err != nil {
+			return nil, err
+		}
+		var item struct {
+			Path     string `json:"path"`
+			IsFolder bool   `json:"isFolder"`
+		}
+		if err := json.Unmarshal(b, &item); err != nil {
+			return nil, fmt.Errorf("failed to parse change: %w", err)
+		}
+		if item.IsFolder {
+			continue
+		}
+		result = append(result, fileChange{
+			Path:         item.Path,
+			ChangeType:   string(lo.FromPtr(e.ChangeType)),
+			OriginalPath: lo.FromPtr(e.OriginalPath),
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChanges(t *testing.T) {
+	entries := []git.GitPullRequestChange{
+		{
+			ChangeType: &git.VersionControlChangeTypeValues.Edit,
+			Item:       map[string]any{"path": "/src/main.go"},
+		},
+		{
+			ChangeType: &git.VersionControlChangeTypeValues.Add,
+			Item:       map[string]any{"path": "/docs", "isFolder": true},
+		},
+		{
+			ChangeType:   lo.ToPtr(git.VersionControlChangeType("rename")),
+			Item:         map[string]any{"path": "/README.md"},
+			OriginalPath: lo.ToPtr("/README.txt"),
+		},
+	}
+
+	changes, err := parseChanges(entries)
+	require.NoError(t, err)
+	assert.Equal(t, []fileChange{
+		{Path: "/src/main.go", ChangeType: "edit"},
+		{Path: "/README.md", ChangeType: "rename", OriginalPath: "/README.txt"},
+	}, changes)
+}
@@ -0,0 +1,95 @@
+package add
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type addOptions struct {
+	organizationName string
+	pullRequestID    int
+	name             string
+}
+
+func NewCmdLabelAdd(ctx util.CmdContext) *cobra.Command {
+	opts := &addOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add <id>",
+		Short: "Add a label to a pull request",
+		Example: heredoc.Doc(`
+			# add the label "bug" to pull request 42
+			azdo pr label add 42 --name bug
+		`),
+		Args: util.ExactArgs(1, "cannot add label: pull request ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+
+			return runAdd(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	cmd.Flags().StringVar(&opts.name, "name", "", "Name of the label")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func runAdd(ctx util.CmdContext, opts *addOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	if err != nil {
+		return
+	}
+
+	label, err := client.CreatePullRequestLabel(rctx, git.CreatePullRequestLabelArgs{
+		Label: &core.WebApiCreateTagRequestData{
+			Name: &opts.name,
+		},
+		RepositoryId:  lo.ToPtr(pr.Repository.Id.String()),
+		PullRequestId: &opts.pullRequestID,
+		Project:       lo.ToPtr(pr.Repository.Project.Id.String()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add label %s: %w", opts.name, err)
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Added label %s to pull request !%d\n", cs.SuccessIcon(), cs.Bold(*label.Name), opts.pullRequestID)
+	}
+	return
+}
@@ -0,0 +1,21 @@
+package label
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/label/add"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/label/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/label/remove"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdLabel(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "label <command>",
+		Short: "Manage pull request labels",
+	}
+
+	cmd.AddCommand(add.NewCmdLabelAdd(ctx))
+	cmd.AddCommand(list.NewCmdLabelList(ctx))
+	cmd.AddCommand(remove.NewCmdLabelRemove(ctx))
+	return cmd
+}
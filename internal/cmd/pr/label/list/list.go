@@ -0,0 +1,103 @@
+package list
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type listOptions struct {
+	organizationName string
+	pullRequestID    int
+	format           string
+}
+
+func NewCmdLabelList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <id>",
+		Short: "List the labels of a pull request",
+		Example: heredoc.Doc(`
+			# list the labels of pull request 42
+			azdo pr label list 42
+
+			# list the labels of pull request 42 as JSON
+			azdo pr label list 42 --organization myorg --format json
+		`),
+		Args:    util.ExactArgs(1, "cannot list labels: pull request ID required"),
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	if err != nil {
+		return
+	}
+
+	res, err := client.GetPullRequestLabels(rctx, git.GetPullRequestLabelsArgs{
+		RepositoryId:  lo.ToPtr(pr.Repository.Id.String()),
+		PullRequestId: &opts.pullRequestID,
+		Project:       lo.ToPtr(pr.Repository.Project.Id.String()),
+	})
+	if err != nil {
+		return
+	}
+	if res == nil || len(*res) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No labels found for pull request %d", opts.pullRequestID))
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+
+	tp.AddColumns("Name", "Active", "ID")
+	for _, l := range *res {
+		tp.AddField(*l.Name)
+		tp.AddField(strconv.FormatBool(lo.FromPtr(l.Active)))
+		tp.AddField(l.Id.String(), printer.WithTruncate(nil))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,100 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type removeOptions struct {
+	organizationName string
+	pullRequestID    int
+	name             string
+	dryRun           bool
+}
+
+func NewCmdLabelRemove(ctx util.CmdContext) *cobra.Command {
+	opts := &removeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <id>",
+		Short: "Remove a label from a pull request",
+		Example: heredoc.Doc(`
+			# remove the label "wip" from pull request 42
+			azdo pr label remove 42 --name wip
+		`),
+		Args:    util.ExactArgs(1, "cannot remove label: pull request ID required"),
+		Aliases: []string{"rm"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+
+			return runRemove(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	cmd.Flags().StringVar(&opts.name, "name", "", "Name or ID of the label")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be removed without removing it")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func runRemove(ctx util.CmdContext, opts *removeOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	if err != nil {
+		return
+	}
+
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("remove label %s from pull request !%d", opts.name, opts.pullRequestID), func() error {
+		return client.DeletePullRequestLabels(rctx, git.DeletePullRequestLabelsArgs{
+			RepositoryId:  lo.ToPtr(pr.Repository.Id.String()),
+			PullRequestId: &opts.pullRequestID,
+			LabelIdOrName: &opts.name,
+			Project:       lo.ToPtr(pr.Repository.Project.Id.String()),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove label %s: %w", opts.name, err)
+	}
+	if opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Removed label %s from pull request !%d\n", cs.SuccessIcon(), cs.Bold(opts.name), opts.pullRequestID)
+	}
+	return
+}
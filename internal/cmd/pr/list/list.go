@@ -0,0 +1,165 @@
+package list
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type listOptions struct {
+	repository   string
+	status       string
+	sourceBranch string
+	targetBranch string
+	limit        int
+	format       string
+	interactive  bool
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List pull requests of a repository",
+		Long: heredoc.Doc(`
+			List the pull requests of a repository.
+
+			When the repository is not specified, the Azure DevOps repository of the git remotes
+			of the current directory is used.
+
+			With --interactive a pull request can be selected from the list to open it in the
+			browser, check out its source branch or merge it.
+		`),
+		Example: heredoc.Doc(`
+			# list the active pull requests of the repository in the current directory
+			azdo pr list
+
+			# list the completed pull requests into main
+			azdo pr list --repo myorg/myproject/myrepo --status completed --target-branch main
+
+			# select a pull request and act on it
+			azdo pr list -i
+		`),
+		Args:    cobra.NoArgs,
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.limit < 1 {
+				return util.FlagErrorf("invalid limit: %d", opts.limit)
+			}
+			if opts.interactive && opts.format != "table" {
+				return util.FlagErrorf("--interactive is only supported with table output")
+			}
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.repository, "repo", "R", "", "Repository in the form [ORGANIZATION/]PROJECT/REPOSITORY")
+	util.StringEnumFlag(cmd, &opts.status, "status", "s", string(git.PullRequestStatusValues.Active),
+		[]string{
+			string(git.PullRequestStatusValues.Active),
+			string(git.PullRequestStatusValues.Completed),
+			string(git.PullRequestStatusValues.Abandoned),
+			string(git.PullRequestStatusValues.All),
+		}, "Filter by pull request status")
+	cmd.Flags().StringVar(&opts.sourceBranch, "source-branch", "", "Filter by source branch")
+	cmd.Flags().StringVarP(&opts.targetBranch, "target-branch", "t", "", "Filter by target branch")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 30, "Maximum number of pull requests to list")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+	cmd.Flags().BoolVarP(&opts.interactive, "interactive", "i", false, "Select a pull request to open, check out or merge")
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	if opts.interactive && !iostrms.CanPrompt() {
+		return util.FlagErrorf("--interactive requires an interactive terminal")
+	}
+	organizationName, project, repository, err := shared.ParseRepositoryArg(ctx, opts.repository)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &repository,
+	})
+	if err != nil {
+		iostrms.StopProgressIndicator()
+		return fmt.Errorf("failed to get repository %s: %w", repository, err)
+	}
+
+	criteria := &git.GitPullRequestSearchCriteria{
+		Status: lo.ToPtr(git.PullRequestStatus(opts.status)),
+	}
+	if opts.sourceBranch != "" {
+		criteria.SourceRefName = lo.ToPtr(util.NormalizeBranchRef(opts.sourceBranch))
+	}
+	if opts.targetBranch != "" {
+		criteria.TargetRefName = lo.ToPtr(util.NormalizeBranchRef(opts.targetBranch))
+	}
+	res, err := client.GetPullRequests(rctx, git.GetPullRequestsArgs{
+		RepositoryId:   lo.ToPtr(repo.Id.String()),
+		SearchCriteria: criteria,
+		Project:        &project,
+		Top:            &opts.limit,
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to list pull requests: %w", err)
+	}
+	if res == nil || len(*res) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No pull requests found for repository %s", *repo.Name))
+	}
+
+	if opts.interactive {
+		return buildTUIModel(*res).run(ctx, organizationName, client, repo)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+
+	tp.AddColumns("ID", "Title", "Source", "Target", "Status", "Author")
+	for _, pr := range *res {
+		tp.AddField(strconv.Itoa(*pr.PullRequestId), printer.WithTruncate(nil))
+		tp.AddField(*pr.Title)
+		tp.AddField(util.ShortBranchName(lo.FromPtr(pr.SourceRefName)))
+		tp.AddField(util.ShortBranchName(lo.FromPtr(pr.TargetRefName)))
+		tp.AddField(shared.PullRequestState(&pr))
+		if pr.CreatedBy != nil {
+			tp.AddField(lo.FromPtr(pr.CreatedBy.DisplayName))
+		} else {
+			tp.AddField("")
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,120 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// Actions which can be performed on the pull request selected in interactive mode
+const (
+	actionOpen     = "Open in browser"
+	actionCheckout = "Check out source branch"
+	actionMerge    = "Merge"
+	actionCancel   = "Cancel"
+)
+
+var tuiActions = []string{actionOpen, actionCheckout, actionMerge, actionCancel}
+
+// tuiModel holds the pull requests offered for selection in interactive mode.
+type tuiModel struct {
+	prs     []git.GitPullRequest
+	options []string
+}
+
+func buildTUIModel(prs []git.GitPullRequest) *tuiModel {
+	return &tuiModel{
+		prs: prs,
+		options: lo.Map(prs, func(pr git.GitPullRequest, _ int) string {
+			return fmt.Sprintf("!%d %s (%s → %s)",
+				*pr.PullRequestId,
+				*pr.Title,
+				util.ShortBranchName(lo.FromPtr(pr.SourceRefName)),
+				util.ShortBranchName(lo.FromPtr(pr.TargetRefName)))
+		}),
+	}
+}
+
+// run prompts for a pull request and the action to perform on it.
+func (m *tuiModel) run(ctx util.CmdContext, organizationName string, client git.Client, repo *git.GitRepository) error {
+	p, err := ctx.Prompter()
+	if err != nil {
+		return err
+	}
+	idx, err := p.Select("Select a pull request", "", m.options)
+	if err != nil {
+		return err
+	}
+	pr := &m.prs[idx]
+
+	actionIdx, err := p.Select(fmt.Sprintf("What do you want to do with pull request !%d?", *pr.PullRequestId), actionOpen, tuiActions)
+	if err != nil {
+		return err
+	}
+	switch tuiActions[actionIdx] {
+	case actionOpen:
+		return util.OpenInBrowser(ctx, shared.PullRequestWebURL(repo, *pr.PullRequestId))
+	case actionCheckout:
+		return m.checkout(ctx, repo, pr)
+	case actionMerge:
+		return m.merge(ctx, organizationName, client, pr)
+	}
+	return util.ErrCancel
+}
+
+func (m *tuiModel) checkout(ctx util.CmdContext, repo *git.GitRepository, pr *git.GitPullRequest) error {
+	rctx, err := ctx.Context()
+	if err != nil {
+		return err
+	}
+	gitClient, err := ctx.GitClient()
+	if err != nil {
+		return err
+	}
+	return shared.CheckoutBranch(rctx, gitClient, *repo.Project.Name, *repo.Name, lo.FromPtr(pr.SourceRefName))
+}
+
+func (m *tuiModel) merge(ctx util.CmdContext, organizationName string, client git.Client, pr *git.GitPullRequest) error {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return err
+	}
+	p, err := ctx.Prompter()
+	if err != nil {
+		return err
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return err
+	}
+
+	defaultStrategy, err := shared.DefaultMergeStrategy(ctx, organizationName)
+	if err != nil {
+		return err
+	}
+	idx, err := p.Select("Merge strategy", string(defaultStrategy), shared.MergeStrategies)
+	if err != nil {
+		return err
+	}
+	deleteSourceBranch, err := p.Confirm("Delete the source branch after merging?", false)
+	if err != nil {
+		return err
+	}
+
+	// the pull request returned by the list call doesn't include the last merge source commit
+	pr, err = shared.GetPullRequest(rctx, client, *pr.PullRequestId)
+	if err != nil {
+		return err
+	}
+	_, err = shared.CompletePullRequest(rctx, client, pr, git.GitPullRequestMergeStrategy(shared.MergeStrategies[idx]), deleteSourceBranch)
+	if err != nil {
+		return err
+	}
+
+	cs := iostrms.ColorScheme()
+	fmt.Fprintf(iostrms.Out, "%s Merged pull request !%d %s\n", cs.SuccessIcon(), *pr.PullRequestId, *pr.Title)
+	return nil
+}
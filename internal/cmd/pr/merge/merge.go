@@ -0,0 +1,206 @@
+package merge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	gitcmd "github.com/tmeckel/azdo-cli/internal/git"
+)
+
+// pollInterval is the interval in which the pull request is checked while waiting for the merge
+var pollInterval = 2 * time.Second
+
+// mergeTimeout is the maximum time to wait for the merge of the pull request
+var mergeTimeout = 2 * time.Minute
+
+type mergeOptions struct {
+	organizationName   string
+	pullRequestID      int
+	strategy           string
+	deleteSourceBranch bool
+	localCleanup       bool
+	dryRun             bool
+}
+
+func NewCmdMerge(ctx util.CmdContext) *cobra.Command {
+	opts := &mergeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "merge <id>",
+		Short: "Merge a pull request",
+		Long: heredoc.Doc(`
+			Complete a pull request by merging its source branch into its target branch.
+
+			The merge strategy defaults to the value of the "pr.merge.strategy" configuration.
+
+			With --local-cleanup the source branch is deleted from the clone in the current directory
+			after the merge. If the source branch is checked out, the target branch is checked out
+			and pulled first. The pull request is not merged if the local source branch has commits
+			which are not part of the pull request or uncommitted changes, since they would be lost.
+		`),
+		Example: heredoc.Doc(`
+			# squash pull request 42 and delete its source branch on the server
+			azdo pr merge 42 --strategy squash --delete-source-branch
+
+			# merge pull request 42 and delete the local branch
+			azdo pr merge 42 --local-cleanup
+		`),
+		Args: util.ExactArgs(1, "cannot merge: pull request ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+
+			return runMerge(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	util.StringEnumFlag(cmd, &opts.strategy, "strategy", "", "", shared.MergeStrategies, "Merge strategy (default: pr.merge.strategy configuration)")
+	cmd.Flags().BoolVar(&opts.deleteSourceBranch, "delete-source-branch", false, "Delete the source branch on the server after merging")
+	cmd.Flags().BoolVar(&opts.localCleanup, "local-cleanup", false, "Delete the local source branch after merging")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be done without merging the pull request")
+
+	return cmd
+}
+
+func runMerge(ctx util.CmdContext, opts *mergeOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	strategy := git.GitPullRequestMergeStrategy(opts.strategy)
+	if strategy == "" {
+		strategy, err = shared.DefaultMergeStrategy(ctx, organizationName)
+		if err != nil {
+			return
+		}
+	}
+
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	if err != nil {
+		return
+	}
+	if *pr.Status != git.PullRequestStatusValues.Active {
+		return fmt.Errorf("pull request %d is %s", opts.pullRequestID, *pr.Status)
+	}
+	if lo.FromPtr(pr.IsDraft) {
+		return fmt.Errorf("pull request %d is a draft", opts.pullRequestID)
+	}
+	project := *pr.Repository.Project.Name
+	repository := *pr.Repository.Name
+	sourceBranch := util.ShortBranchName(*pr.SourceRefName)
+	targetBranch := util.ShortBranchName(*pr.TargetRefName)
+
+	var gitClient *gitcmd.Client
+	// check before merging, so the pull request is not merged if the local branch cannot be deleted
+	if opts.localCleanup {
+		gitClient, err = ctx.GitClient()
+		if err != nil {
+			return
+		}
+		var sourceCommit string
+		if pr.LastMergeSourceCommit != nil {
+			sourceCommit = lo.FromPtr(pr.LastMergeSourceCommit.CommitId)
+		}
+		if err := shared.CheckLocalCleanup(rctx, gitClient, project, repository, sourceBranch, sourceCommit); err != nil {
+			return fmt.Errorf("cannot clean up local branch: %w", err)
+		}
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	action := fmt.Sprintf("merge pull request !%d %s into %s with strategy %s", *pr.PullRequestId, *pr.Title, targetBranch, strategy)
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, action, func() error {
+		pr, err = shared.CompletePullRequest(rctx, client, pr, strategy, opts.deleteSourceBranch)
+		if err != nil {
+			return err
+		}
+		if !opts.localCleanup {
+			return nil
+		}
+		// the merge is asynchronous, so the target branch is only pulled after the merge completed
+		pr, err = waitForMerge(rctx, client, pr)
+		return err
+	})
+	if err != nil {
+		return
+	}
+	iostrms.StopProgressIndicator()
+
+	cs := iostrms.ColorScheme()
+	if !opts.dryRun && iostrms.IsStdoutTTY() {
+		fmt.Fprintf(iostrms.Out, "%s Merged pull request !%d %s\n", cs.SuccessIcon(), *pr.PullRequestId, *pr.Title)
+	}
+	if !opts.localCleanup {
+		return
+	}
+
+	return util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("delete local branch %s", sourceBranch), func() error {
+		deleted, err := shared.CleanupLocalBranch(rctx, gitClient, project, repository, sourceBranch, targetBranch)
+		if err != nil {
+			return fmt.Errorf("merged pull request %d but failed to clean up local branch: %w", opts.pullRequestID, err)
+		}
+		if deleted && iostrms.IsStdoutTTY() {
+			fmt.Fprintf(iostrms.Out, "%s Deleted local branch %s\n", cs.SuccessIcon(), cs.Bold(sourceBranch))
+		}
+		return nil
+	})
+}
+
+// waitForMerge waits until the pull request is completed or its merge failed.
+func waitForMerge(ctx context.Context, client git.Client, pr *git.GitPullRequest) (*git.GitPullRequest, error) {
+	ctx, cancel := context.WithTimeout(ctx, mergeTimeout)
+	defer cancel()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		switch lo.FromPtr(pr.MergeStatus) {
+		case git.PullRequestAsyncStatusValues.Conflicts, git.PullRequestAsyncStatusValues.Failure, git.PullRequestAsyncStatusValues.RejectedByPolicy:
+			return nil, fmt.Errorf("failed to merge pull request %d: %s", *pr.PullRequestId, *pr.MergeStatus)
+		}
+		if lo.FromPtr(pr.Status) == git.PullRequestStatusValues.Completed {
+			return pr, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("pull request %d was not merged within %s; it may be waiting for policies", *pr.PullRequestId, mergeTimeout)
+		case <-ticker.C:
+		}
+		var err error
+		pr, err = shared.GetPullRequest(ctx, client, *pr.PullRequestId)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package merge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClient struct {
+	git.Client
+	responses []*git.GitPullRequest
+	calls     int
+}
+
+func (c *fakeClient) GetPullRequestById(_ context.Context, _ git.GetPullRequestByIdArgs) (*git.GitPullRequest, error) {
+	pr := c.responses[c.calls]
+	c.calls++
+	return pr, nil
+}
+
+func pullRequest(status git.PullRequestStatus, mergeStatus git.PullRequestAsyncStatus) *git.GitPullRequest {
+	return &git.GitPullRequest{
+		PullRequestId: lo.ToPtr(42),
+		Status:        &status,
+		MergeStatus:   &mergeStatus,
+	}
+}
+
+func TestWaitForMerge(t *testing.T) {
+	interval := pollInterval
+	t.Cleanup(func() { pollInterval = interval })
+	pollInterval = time.Millisecond
+
+	t.Run("completed", func(t *testing.T) {
+		client := &fakeClient{responses: []*git.GitPullRequest{
+			pullRequest(git.PullRequestStatusValues.Active, git.PullRequestAsyncStatusValues.Queued),
+			pullRequest(git.PullRequestStatusValues.Completed, git.PullRequestAsyncStatusValues.Succeeded),
+		}}
+		pr, err := waitForMerge(context.Background(), client, pullRequest(git.PullRequestStatusValues.Active, git.PullRequestAsyncStatusValues.Queued))
+		require.NoError(t, err)
+		assert.Equal(t, git.PullRequestStatusValues.Completed, *pr.Status)
+		assert.Equal(t, 2, client.calls)
+	})
+
+	t.Run("already completed", func(t *testing.T) {
+		client := &fakeClient{}
+		_, err := waitForMerge(context.Background(), client, pullRequest(git.PullRequestStatusValues.Completed, git.PullRequestAsyncStatusValues.Succeeded))
+		require.NoError(t, err)
+		assert.Equal(t, 0, client.calls)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		client := &fakeClient{responses: []*git.GitPullRequest{
+			pullRequest(git.PullRequestStatusValues.Active, git.PullRequestAsyncStatusValues.Conflicts),
+		}}
+		_, err := waitForMerge(context.Background(), client, pullRequest(git.PullRequestStatusValues.Active, git.PullRequestAsyncStatusValues.Queued))
+		assert.EqualError(t, err, "failed to merge pull request 42: conflicts")
+	})
+}
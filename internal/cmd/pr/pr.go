@@ -0,0 +1,41 @@
+package pr
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/close"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/create"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/diff"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/label"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/merge"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/update"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/view"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/vote"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdPR(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr <command>",
+		Short: "Manage pull requests",
+		Long:  `Work with Azure DevOps Git pull requests.`,
+		Example: heredoc.Doc(`
+			$ azdo pr list
+			$ azdo pr create --repo myproject/myrepo --title "Fix the parser"
+			$ azdo pr vote 42 --approved
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(close.NewCmdClose(ctx))
+	cmd.AddCommand(create.NewCmdCreate(ctx))
+	cmd.AddCommand(diff.NewCmdDiff(ctx))
+	cmd.AddCommand(label.NewCmdLabel(ctx))
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(merge.NewCmdMerge(ctx))
+	cmd.AddCommand(update.NewCmdUpdate(ctx))
+	cmd.AddCommand(view.NewCmdView(ctx))
+	cmd.AddCommand(vote.NewCmdVote(ctx))
+	return cmd
+}
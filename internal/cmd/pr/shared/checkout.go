@@ -0,0 +1,116 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/tmeckel/azdo-cli/internal/azdo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/git"
+)
+
+// FindRemote returns the git remote of the current directory which references the repository.
+func FindRemote(ctx context.Context, gitClient *git.Client, project, repository string) (*git.Remote, error) {
+	remotes, err := gitClient.Remotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, remote := range remotes {
+		for _, u := range []*url.URL{remote.FetchURL, remote.PushURL} {
+			repo, err := azdo.RepositoryFromURL(u)
+			if err != nil {
+				continue
+			}
+			if strings.EqualFold(repo.Project(), project) && strings.EqualFold(repo.Name(), repository) {
+				return remote, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no git remote references repository %s/%s", project, repository)
+}
+
+// CheckoutBranch fetches the branch from the remote referencing the repository and checks it out.
+// If no local branch exists, a new branch tracking the remote branch is created.
+func CheckoutBranch(ctx context.Context, gitClient *git.Client, project, repository, branch string) error {
+	remote, err := FindRemote(ctx, gitClient, project, repository)
+	if err != nil {
+		return err
+	}
+	branch = util.ShortBranchName(branch)
+	refspec := fmt.Sprintf("+refs/heads/%[1]s:refs/remotes/%[2]s/%[1]s", branch, remote.Name)
+	if err := gitClient.Fetch(ctx, remote.Name, refspec); err != nil {
+		return err
+	}
+	if gitClient.HasLocalBranch(ctx, branch) {
+		return gitClient.CheckoutBranch(ctx, branch)
+	}
+	return gitClient.CheckoutNewBranch(ctx, remote.Name, branch)
+}
+
+// CheckLocalCleanup returns an error if the local source branch of a pull request cannot be deleted
+// after merging, because the current directory is no clone of the repository, the local source branch
+// has commits which are not part of the pull request, or the source branch is checked out with
+// uncommitted changes. sourceCommit is the last commit of the source branch merged by the pull request.
+func CheckLocalCleanup(ctx context.Context, gitClient *git.Client, project, repository, sourceBranch, sourceCommit string) error {
+	if _, err := FindRemote(ctx, gitClient, project, repository); err != nil {
+		return err
+	}
+	sourceBranch = util.ShortBranchName(sourceBranch)
+	if !gitClient.HasLocalBranch(ctx, sourceBranch) {
+		return nil
+	}
+	// the branch is deleted with force, so commits which were not pushed would be lost
+	tip, err := gitClient.ResolveCommit(ctx, "refs/heads/"+sourceBranch)
+	if err != nil {
+		return err
+	}
+	if tip != sourceCommit {
+		// the pull request commit is missing locally if the branch was not fetched after the last push
+		if merged, err := gitClient.IsAncestor(ctx, tip, sourceCommit); err != nil || !merged {
+			return fmt.Errorf("branch %s has commits which are not part of the pull request", sourceBranch)
+		}
+	}
+	current, err := gitClient.CurrentBranch(ctx)
+	if err != nil || current != sourceBranch {
+		return nil
+	}
+	count, err := gitClient.UncommittedChangeCount(ctx)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("branch %s has %d uncommitted changes", current, count)
+	}
+	return nil
+}
+
+// CleanupLocalBranch deletes the local source branch of a merged pull request. If the source branch
+// is checked out, the target branch is checked out and pulled first. It returns false if there is no
+// local source branch.
+func CleanupLocalBranch(ctx context.Context, gitClient *git.Client, project, repository, sourceBranch, targetBranch string) (bool, error) {
+	sourceBranch = util.ShortBranchName(sourceBranch)
+	targetBranch = util.ShortBranchName(targetBranch)
+
+	// a detached HEAD is not on the source branch, so no checkout is needed
+	if current, err := gitClient.CurrentBranch(ctx); err == nil && current == sourceBranch {
+		if err := CheckoutBranch(ctx, gitClient, project, repository, targetBranch); err != nil {
+			return false, fmt.Errorf("failed to check out branch %s: %w", targetBranch, err)
+		}
+		remote, err := FindRemote(ctx, gitClient, project, repository)
+		if err != nil {
+			return false, err
+		}
+		if err := gitClient.Pull(ctx, remote.Name, targetBranch); err != nil {
+			return false, fmt.Errorf("failed to pull branch %s: %w", targetBranch, err)
+		}
+	}
+	if !gitClient.HasLocalBranch(ctx, sourceBranch) {
+		return false, nil
+	}
+	if err := gitClient.DeleteLocalBranch(ctx, sourceBranch); err != nil {
+		return false, fmt.Errorf("failed to delete branch %s: %w", sourceBranch, err)
+	}
+	return true, nil
+}
@@ -0,0 +1,142 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/config"
+)
+
+// ParsePullRequestID parses a pull request ID argument. The ID may be prefixed with "!"
+// as it is displayed in the Azure DevOps web UI.
+func ParsePullRequestID(arg string) (int, error) {
+	id, err := strconv.Atoi(strings.TrimPrefix(arg, "!"))
+	if err != nil || id < 1 {
+		return 0, util.FlagErrorf("invalid pull request ID: %s", arg)
+	}
+	return id, nil
+}
+
+// GetPullRequest returns the pull request with the specified ID. Pull request IDs are unique
+// within an organization, so no project or repository is required.
+func GetPullRequest(ctx context.Context, client git.Client, id int) (*git.GitPullRequest, error) {
+	pr, err := client.GetPullRequestById(ctx, git.GetPullRequestByIdArgs{
+		PullRequestId: &id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request %d: %w", id, err)
+	}
+	return pr, nil
+}
+
+// PullRequestWebURL returns the URL of a pull request of the repository in the Azure DevOps web UI.
+func PullRequestWebURL(repo *git.GitRepository, id int) string {
+	if repo == nil || repo.WebUrl == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s/pullrequest/%d", *repo.WebUrl, id)
+}
+
+// PullRequestState returns the status of the pull request, or "draft" for an active draft.
+func PullRequestState(pr *git.GitPullRequest) string {
+	if lo.FromPtr(pr.IsDraft) && lo.FromPtr(pr.Status) == git.PullRequestStatusValues.Active {
+		return "draft"
+	}
+	return string(lo.FromPtr(pr.Status))
+}
+
+// ParseRepositoryArg parses a repository argument in the form [ORGANIZATION/]PROJECT/REPOSITORY. If the
+// argument is empty, the Azure DevOps repository of the git remotes of the current directory is used.
+func ParseRepositoryArg(ctx util.CmdContext, scope string) (organizationName, project, repository string, err error) {
+	if scope != "" {
+		return util.ParseRepositoryScope(ctx, scope)
+	}
+	repoCtx, err := ctx.RepoContext()
+	if err != nil {
+		return
+	}
+	repo, err := repoCtx.Repo()
+	if err != nil {
+		return "", "", "", util.FlagErrorf("no repository specified and %s", err)
+	}
+	return repo.Organization(), repo.Project(), repo.Name(), nil
+}
+
+// mergeStrategyKey is the configuration key of the default merge strategy
+const mergeStrategyKey = "pr.merge.strategy"
+
+// MergeStrategies are the names of the strategies which can be used to complete a pull request.
+var MergeStrategies = []string{
+	string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+	string(git.GitPullRequestMergeStrategyValues.Squash),
+	string(git.GitPullRequestMergeStrategyValues.Rebase),
+	string(git.GitPullRequestMergeStrategyValues.RebaseMerge),
+}
+
+// DefaultMergeStrategy returns the merge strategy configured with "pr.merge.strategy" for the
+// organization or, if the organization has no own setting, globally.
+func DefaultMergeStrategy(ctx util.CmdContext, organizationName string) (git.GitPullRequestMergeStrategy, error) {
+	cfg, err := ctx.Config()
+	if err != nil {
+		return "", err
+	}
+	strategy, err := cfg.Get([]string{config.Organizations, organizationName, mergeStrategyKey})
+	if err != nil || strategy == "" {
+		strategy, err = cfg.GetOrDefault([]string{mergeStrategyKey})
+		if err != nil {
+			return "", err
+		}
+	}
+	if !lo.Contains(MergeStrategies, strategy) {
+		return "", fmt.Errorf("invalid value %q of configuration %s; valid values are %s", strategy, mergeStrategyKey, strings.Join(MergeStrategies, ", "))
+	}
+	return git.GitPullRequestMergeStrategy(strategy), nil
+}
+
+// CompletePullRequest completes the pull request by merging it with the given strategy.
+func CompletePullRequest(ctx context.Context, client git.Client, pr *git.GitPullRequest, strategy git.GitPullRequestMergeStrategy, deleteSourceBranch bool) (*git.GitPullRequest, error) {
+	completed, err := client.UpdatePullRequest(ctx, git.UpdatePullRequestArgs{
+		GitPullRequestToUpdate: &git.GitPullRequest{
+			Status:                &git.PullRequestStatusValues.Completed,
+			LastMergeSourceCommit: pr.LastMergeSourceCommit,
+			CompletionOptions: &git.GitPullRequestCompletionOptions{
+				MergeStrategy:      &strategy,
+				DeleteSourceBranch: &deleteSourceBranch,
+			},
+		},
+		RepositoryId:  lo.ToPtr(pr.Repository.Id.String()),
+		PullRequestId: pr.PullRequestId,
+		Project:       lo.ToPtr(pr.Repository.Project.Id.String()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge pull request %d: %w", *pr.PullRequestId, err)
+	}
+	return completed, nil
+}
+
+// AddComment adds a comment to the pull request in a new thread.
+func AddComment(ctx context.Context, client git.Client, pr *git.GitPullRequest, content string) (*git.GitPullRequestCommentThread, error) {
+	thread, err := client.CreateThread(ctx, git.CreateThreadArgs{
+		CommentThread: &git.GitPullRequestCommentThread{
+			Comments: &[]git.Comment{
+				{
+					Content:     &content,
+					CommentType: &git.CommentTypeValues.Text,
+				},
+			},
+			Status: &git.CommentThreadStatusValues.Active,
+		},
+		RepositoryId:  lo.ToPtr(pr.Repository.Id.String()),
+		PullRequestId: pr.PullRequestId,
+		Project:       lo.ToPtr(pr.Repository.Project.Id.String()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add comment to pull request %d: %w", *pr.PullRequestId, err)
+	}
+	return thread, nil
+}
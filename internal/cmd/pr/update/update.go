@@ -0,0 +1,166 @@
+package update
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type updateOptions struct {
+	organizationName string
+	pullRequestID    int
+	title            *string
+	description      *string
+	draft            *bool
+	addLabels        []string
+	removeLabels     []string
+}
+
+func NewCmdUpdate(ctx util.CmdContext) *cobra.Command {
+	opts := &updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Update a pull request",
+		Example: heredoc.Doc(`
+			# change the title of pull request 42
+			azdo pr update 42 --title "Fix the parser"
+
+			# publish a draft pull request
+			azdo pr update 42 --draft=false
+
+			# replace the label "wip" with "ready"
+			azdo pr update 42 --organization myorg --label ready --remove-label wip
+		`),
+		Args: util.ExactArgs(1, "cannot update: pull request ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+
+			if opts.title == nil && opts.description == nil && opts.draft == nil && len(opts.addLabels) == 0 && len(opts.removeLabels) == 0 {
+				return util.FlagErrorf("nothing to update; specify at least one flag")
+			}
+
+			return runUpdate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	util.NilStringFlag(cmd, &opts.title, "title", "", "New title of the pull request")
+	util.NilStringFlag(cmd, &opts.description, "description", "d", "New description of the pull request")
+	util.NilBoolFlag(cmd, &opts.draft, "draft", "", "Mark the pull request as draft")
+	cmd.Flags().StringArrayVar(&opts.addLabels, "label", nil, "Add a label to the pull request; can be repeated")
+	cmd.Flags().StringArrayVar(&opts.removeLabels, "remove-label", nil, "Remove a label from the pull request; can be repeated")
+
+	return cmd
+}
+
+func runUpdate(ctx util.CmdContext, opts *updateOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	if err != nil {
+		return
+	}
+	repositoryID := lo.ToPtr(pr.Repository.Id.String())
+	projectID := lo.ToPtr(pr.Repository.Project.Id.String())
+
+	if opts.title != nil || opts.description != nil || opts.draft != nil {
+		pr, err = client.UpdatePullRequest(rctx, git.UpdatePullRequestArgs{
+			GitPullRequestToUpdate: &git.GitPullRequest{
+				Title:       opts.title,
+				Description: opts.description,
+				IsDraft:     opts.draft,
+			},
+			RepositoryId:  repositoryID,
+			PullRequestId: &opts.pullRequestID,
+			Project:       projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update pull request %d: %w", opts.pullRequestID, err)
+		}
+	}
+
+	if len(opts.addLabels) > 0 || len(opts.removeLabels) > 0 {
+		existing, err := client.GetPullRequestLabels(rctx, git.GetPullRequestLabelsArgs{
+			RepositoryId:  repositoryID,
+			PullRequestId: &opts.pullRequestID,
+			Project:       projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get labels of pull request %d: %w", opts.pullRequestID, err)
+		}
+		hasLabel := func(name string) bool {
+			return lo.ContainsBy(*existing, func(l core.WebApiTagDefinition) bool {
+				return strings.EqualFold(*l.Name, name)
+			})
+		}
+
+		for _, name := range lo.Uniq(opts.addLabels) {
+			if hasLabel(name) {
+				continue
+			}
+			_, err := client.CreatePullRequestLabel(rctx, git.CreatePullRequestLabelArgs{
+				Label: &core.WebApiCreateTagRequestData{
+					Name: lo.ToPtr(name),
+				},
+				RepositoryId:  repositoryID,
+				PullRequestId: &opts.pullRequestID,
+				Project:       projectID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add label %s: %w", name, err)
+			}
+		}
+		for _, name := range lo.Uniq(opts.removeLabels) {
+			if !hasLabel(name) {
+				continue
+			}
+			err := client.DeletePullRequestLabels(rctx, git.DeletePullRequestLabelsArgs{
+				RepositoryId:  repositoryID,
+				PullRequestId: &opts.pullRequestID,
+				LabelIdOrName: lo.ToPtr(name),
+				Project:       projectID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to remove label %s: %w", name, err)
+			}
+		}
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Updated pull request !%d %s\n", cs.SuccessIcon(), *pr.PullRequestId, *pr.Title)
+	}
+	return
+}
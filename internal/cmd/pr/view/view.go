@@ -0,0 +1,178 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+type viewOptions struct {
+	organizationName string
+	pullRequestID    int
+	format           string
+}
+
+func NewCmdView(ctx util.CmdContext) *cobra.Command {
+	opts := &viewOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "view <id>",
+		Short: "View a pull request",
+		Long: heredoc.Doc(`
+			Display the title, branches, author, reviewers and description of a pull request.
+
+			The compact format prints a single line with the ID, title, status and branches, which
+			is handy for status bars and scripts. The raw format prints the pull request as returned
+			by the Azure DevOps API.
+		`),
+		Example: heredoc.Doc(`
+			# view pull request 42
+			azdo pr view 42
+
+			# print a one-line summary of pull request 42
+			azdo pr view 42 --format compact --organization myorg
+
+			# print the pull request as returned by the API
+			azdo pr view 42 --format raw
+		`),
+		Args: util.ExactArgs(1, "cannot view pull request: pull request ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+
+			return runView(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "full", []string{"compact", "full", "raw"}, "Output format")
+
+	return cmd
+}
+
+func runView(ctx util.CmdContext, opts *viewOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return
+	}
+
+	switch opts.format {
+	case "raw":
+		return json.NewEncoder(iostrms.Out).Encode(pr)
+	case "compact":
+		fmt.Fprintln(iostrms.Out, compactLine(pr))
+		return nil
+	}
+	printPullRequest(iostrms.Out, iostrms.ColorScheme(), pr)
+	return nil
+}
+
+// compactLine returns a one-line summary of the pull request.
+func compactLine(pr *git.GitPullRequest) string {
+	return fmt.Sprintf("!%d %s [%s] %s -> %s",
+		lo.FromPtr(pr.PullRequestId),
+		lo.FromPtr(pr.Title),
+		shared.PullRequestState(pr),
+		util.ShortBranchName(lo.FromPtr(pr.SourceRefName)),
+		util.ShortBranchName(lo.FromPtr(pr.TargetRefName)))
+}
+
+func printPullRequest(out io.Writer, cs *iostreams.ColorScheme, pr *git.GitPullRequest) {
+	fmt.Fprintf(out, "%s %s\n", cs.Bold(lo.FromPtr(pr.Title)), cs.Gray(fmt.Sprintf("!%d", lo.FromPtr(pr.PullRequestId))))
+	author := ""
+	if pr.CreatedBy != nil {
+		author = lo.FromPtr(pr.CreatedBy.DisplayName)
+	}
+	created := ""
+	if pr.CreationDate != nil {
+		created = " " + text.FuzzyAgo(time.Now(), pr.CreationDate.Time)
+	}
+	fmt.Fprintf(out, "%s • %s wants to merge %s into %s%s\n",
+		shared.PullRequestState(pr),
+		author,
+		cs.Bold(util.ShortBranchName(lo.FromPtr(pr.SourceRefName))),
+		cs.Bold(util.ShortBranchName(lo.FromPtr(pr.TargetRefName))),
+		created)
+
+	if reviewers := lo.FromPtr(pr.Reviewers); len(reviewers) > 0 {
+		names := lo.Map(reviewers, func(r git.IdentityRefWithVote, _ int) string {
+			name := lo.FromPtr(r.DisplayName)
+			if lo.FromPtr(r.IsRequired) {
+				name += " (required)"
+			}
+			return fmt.Sprintf("%s: %s", name, voteDescription(lo.FromPtr(r.Vote)))
+		})
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Reviewers:"), strings.Join(names, ", "))
+	}
+	if labels := lo.FromPtr(pr.Labels); len(labels) > 0 {
+		fmt.Fprintf(out, "%s %s\n", cs.Bold("Labels:"), strings.Join(lo.FilterMap(labels, func(l core.WebApiTagDefinition, _ int) (string, bool) {
+			return lo.FromPtr(l.Name), l.Name != nil
+		}), ", "))
+	}
+
+	fmt.Fprintln(out)
+	if description := strings.TrimSpace(lo.FromPtr(pr.Description)); description != "" {
+		fmt.Fprintln(out, description)
+	} else {
+		fmt.Fprintln(out, cs.Gray("No description provided"))
+	}
+
+	if url := shared.PullRequestWebURL(pr.Repository, lo.FromPtr(pr.PullRequestId)); url != "" {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, cs.Gray("View this pull request on Azure DevOps: "+url))
+	}
+}
+
+// voteDescription returns the description of a reviewer vote.
+func voteDescription(vote int) string {
+	switch {
+	case vote >= 10:
+		return "approved"
+	case vote > 0:
+		return "approved with suggestions"
+	case vote <= -10:
+		return "rejected"
+	case vote < 0:
+		return "waiting for author"
+	}
+	return "no vote"
+}
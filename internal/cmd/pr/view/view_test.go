@@ -0,0 +1,54 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactLine(t *testing.T) {
+	tests := []struct {
+		name string
+		pr   *git.GitPullRequest
+		want string
+	}{
+		{
+			name: "active",
+			pr: &git.GitPullRequest{
+				PullRequestId: lo.ToPtr(42),
+				Title:         lo.ToPtr("Fix the parser"),
+				Status:        &git.PullRequestStatusValues.Active,
+				SourceRefName: lo.ToPtr("refs/heads/feature/parser"),
+				TargetRefName: lo.ToPtr("refs/heads/main"),
+			},
+			want: "!42 Fix the parser [active] feature/parser -> main",
+		},
+		{
+			name: "draft",
+			pr: &git.GitPullRequest{
+				PullRequestId: lo.ToPtr(7),
+				Title:         lo.ToPtr("WIP"),
+				Status:        &git.PullRequestStatusValues.Active,
+				IsDraft:       lo.ToPtr(true),
+				SourceRefName: lo.ToPtr("refs/heads/wip"),
+				TargetRefName: lo.ToPtr("refs/heads/main"),
+			},
+			want: "!7 WIP [draft] wip -> main",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, compactLine(tt.pr))
+		})
+	}
+}
+
+func TestVoteDescription(t *testing.T) {
+	assert.Equal(t, "approved", voteDescription(10))
+	assert.Equal(t, "approved with suggestions", voteDescription(5))
+	assert.Equal(t, "no vote", voteDescription(0))
+	assert.Equal(t, "waiting for author", voteDescription(-5))
+	assert.Equal(t, "rejected", voteDescription(-10))
+}
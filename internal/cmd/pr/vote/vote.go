@@ -0,0 +1,203 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// Vote values of a pull request reviewer
+const (
+	voteApproved                = 10
+	voteApprovedWithSuggestions = 5
+	voteNone                    = 0
+	voteWaitingForAuthor        = -5
+	voteRejected                = -10
+)
+
+type voteOptions struct {
+	organizationName        string
+	pullRequestID           int
+	approved                bool
+	approvedWithSuggestions bool
+	waiting                 bool
+	rejected                bool
+	reset                   bool
+	autoApprove             bool
+	comment                 string
+}
+
+func NewCmdVote(ctx util.CmdContext) *cobra.Command {
+	opts := &voteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "vote <id>",
+		Short: "Vote on a pull request",
+		Long: heredoc.Doc(`
+			Cast a vote on a pull request as the authenticated user.
+
+			Exactly one of the vote flags must be given.
+
+			With --auto-approve-if-no-conflicts the vote depends on the merge status of the pull
+			request: if it merges without conflicts it is approved, otherwise the vote is waiting for
+			author and a comment explaining the merge status is added. This is meant for CI jobs
+			which approve conflict-free pull requests.
+		`),
+		Example: heredoc.Doc(`
+			# approve pull request 42
+			azdo pr vote 42 --approved
+
+			# request changes from the author of pull request 42
+			azdo pr vote 42 --waiting --organization myorg
+
+			# remove the vote from pull request 42
+			azdo pr vote 42 --reset
+
+			# approve pull request 42 if it has no merge conflicts
+			azdo pr vote 42 --auto-approve-if-no-conflicts --comment "Checked by CI"
+		`),
+		Args: util.ExactArgs(1, "cannot vote: pull request ID required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := shared.ParsePullRequestID(args[0])
+			if err != nil {
+				return err
+			}
+			opts.pullRequestID = id
+			if opts.comment != "" && !opts.autoApprove {
+				return util.FlagErrorf("--comment requires --auto-approve-if-no-conflicts")
+			}
+
+			return runVote(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Use organization")
+	cmd.Flags().BoolVar(&opts.approved, "approved", false, "Approve the pull request")
+	cmd.Flags().BoolVar(&opts.approvedWithSuggestions, "approved-with-suggestions", false, "Approve the pull request with suggestions")
+	cmd.Flags().BoolVar(&opts.waiting, "waiting", false, "Wait for the author to respond")
+	cmd.Flags().BoolVar(&opts.rejected, "rejected", false, "Reject the pull request")
+	cmd.Flags().BoolVar(&opts.reset, "reset", false, "Reset the vote")
+	cmd.Flags().BoolVar(&opts.autoApprove, "auto-approve-if-no-conflicts", false, "Approve the pull request if it has no merge conflicts, otherwise wait for the author")
+	cmd.Flags().StringVarP(&opts.comment, "comment", "c", "", "Comment to add to the pull request with --auto-approve-if-no-conflicts")
+	cmd.MarkFlagsMutuallyExclusive("approved", "approved-with-suggestions", "waiting", "rejected", "reset", "auto-approve-if-no-conflicts")
+
+	return cmd
+}
+
+func (opts *voteOptions) vote() (int, string, error) {
+	switch {
+	case opts.approved:
+		return voteApproved, "approved", nil
+	case opts.approvedWithSuggestions:
+		return voteApprovedWithSuggestions, "approved with suggestions", nil
+	case opts.waiting:
+		return voteWaitingForAuthor, "waiting for author", nil
+	case opts.rejected:
+		return voteRejected, "rejected", nil
+	case opts.reset:
+		return voteNone, "reset", nil
+	case opts.autoApprove:
+		// decided by autoVote once the merge status is known
+		return voteNone, "", nil
+	}
+	return 0, "", util.FlagErrorf("one of --approved, --approved-with-suggestions, --waiting, --rejected, --reset or --auto-approve-if-no-conflicts is required")
+}
+
+// autoVote returns the vote for the merge status of a pull request and the comment to add to it.
+// Pull requests which merge without conflicts are approved, all others wait for the author.
+func autoVote(status *git.PullRequestAsyncStatus, comment string) (vote int, description string, content string) {
+	if status != nil && *status == git.PullRequestAsyncStatusValues.Succeeded {
+		return voteApproved, "approved", comment
+	}
+
+	var warning string
+	switch lo.FromPtr(status) {
+	case git.PullRequestAsyncStatusValues.Conflicts:
+		warning = "This pull request has merge conflicts with the target branch. Please resolve them."
+	case git.PullRequestAsyncStatusValues.Queued, git.PullRequestAsyncStatusValues.NotSet, "":
+		warning = "The merge status of this pull request has not been determined yet. Please try again later."
+	default:
+		warning = fmt.Sprintf("The merge of this pull request into the target branch did not succeed (merge status: %s).", *status)
+	}
+	if comment != "" {
+		warning = comment + "\n\n" + warning
+	}
+	return voteWaitingForAuthor, "waiting for author", warning
+}
+
+func runVote(ctx util.CmdContext, opts *voteOptions) (err error) {
+	vote, voteDescription, err := opts.vote()
+	if err != nil {
+		return
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	pr, err := shared.GetPullRequest(rctx, client, opts.pullRequestID)
+	if err != nil {
+		return
+	}
+
+	var comment string
+	if opts.autoApprove {
+		vote, voteDescription, comment = autoVote(pr.MergeStatus, opts.comment)
+	}
+
+	user, err := util.GetAuthenticatedUser(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	_, err = client.CreatePullRequestReviewer(rctx, git.CreatePullRequestReviewerArgs{
+		Reviewer: &git.IdentityRefWithVote{
+			Vote: &vote,
+		},
+		RepositoryId:  lo.ToPtr(pr.Repository.Id.String()),
+		PullRequestId: &opts.pullRequestID,
+		ReviewerId:    lo.ToPtr(user.Id.String()),
+		Project:       lo.ToPtr(pr.Repository.Project.Id.String()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to vote on pull request %d: %w", opts.pullRequestID, err)
+	}
+	if comment != "" {
+		if _, err := shared.AddComment(rctx, client, pr, comment); err != nil {
+			return err
+		}
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		if vote == voteNone {
+			fmt.Fprintf(iostrms.Out, "%s Reset vote on pull request !%d %s\n", cs.SuccessIcon(), *pr.PullRequestId, *pr.Title)
+		} else {
+			fmt.Fprintf(iostrms.Out, "%s Voted %s on pull request !%d %s\n", cs.SuccessIcon(), cs.Bold(voteDescription), *pr.PullRequestId, *pr.Title)
+		}
+	}
+	return
+}
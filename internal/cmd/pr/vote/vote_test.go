@@ -0,0 +1,33 @@
+package vote
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoVote(t *testing.T) {
+	vote, description, comment := autoVote(&git.PullRequestAsyncStatusValues.Succeeded, "")
+	assert.Equal(t, voteApproved, vote)
+	assert.Equal(t, "approved", description)
+	assert.Empty(t, comment)
+
+	vote, _, comment = autoVote(&git.PullRequestAsyncStatusValues.Succeeded, "Checked by CI")
+	assert.Equal(t, voteApproved, vote)
+	assert.Equal(t, "Checked by CI", comment)
+
+	vote, description, comment = autoVote(&git.PullRequestAsyncStatusValues.Conflicts, "Checked by CI")
+	assert.Equal(t, voteWaitingForAuthor, vote)
+	assert.Equal(t, "waiting for author", description)
+	assert.Contains(t, comment, "Checked by CI\n\n")
+	assert.Contains(t, comment, "merge conflicts")
+
+	vote, _, comment = autoVote(nil, "")
+	assert.Equal(t, voteWaitingForAuthor, vote)
+	assert.Contains(t, comment, "not been determined")
+
+	vote, _, comment = autoVote(&git.PullRequestAsyncStatusValues.Failure, "")
+	assert.Equal(t, voteWaitingForAuthor, vote)
+	assert.Contains(t, comment, "merge status: failure")
+}
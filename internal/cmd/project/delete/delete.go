@@ -0,0 +1,206 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/operations"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// pollInterval is the interval in which the status of the delete operation is checked
+var pollInterval = 2 * time.Second
+
+// operationTimeout is the maximum time to wait for the delete operation to finish
+var operationTimeout = 10 * time.Minute
+
+type deleteOptions struct {
+	project          string
+	organizationName string
+	yes              bool
+	confirmName      string
+	dryRun           bool
+}
+
+func NewCmdProjectDelete(ctx util.CmdContext) *cobra.Command {
+	opts := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <project> [organization]",
+		Short: "Delete a project",
+		Long: heredoc.Doc(`
+			Delete a project with all its repositories, pipelines, work items and other data.
+
+			Before the deletion the number and total size of the repositories of the project are
+			shown. Unless --yes is given, the name of the project must be typed to confirm the
+			deletion. In scripts, pass the name of the project with --confirm-name instead; the
+			deletion is aborted if it does not match the name of the project.
+
+			The command waits up to 10 minutes until Azure DevOps has finished deleting the project.
+		`),
+		Example: heredoc.Doc(`
+			# delete the project myproject of the default organization after typing its name
+			azdo project delete myproject
+
+			# delete the project in a script
+			azdo project delete myproject myorg --confirm-name myproject
+		`),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.project = args[0]
+			if len(args) > 1 {
+				opts.organizationName = args[1]
+			}
+			return runDelete(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	cmd.Flags().StringVar(&opts.confirmName, "confirm-name", "", "Name of the project to confirm the deletion without prompting")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be deleted without deleting it")
+	cmd.MarkFlagsMutuallyExclusive("yes", "confirm-name")
+
+	return cmd
+}
+
+func runDelete(ctx util.CmdContext, opts *deleteOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	gitClient, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	project, err := client.GetProject(rctx, core.GetProjectArgs{
+		ProjectId: &opts.project,
+	})
+	if err != nil {
+		iostrms.StopProgressIndicator()
+		return fmt.Errorf("failed to get project %s: %w", opts.project, err)
+	}
+	repos, err := gitClient.GetRepositories(rctx, git.GetRepositoriesArgs{
+		Project: lo.ToPtr(project.Id.String()),
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get repositories of project %s: %w", *project.Name, err)
+	}
+
+	var size uint64
+	for _, r := range lo.FromPtr(repos) {
+		size += lo.FromPtr(r.Size)
+	}
+	cs := iostrms.ColorScheme()
+	repoCount := len(lo.FromPtr(repos))
+	fmt.Fprintf(iostrms.ErrOut, "%s Project %s contains %d %s with a total size of %s\n", cs.WarningIcon(), cs.Bold(*project.Name),
+		repoCount, lo.Ternary(repoCount == 1, "repository", "repositories"), text.FormatBytes(int64(size)))
+
+	switch {
+	case opts.confirmName != "":
+		if opts.confirmName != *project.Name {
+			return fmt.Errorf("--confirm-name %q does not match the name of project %s", opts.confirmName, *project.Name)
+		}
+	case !opts.yes && !opts.dryRun:
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--yes or --confirm-name required when not running interactively")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		if err := p.ConfirmDeletion(*project.Name); err != nil {
+			return err
+		}
+	}
+
+	operationsClient := operations.NewClient(rctx, conn)
+	iostrms.StartProgressIndicatorWithLabel(fmt.Sprintf("Deleting project %s", *project.Name))
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("delete project %s (%s)", *project.Name, project.Id), func() error {
+		ref, err := client.QueueDeleteProject(rctx, core.QueueDeleteProjectArgs{
+			ProjectId: project.Id,
+		})
+		if err != nil {
+			return err
+		}
+		if ref == nil || ref.Id == nil {
+			return fmt.Errorf("no delete operation was queued")
+		}
+		return waitForOperation(rctx, operationsClient, *ref.Id)
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to delete project %s: %w", *project.Name, err)
+	}
+	if opts.dryRun {
+		return
+	}
+
+	fmt.Fprintf(iostrms.Out, "%s Deleted project %s (%s)\n", cs.SuccessIcon(), cs.Bold(*project.Name), project.Id)
+	return
+}
+
+// waitForOperation polls the status of the operation until it has finished or operationTimeout has
+// passed. An error is returned if the operation failed, was cancelled or is still running.
+func waitForOperation(ctx context.Context, client operations.Client, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, operationTimeout)
+	defer cancel()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		op, err := client.GetOperation(ctx, operations.GetOperationArgs{
+			OperationId: &id,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get status of operation %s: %w", id, err)
+		}
+		switch lo.FromPtr(op.Status) {
+		case operations.OperationStatusValues.Succeeded:
+			return nil
+		case operations.OperationStatusValues.Failed, operations.OperationStatusValues.Cancelled:
+			msg := lo.FromPtr(op.ResultMessage)
+			if msg == "" {
+				msg = lo.FromPtr(op.DetailedMessage)
+			}
+			return fmt.Errorf("operation %s: %s", *op.Status, msg)
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("deletion is still running after %s, check the status of operation %s", operationTimeout, id)
+			}
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
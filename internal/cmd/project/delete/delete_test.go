@@ -0,0 +1,61 @@
+package delete
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/operations"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeOperationsClient struct {
+	statuses []operations.OperationStatus
+	calls    int
+}
+
+// GetOperation returns the statuses in order and repeats the last one.
+func (c *fakeOperationsClient) GetOperation(_ context.Context, args operations.GetOperationArgs) (*operations.Operation, error) {
+	status := c.statuses[len(c.statuses)-1]
+	if c.calls < len(c.statuses) {
+		status = c.statuses[c.calls]
+	}
+	c.calls++
+	return &operations.Operation{
+		Id:            args.OperationId,
+		Status:        &status,
+		ResultMessage: lo.ToPtr("project is locked"),
+	}, nil
+}
+
+func TestWaitForOperation(t *testing.T) {
+	pollInterval = time.Millisecond
+	defer func() { pollInterval = 2 * time.Second }()
+
+	client := &fakeOperationsClient{statuses: []operations.OperationStatus{
+		operations.OperationStatusValues.Queued,
+		operations.OperationStatusValues.InProgress,
+		operations.OperationStatusValues.Succeeded,
+	}}
+	assert.NoError(t, waitForOperation(context.Background(), client, uuid.New()))
+	assert.Equal(t, 3, client.calls)
+
+	client = &fakeOperationsClient{statuses: []operations.OperationStatus{
+		operations.OperationStatusValues.Failed,
+	}}
+	assert.EqualError(t, waitForOperation(context.Background(), client, uuid.New()), "operation failed: project is locked")
+}
+
+func TestWaitForOperationTimeout(t *testing.T) {
+	interval, timeout := pollInterval, operationTimeout
+	t.Cleanup(func() { pollInterval, operationTimeout = interval, timeout })
+	pollInterval = time.Millisecond
+	operationTimeout = 10 * time.Millisecond
+
+	id := uuid.New()
+	client := &fakeOperationsClient{statuses: []operations.OperationStatus{operations.OperationStatusValues.InProgress}}
+	err := waitForOperation(context.Background(), client, id)
+	assert.EqualError(t, err, "deletion is still running after 10ms, check the status of operation "+id.String())
+}
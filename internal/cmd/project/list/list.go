@@ -1,22 +1,35 @@
 package list
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/tmeckel/azdo-cli/internal/cmd/util"
 	"github.com/tmeckel/azdo-cli/internal/printer"
 )
 
+// maxConcurrentRequests is the maximum number of projects whose properties are fetched concurrently
+const maxConcurrentRequests = 8
+
 type listOptions struct {
 	organizationName string
 	limit            int
 	state            string
+	withProperties   bool
 	format           string
 }
 
+// properties are the properties of a project which are not returned when listing projects.
+type properties struct {
+	processTemplate string
+	versionControl  string
+}
+
 func NewCmdProjectList(ctx util.CmdContext) *cobra.Command {
 	opts := &listOptions{}
 
@@ -29,6 +42,9 @@ func NewCmdProjectList(ctx util.CmdContext) *cobra.Command {
 
 			# list the projects for an Azure DevOps organization including closed projects
 			azdo project list --organization myorg --closed
+
+			# list the projects with their process template, version control and visibility
+			azdo project list --with-properties
 		`),
 		Aliases: []string{"ls"},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -37,7 +53,7 @@ func NewCmdProjectList(ctx util.CmdContext) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Get per-organization configuration")
-	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+	util.AddOutputFormatFlag(cmd, &opts.format)
 	util.StringEnumFlag(cmd, &opts.state, "state", "", "",
 		[]string{
 			string(core.ProjectStateValues.Deleting),
@@ -49,24 +65,15 @@ func NewCmdProjectList(ctx util.CmdContext) *cobra.Command {
 			string(core.ProjectStateValues.Deleted),
 		}, "Project state filter")
 	cmd.Flags().IntVarP(&opts.limit, "limit", "l", 30, "Maximum number of projects to fetch")
+	cmd.Flags().BoolVar(&opts.withProperties, "with-properties", false, "Add the process template, version control and visibility of the projects; requires a request per project")
 
 	return cmd
 }
 
 func runList(ctx util.CmdContext, opts *listOptions) (err error) {
-	cfg, err := ctx.Config()
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
 	if err != nil {
-		return util.FlagErrorf("error getting io configuration: %w", err)
-	}
-
-	var organizationName string
-	if opts.organizationName != "" {
-		organizationName = opts.organizationName
-	} else {
-		organizationName, _ = cfg.Authentication().GetDefaultOrganization()
-	}
-	if organizationName == "" {
-		return util.FlagErrorf("no organization specified")
+		return
 	}
 	conn, err := ctx.Connection(organizationName)
 	if err != nil {
@@ -95,17 +102,78 @@ func runList(ctx util.CmdContext, opts *listOptions) (err error) {
 		return util.NewNoResultsError(fmt.Sprintf("No projects found for organization %s", organizationName))
 	}
 
+	var props []properties
+	if opts.withProperties {
+		props, err = getProperties(rctx, orgClient, res.Value)
+		if err != nil {
+			return
+		}
+	}
+
 	tp, err := ctx.Printer(opts.format)
 	if err != nil {
 		return
 	}
 
-	tp.AddColumns("ID", "Name", "State")
-	for _, p := range res.Value {
+	columns := []string{"ID", "Name", "State"}
+	if opts.withProperties {
+		columns = append(columns, "Process Template", "Version Control", "Visibility")
+	}
+	tp.AddColumns(columns...)
+	for i, p := range res.Value {
 		tp.AddField(p.Id.String(), printer.WithTruncate(nil))
 		tp.AddField(*p.Name)
 		tp.AddField(string(*p.State))
+		if opts.withProperties {
+			tp.AddField(props[i].processTemplate)
+			tp.AddField(props[i].versionControl)
+			tp.AddField(string(lo.FromPtr(p.Visibility)))
+		}
 		tp.EndRow()
 	}
 	return tp.Render()
 }
+
+// getProperties returns the properties of the projects in the order of the projects. The capabilities
+// of a project are only returned when getting a single project, so the projects are fetched
+// concurrently.
+func getProperties(ctx context.Context, client core.Client, projects []core.TeamProjectReference) ([]properties, error) {
+	props := make([]properties, len(projects))
+	errs := make([]error, len(projects))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentRequests)
+	for i := range projects {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			project, err := client.GetProject(ctx, core.GetProjectArgs{
+				ProjectId:           lo.ToPtr(projects[i].Id.String()),
+				IncludeCapabilities: lo.ToPtr(true),
+			})
+			if err != nil {
+				errs[i] = fmt.Errorf("failed to get project %s: %w", lo.FromPtr(projects[i].Name), err)
+				return
+			}
+			props[i] = projectProperties(project)
+		}(i)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return props, nil
+}
+
+// projectProperties returns the properties from the capabilities of the project, which are in the
+// form {"processTemplate": {"templateName": "Agile"}, "versioncontrol": {"sourceControlType": "Git"}}.
+func projectProperties(project *core.TeamProject) properties {
+	capabilities := lo.FromPtr(project.Capabilities)
+	return properties{
+		processTemplate: capabilities["processTemplate"]["templateName"],
+		versionControl:  capabilities["versioncontrol"]["sourceControlType"],
+	}
+}
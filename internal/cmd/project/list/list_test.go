@@ -0,0 +1,19 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectProperties(t *testing.T) {
+	project := &core.TeamProject{
+		Capabilities: &map[string]map[string]string{
+			"processTemplate": {"templateName": "Agile", "templateTypeId": "adcc42ab-9882-485e-a3ed-7678f01f66bc"},
+			"versioncontrol":  {"sourceControlType": "Git", "gitEnabled": "True"},
+		},
+	}
+	assert.Equal(t, properties{processTemplate: "Agile", versionControl: "Git"}, projectProperties(project))
+	assert.Equal(t, properties{}, projectProperties(&core.TeamProject{}))
+}
@@ -3,6 +3,7 @@ package project
 import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/project/delete"
 	"github.com/tmeckel/azdo-cli/internal/cmd/project/list"
 	"github.com/tmeckel/azdo-cli/internal/cmd/util"
 )
@@ -14,11 +15,12 @@ func NewCmdProject(ctx util.CmdContext) *cobra.Command {
 		Example: heredoc.Doc(`
 			$ azdo project create -o <organization> <project>
 			$ azdo project list
-			$ azdo project delete -o <organization> <project>
+			$ azdo project delete <project> <organization>
 		`),
 		GroupID: "core",
 	}
 
+	cmd.AddCommand(delete.NewCmdProjectDelete(ctx))
 	cmd.AddCommand(list.NewCmdProjectList(ctx))
 	return cmd
 }
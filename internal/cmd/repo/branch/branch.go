@@ -0,0 +1,21 @@
+package branch
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/branch/create"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/branch/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/branch/rename"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdBranch(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "branch <command>",
+		Short: "Manage the branches of a repository",
+	}
+
+	cmd.AddCommand(create.NewCmdCreate(ctx))
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(rename.NewCmdRename(ctx))
+	return cmd
+}
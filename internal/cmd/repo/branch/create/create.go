@@ -0,0 +1,149 @@
+package create
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/branch/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+var commitIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+type createOptions struct {
+	name           string
+	repository     string
+	scope          string
+	source         string
+	ignoreIfExists bool
+}
+
+func NewCmdCreate(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create <name> <repository> [organization/]project",
+		Short: "Create a branch in a repository",
+		Long: heredoc.Doc(`
+			Create a branch in a repository.
+
+			The branch is created at the head of the branch given by --source, or at the commit if
+			--source is a full commit ID. Without --source the branch is created at the head of the
+			default branch of the repository.
+
+			Creating a branch which already exists fails, unless --ignore-if-exists is given. The
+			existing branch is left unchanged then, which makes the command safe to repeat in
+			automation.
+		`),
+		Example: heredoc.Doc(`
+			# create the branch feature/login from the default branch
+			azdo repo branch create feature/login myrepo myproject
+
+			# create a release branch from develop, unless it already exists
+			azdo repo branch create release/1.2 myrepo myorg/myproject --source develop --ignore-if-exists
+		`),
+		Args: util.ExactArgs(3, "cannot create branch: name, repository and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.name = args[0]
+			opts.repository = args[1]
+			opts.scope = args[2]
+
+			return runCreate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.source, "source", "", "Branch or full commit ID to create the branch from")
+	cmd.Flags().BoolVar(&opts.ignoreIfExists, "ignore-if-exists", false, "Do not fail if the branch already exists")
+
+	return cmd
+}
+
+func runCreate(ctx util.CmdContext, opts *createOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &opts.repository,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+	}
+	repositoryID := repo.Id.String()
+
+	ref := util.NormalizeBranchRef(opts.name)
+	name := util.ShortBranchName(ref)
+	existing, err := shared.FindRef(rctx, client, project, repositoryID, ref)
+	if err != nil {
+		return
+	}
+	if existing != nil {
+		if !opts.ignoreIfExists {
+			return fmt.Errorf("branch %s already exists in repository %s", name, *repo.Name)
+		}
+		iostrms.StopProgressIndicator()
+		fmt.Fprintf(iostrms.ErrOut, "branch %s already exists in repository %s, skipping\n", name, *repo.Name)
+		return nil
+	}
+
+	objectID := opts.source
+	if !commitIDPattern.MatchString(objectID) {
+		sourceRef := lo.FromPtr(repo.DefaultBranch)
+		if opts.source != "" {
+			sourceRef = util.NormalizeBranchRef(opts.source)
+		}
+		if sourceRef == "" {
+			return fmt.Errorf("repository %s has no default branch; use --source", *repo.Name)
+		}
+		source, err := shared.FindRef(rctx, client, project, repositoryID, sourceRef)
+		if err != nil {
+			return err
+		}
+		if source == nil {
+			return fmt.Errorf("branch %s does not exist in repository %s", util.ShortBranchName(sourceRef), *repo.Name)
+		}
+		objectID = *source.ObjectId
+	}
+
+	err = shared.UpdateRef(rctx, client, project, repositoryID, git.GitRefUpdate{
+		Name:        &ref,
+		OldObjectId: lo.ToPtr(shared.ZeroObjectID),
+		NewObjectId: &objectID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create branch %s: %w", name, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Created branch %s of %s at %s\n", cs.SuccessIcon(), cs.Bold(name), *repo.Name, objectID[:7])
+	}
+	return
+}
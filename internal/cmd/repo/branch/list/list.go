@@ -0,0 +1,294 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// policyConfigurationsLocationID is the location of the policy configurations of a project. They are
+// requested without the policy client of the SDK, which does not pass on the continuation token.
+var policyConfigurationsLocationID = uuid.MustParse("dad91cbe-d183-45f8-9c6e-9c1164472121")
+
+const apiVersion = "7.1-preview.1"
+
+type listOptions struct {
+	repository      string
+	scope           string
+	stale           string
+	exceptProtected bool
+	format          string
+}
+
+type branch struct {
+	Name           string     `json:"name"`
+	LastCommitID   string     `json:"lastCommitId"`
+	LastCommitDate *time.Time `json:"lastCommitDate,omitempty"`
+	Author         string     `json:"author"`
+	AheadCount     int        `json:"aheadCount"`
+	BehindCount    int        `json:"behindCount"`
+	IsDefault      bool       `json:"isDefault"`
+}
+
+// policyScope is the scope of a branch policy as found in the policy settings.
+type policyScope struct {
+	RepositoryID string `json:"repositoryId"`
+	RefName      string `json:"refName"`
+	MatchKind    string `json:"matchKind"`
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <repository> [organization/]project",
+		Short: "List the branches of a repository",
+		Long: heredoc.Doc(`
+			List the branches of a repository with their last commit and how far they are ahead
+			of and behind the default branch.
+
+			With --stale only branches whose last commit is older than the given duration are
+			listed, oldest first. The duration accepts the units "d" for days and "w" for weeks
+			in addition to the units of Go durations, e.g. "90d", "2w" or "36h".
+		`),
+		Example: heredoc.Doc(`
+			# list the branches of a repository
+			azdo repo branch list myrepo myproject
+
+			# find branches without commits in the last 90 days which are not protected by policies
+			azdo repo branch list myrepo myorg/myproject --stale 90d --except-protected
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(2, "cannot list branches: repository and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.repository = args[0]
+			opts.scope = args[1]
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.stale, "stale", "", "Only list branches whose last commit is older than this duration, e.g. \"90d\"")
+	cmd.Flags().BoolVar(&opts.exceptProtected, "except-protected", false, "Exclude branches with enabled branch policies")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	var staleAfter time.Duration
+	if opts.stale != "" {
+		staleAfter, err = util.ParseDuration(opts.stale)
+		if err != nil {
+			return util.FlagErrorf("invalid value for --stale: %w", err)
+		}
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &opts.repository,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+	}
+
+	stats, err := client.GetBranches(rctx, git.GetBranchesArgs{
+		RepositoryId: lo.ToPtr(repo.Id.String()),
+		Project:      &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get branches of repository %s: %w", *repo.Name, err)
+	}
+	branches := lo.Map(lo.FromPtr(stats), func(s git.GitBranchStats, _ int) branch {
+		return newBranch(&s, util.ShortBranchName(lo.FromPtr(repo.DefaultBranch)))
+	})
+
+	if opts.exceptProtected {
+		scopes, err := getPolicyScopes(rctx, conn, project, repo.Id.String())
+		if err != nil {
+			return err
+		}
+		branches = lo.Reject(branches, func(b branch, _ int) bool {
+			return isProtected(scopes, b.Name)
+		})
+	}
+
+	if opts.stale != "" {
+		cutoff := time.Now().Add(-staleAfter)
+		branches = lo.Filter(branches, func(b branch, _ int) bool {
+			return b.LastCommitDate != nil && b.LastCommitDate.Before(cutoff)
+		})
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branches[i].LastCommitDate.Before(*branches[j].LastCommitDate)
+		})
+	} else {
+		sort.SliceStable(branches, func(i, j int) bool {
+			return branches[i].Name < branches[j].Name
+		})
+	}
+	iostrms.StopProgressIndicator()
+
+	if len(branches) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No branches found in repository %s", *repo.Name))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(branches)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	tp.AddColumns("Name", "Last Commit", "Author", "Ahead", "Behind")
+	for _, b := range branches {
+		name := b.Name
+		if b.IsDefault {
+			name += " (default)"
+		}
+		tp.AddField(name)
+		if b.LastCommitDate != nil {
+			if iostrms.IsStdoutTTY() {
+				tp.AddField(text.FuzzyAgo(now, *b.LastCommitDate))
+			} else {
+				tp.AddField(b.LastCommitDate.Format(time.RFC3339))
+			}
+		} else {
+			tp.AddField("")
+		}
+		tp.AddField(b.Author)
+		tp.AddField(strconv.Itoa(b.AheadCount), printer.WithTruncate(nil))
+		tp.AddField(strconv.Itoa(b.BehindCount), printer.WithTruncate(nil))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+func newBranch(s *git.GitBranchStats, defaultBranch string) branch {
+	b := branch{
+		Name:        lo.FromPtr(s.Name),
+		AheadCount:  lo.FromPtr(s.AheadCount),
+		BehindCount: lo.FromPtr(s.BehindCount),
+		IsDefault:   lo.FromPtr(s.Name) == defaultBranch,
+	}
+	if s.Commit != nil {
+		b.LastCommitID = lo.FromPtr(s.Commit.CommitId)
+		if c := s.Commit.Committer; c != nil && c.Date != nil {
+			b.LastCommitDate = &c.Date.Time
+		}
+		if a := s.Commit.Author; a != nil {
+			b.Author = lo.FromPtr(a.Name)
+		}
+	}
+	return b
+}
+
+// getPolicyScopes returns the branch scopes of all enabled policies of the project which apply to the repository.
+func getPolicyScopes(ctx context.Context, conn *azuredevops.Connection, project, repositoryID string) ([]policyScope, error) {
+	client, err := conn.GetClientByResourceAreaId(ctx, policy.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	var configurations []policy.PolicyConfiguration
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		if continuationToken != "" {
+			query.Add("continuationToken", continuationToken)
+		}
+		resp, err := client.Send(ctx, http.MethodGet, policyConfigurationsLocationID, apiVersion, map[string]string{
+			"project": project,
+		}, query, nil, "", "application/json", nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get policies of project %s: %w", project, err)
+		}
+		var page []policy.PolicyConfiguration
+		if err := client.UnmarshalCollectionBody(resp, &page); err != nil {
+			return nil, fmt.Errorf("failed to get policies of project %s: %w", project, err)
+		}
+		configurations = append(configurations, page...)
+		continuationToken = resp.Header.Get(azuredevops.HeaderKeyContinuationToken)
+		if continuationToken == "" {
+			break
+		}
+	}
+
+	var scopes []policyScope
+	for _, p := range configurations {
+		if !lo.FromPtr(p.IsEnabled) || lo.FromPtr(p.IsDeleted) {
+			continue
+		}
+		// the settings are a free form JSON object, so they are decoded by round-tripping them through JSON
+		data, err := json.Marshal(p.Settings)
+		if err != nil {
+			return nil, err
+		}
+		var settings struct {
+			Scope []policyScope `json:"scope"`
+		}
+		if err := json.Unmarshal(data, &settings); err != nil {
+			continue
+		}
+		for _, s := range settings.Scope {
+			if s.RefName == "" || (s.RepositoryID != "" && !strings.EqualFold(s.RepositoryID, repositoryID)) {
+				continue
+			}
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes, nil
+}
+
+// isProtected reports whether any of the policy scopes applies to the branch.
+func isProtected(scopes []policyScope, name string) bool {
+	ref := util.NormalizeBranchRef(name)
+	return lo.ContainsBy(scopes, func(s policyScope) bool {
+		if strings.EqualFold(s.MatchKind, "prefix") {
+			return strings.HasPrefix(ref, s.RefName)
+		}
+		return ref == s.RefName
+	})
+}
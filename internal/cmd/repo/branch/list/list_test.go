@@ -0,0 +1,20 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsProtected(t *testing.T) {
+	scopes := []policyScope{
+		{RefName: "refs/heads/main", MatchKind: "Exact"},
+		{RefName: "refs/heads/release/", MatchKind: "Prefix"},
+	}
+
+	assert.True(t, isProtected(scopes, "main"))
+	assert.True(t, isProtected(scopes, "release/1.0"))
+	assert.False(t, isProtected(scopes, "main-old"))
+	assert.False(t, isProtected(scopes, "feature/release"))
+	assert.False(t, isProtected(nil, "main"))
+}
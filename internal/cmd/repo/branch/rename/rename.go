@@ -0,0 +1,229 @@
+package rename
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/branch/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+type renameOptions struct {
+	oldName             string
+	newName             string
+	repository          string
+	scope               string
+	updateDefaultBranch bool
+	yes                 bool
+	dryRun              bool
+}
+
+func NewCmdRename(ctx util.CmdContext) *cobra.Command {
+	opts := &renameOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rename <old-name> <new-name> [<repository>] [organization/]project",
+		Short: "Rename a branch of a repository",
+		Long: heredoc.Doc(`
+			Rename a branch of a repository.
+
+			Azure DevOps has no native rename, so a new branch is created at the commit of the old
+			branch and the old branch is deleted afterwards. Branch policies and open pull requests of
+			the old branch are not moved to the new branch.
+
+			The default branch of a repository can only be renamed with --update-default-branch,
+			which makes the new branch the default branch.
+
+			If the repository is omitted, the Azure DevOps repository of the git remotes of the current
+			directory is used.
+		`),
+		Example: heredoc.Doc(`
+			# rename the branch feature/login to feature/sign-in
+			azdo repo branch rename feature/login feature/sign-in myrepo myproject
+
+			# rename the default branch master to main without confirmation
+			azdo repo branch rename master main myrepo myorg/myproject --update-default-branch --yes
+
+			# show the changes a rename would make
+			azdo repo branch rename feature/login feature/sign-in myrepo myproject --dry-run
+
+			# rename a branch of the repository of the current directory
+			azdo repo branch rename feature/login feature/sign-in myproject
+		`),
+		Args: util.RangeArgs(3, 4, "cannot rename branch: old name, new name and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.oldName = args[0]
+			opts.newName = args[1]
+			opts.scope = args[len(args)-1]
+			if len(args) == 4 {
+				opts.repository = args[2]
+			} else {
+				repository, err := util.RepositoryFromRemote(ctx)
+				if err != nil {
+					return err
+				}
+				opts.repository = repository
+			}
+
+			return runRename(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.updateDefaultBranch, "update-default-branch", false, "Make the new branch the default branch if the old branch is the default branch")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be done without renaming the branch")
+
+	return cmd
+}
+
+func runRename(ctx util.CmdContext, opts *renameOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &opts.repository,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+	}
+	repositoryID := lo.ToPtr(repo.Id.String())
+
+	oldRef := util.NormalizeBranchRef(opts.oldName)
+	newRef := util.NormalizeBranchRef(opts.newName)
+	oldName := util.ShortBranchName(oldRef)
+	newName := util.ShortBranchName(newRef)
+	if oldRef == newRef {
+		return util.FlagErrorf("old and new branch name are the same")
+	}
+
+	old, err := shared.FindRef(rctx, client, project, *repositoryID, oldRef)
+	if err != nil {
+		return
+	}
+	if old == nil {
+		return fmt.Errorf("branch %s does not exist in repository %s", oldName, *repo.Name)
+	}
+	existing, err := shared.FindRef(rctx, client, project, *repositoryID, newRef)
+	if err != nil {
+		return
+	}
+	if existing != nil {
+		return fmt.Errorf("branch %s already exists in repository %s", newName, *repo.Name)
+	}
+
+	isDefault := lo.FromPtr(repo.DefaultBranch) == oldRef
+	if isDefault && !opts.updateDefaultBranch {
+		return util.FlagErrorf("branch %s is the default branch of repository %s; use --update-default-branch to rename it", oldName, *repo.Name)
+	}
+
+	prs, err := client.GetPullRequests(rctx, git.GetPullRequestsArgs{
+		RepositoryId: repositoryID,
+		Project:      &project,
+		SearchCriteria: &git.GitPullRequestSearchCriteria{
+			Status:        &git.PullRequestStatusValues.Active,
+			TargetRefName: &oldRef,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get pull requests of repository %s: %w", *repo.Name, err)
+	}
+	if n := len(lo.FromPtr(prs)); n > 0 {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.ErrOut, "%s %s target branch %s and must be retargeted after the rename\n", cs.WarningIcon(), text.Pluralize(n, "active pull request"), oldName)
+	}
+
+	if !opts.yes && !opts.dryRun {
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--yes required when not running interactively")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		confirmed, err := p.Confirm(fmt.Sprintf("Rename branch %s of repository %s to %s?", oldName, *repo.Name, newName), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return util.ErrCancel
+		}
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("create branch %s at %s", newRef, *old.ObjectId), func() error {
+		return shared.UpdateRef(rctx, client, project, *repositoryID, git.GitRefUpdate{
+			Name:        &newRef,
+			OldObjectId: lo.ToPtr(shared.ZeroObjectID),
+			NewObjectId: old.ObjectId,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create branch %s: %w", newName, err)
+	}
+
+	if isDefault {
+		err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("change default branch of %s to %s", *repo.Name, newRef), func() error {
+			_, err := client.UpdateRepository(rctx, git.UpdateRepositoryArgs{
+				NewRepositoryInfo: &git.GitRepository{
+					DefaultBranch: &newRef,
+				},
+				RepositoryId: repo.Id,
+				Project:      &project,
+			})
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update default branch of repository %s: %w", *repo.Name, err)
+		}
+	}
+
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("delete branch %s", oldRef), func() error {
+		return shared.UpdateRef(rctx, client, project, *repositoryID, git.GitRefUpdate{
+			Name:        &oldRef,
+			OldObjectId: old.ObjectId,
+			NewObjectId: lo.ToPtr(shared.ZeroObjectID),
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("created branch %s but failed to delete branch %s: %w", newName, oldName, err)
+	}
+	iostrms.StopProgressIndicator()
+	if opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Renamed branch %s of %s to %s\n", cs.SuccessIcon(), cs.Bold(oldName), *repo.Name, cs.Bold(newName))
+		if isDefault {
+			fmt.Fprintf(iostrms.Out, "%s Changed default branch of %s to %s\n", cs.SuccessIcon(), *repo.Name, cs.Bold(newName))
+		}
+	}
+	return
+}
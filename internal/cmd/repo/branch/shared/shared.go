@@ -0,0 +1,47 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+)
+
+// ZeroObjectID is the object ID which creates a ref when used as old and deletes a ref when used as new object ID
+const ZeroObjectID = "0000000000000000000000000000000000000000"
+
+// FindRef returns the ref with the given name or nil if it does not exist.
+func FindRef(ctx context.Context, client git.Client, project, repositoryID, name string) (*git.GitRef, error) {
+	refs, err := client.GetRefs(ctx, git.GetRefsArgs{
+		RepositoryId: &repositoryID,
+		Project:      &project,
+		Filter:       lo.ToPtr(name[len("refs/"):]),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branches: %w", err)
+	}
+	ref, ok := lo.Find(refs.Value, func(r git.GitRef) bool { return lo.FromPtr(r.Name) == name })
+	if !ok {
+		return nil, nil
+	}
+	return &ref, nil
+}
+
+// UpdateRef applies a single ref update and fails if it was not successful.
+func UpdateRef(ctx context.Context, client git.Client, project, repositoryID string, update git.GitRefUpdate) error {
+	res, err := client.UpdateRefs(ctx, git.UpdateRefsArgs{
+		RefUpdates:   &[]git.GitRefUpdate{update},
+		RepositoryId: &repositoryID,
+		Project:      &project,
+	})
+	if err != nil {
+		return err
+	}
+	for _, r := range lo.FromPtr(res) {
+		if !lo.FromPtr(r.Success) {
+			return fmt.Errorf("%s", lo.FromPtr(r.UpdateStatus))
+		}
+	}
+	return nil
+}
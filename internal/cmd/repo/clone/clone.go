@@ -78,14 +78,9 @@ func runClone(ctx util.CmdContext, opts *cloneOptions) (err error) {
 	} else if opts.project == "" {
 		return fmt.Errorf("no project specified")
 	}
-	var organizationName string
-	if opts.organizationName != "" {
-		organizationName = opts.organizationName
-	} else {
-		organizationName, _ = cfg.Authentication().GetDefaultOrganization()
-	}
-	if organizationName == "" {
-		return util.FlagErrorf("no organization specified")
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
 	}
 
 	conn, err := ctx.Connection(organizationName)
@@ -0,0 +1,90 @@
+package cherrypick
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type cherryPickOptions struct {
+	shared.RefOperationOptions
+	scope string
+}
+
+func NewCmdCherryPick(ctx util.CmdContext) *cobra.Command {
+	opts := &cherryPickOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "cherry-pick <commit> [<repository>] [organization/]project",
+		Short: "Cherry-pick a commit onto a branch",
+		Long: heredoc.Doc(`
+			Cherry-pick a commit onto a branch of a repository and wait for the cherry-pick to finish.
+
+			Azure DevOps does not commit the cherry-pick onto the target branch itself, but creates a
+			new branch from the target branch which contains the cherry-picked commit. The name of the
+			new branch defaults to "cherry-pick-<commit>-onto-<target>" and can be set with --branch.
+			Merge the new branch into the target branch with a pull request.
+
+			If the cherry-pick fails because of a conflict, the files changed by the commit are listed
+			together with instructions to resolve the conflict locally.
+
+			If the repository is omitted, the Azure DevOps repository of the git remotes of the current
+			directory is used.
+		`),
+		Example: heredoc.Doc(`
+			# cherry-pick a commit onto the release branch
+			azdo repo commit cherry-pick 3f2a9c1d myrepo myproject --onto-branch release/1.2
+
+			# cherry-pick a commit into a branch with a custom name
+			azdo repo commit cherry-pick 3f2a9c1d myrepo myorg/myproject --onto-branch main --branch hotfix/login
+
+			# cherry-pick a commit of the repository of the current directory
+			azdo repo commit cherry-pick 3f2a9c1d myproject --onto-branch release/1.2
+		`),
+		Args: util.RangeArgs(2, 3, "cannot cherry-pick commit: commit and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.CommitID = args[0]
+			opts.scope = args[len(args)-1]
+			if len(args) == 3 {
+				opts.Repository = args[1]
+			} else {
+				repository, err := util.RepositoryFromRemote(ctx)
+				if err != nil {
+					return err
+				}
+				opts.Repository = repository
+			}
+
+			return runCherryPick(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.OntoBranch, "onto-branch", "", "Branch to cherry-pick the commit onto")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Name of the branch to create with the cherry-picked commit")
+	_ = cmd.MarkFlagRequired("onto-branch")
+
+	return cmd
+}
+
+func runCherryPick(ctx util.CmdContext, opts *cherryPickOptions) (err error) {
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	return shared.CherryPickOperation.Run(ctx, client, project, &opts.RefOperationOptions)
+}
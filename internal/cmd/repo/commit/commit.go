@@ -0,0 +1,23 @@
+package commit
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/cherrypick"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/revert"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/show"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdCommit(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit <command>",
+		Short: "List, inspect, cherry-pick and revert the commits of a repository",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(show.NewCmdShow(ctx))
+	cmd.AddCommand(cherrypick.NewCmdCherryPick(ctx))
+	cmd.AddCommand(revert.NewCmdRevert(ctx))
+	return cmd
+}
@@ -0,0 +1,202 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// dateLayout is the layout of the {date} template variable.
+const dateLayout = "2006-01-02 15:04:05 -0700"
+
+type listOptions struct {
+	repository string
+	scope      string
+	branch     string
+	author     string
+	limit      int
+	format     string
+}
+
+type commitResult struct {
+	CommitID string    `json:"commitId"`
+	Author   string    `json:"author"`
+	Email    string    `json:"email"`
+	Date     time.Time `json:"date"`
+	Subject  string    `json:"subject"`
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <repository> [organization/]project",
+		Short: "List the commits of a repository",
+		Long: heredoc.Doc(`
+			List the commits of a branch of a repository, newest first.
+
+			Besides table, tsv and json, --format accepts a template in which the variables {sha},
+			{shortSha}, {author}, {email}, {date} and {subject} are replaced by the values of each
+			commit, which is printed on its own line.
+		`),
+		Example: heredoc.Doc(`
+			# list the last commits of the default branch
+			azdo repo commit list myrepo myproject
+
+			# list the commits of a user on a branch
+			azdo repo commit list myrepo myorg/myproject --branch develop --author jdoe@example.com
+
+			# print a custom log line for each commit
+			azdo repo commit list myrepo myproject --format "{shortSha} {author}: {subject}"
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(2, "cannot list commits: repository and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.repository = args[0]
+			opts.scope = args[1]
+
+			if opts.limit < 1 {
+				return util.FlagErrorf("invalid limit: %d", opts.limit)
+			}
+			if !lo.Contains(util.OutputFormats, opts.format) && !strings.Contains(opts.format, "{") {
+				return util.FlagErrorf("invalid format %q; expected one of %s or a template like \"{shortSha} {subject}\"", opts.format, strings.Join(util.OutputFormats, ", "))
+			}
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "Branch to list the commits of (default: default branch)")
+	cmd.Flags().StringVar(&opts.author, "author", "", "Only list the commits of the author")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 30, "Maximum number of commits to list")
+	cmd.Flags().StringVar(&opts.format, "format", "table", "Output format: {json|table|tsv} or a template")
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	criteria := &git.GitQueryCommitsCriteria{
+		Top: &opts.limit,
+	}
+	if opts.branch != "" {
+		criteria.ItemVersion = &git.GitVersionDescriptor{
+			Version:     lo.ToPtr(util.ShortBranchName(opts.branch)),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		}
+	}
+	if opts.author != "" {
+		criteria.Author = &opts.author
+	}
+	commits, err := client.GetCommits(rctx, git.GetCommitsArgs{
+		RepositoryId:   &opts.repository,
+		SearchCriteria: criteria,
+		Project:        &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get commits of repository %s: %w", opts.repository, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	results := lo.Map(lo.FromPtr(commits), func(c git.GitCommitRef, _ int) commitResult { return newCommitResult(&c) })
+	if len(results) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No commits found in repository %s", opts.repository))
+	}
+
+	switch opts.format {
+	case "json":
+		return json.NewEncoder(iostrms.Out).Encode(results)
+	case "table", "tsv":
+	default:
+		return printTemplate(iostrms.Out, opts.format, results)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	tp.AddColumns("Commit", "Author", "Date", "Subject")
+	for _, c := range results {
+		tp.AddField(shared.ShortID(c.CommitID), printer.WithTruncate(nil))
+		tp.AddField(c.Author)
+		if iostrms.IsStdoutTTY() {
+			tp.AddField(text.FuzzyAgo(now, c.Date))
+		} else {
+			tp.AddField(c.Date.Format(time.RFC3339))
+		}
+		tp.AddField(c.Subject)
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+func newCommitResult(c *git.GitCommitRef) commitResult {
+	res := commitResult{
+		CommitID: lo.FromPtr(c.CommitId),
+		// the comments of listed commits may be truncated, but the first line is always included
+		Subject: strings.TrimSpace(strings.SplitN(lo.FromPtr(c.Comment), "\n", 2)[0]),
+	}
+	if c.Author != nil {
+		res.Author = lo.FromPtr(c.Author.Name)
+		res.Email = lo.FromPtr(c.Author.Email)
+		if c.Author.Date != nil {
+			res.Date = c.Author.Date.Time
+		}
+	}
+	return res
+}
+
+// printTemplate prints a line per commit in which the template variables are replaced by the values
+// of the commit. Unknown variables are printed as is.
+func printTemplate(w io.Writer, template string, commits []commitResult) error {
+	for _, c := range commits {
+		r := strings.NewReplacer(
+			"{sha}", c.CommitID,
+			"{shortSha}", shared.ShortID(c.CommitID),
+			"{author}", c.Author,
+			"{email}", c.Email,
+			"{date}", c.Date.Local().Format(dateLayout),
+			"{subject}", c.Subject,
+		)
+		if _, err := fmt.Fprintln(w, r.Replace(template)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package list
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommitResult(t *testing.T) {
+	date := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	c := &git.GitCommitRef{
+		CommitId: lo.ToPtr("3f2a9c1d4e5b6a7980c1d2e3f4a5b6c7d8e9f0a1"),
+		Comment:  lo.ToPtr("Fix the parser\n\nThe parser failed on empty input."),
+		Author: &git.GitUserDate{
+			Name:  lo.ToPtr("Jane Doe"),
+			Email: lo.ToPtr("jdoe@example.com"),
+			Date:  &azuredevops.Time{Time: date},
+		},
+	}
+	assert.Equal(t, commitResult{
+		CommitID: "3f2a9c1d4e5b6a7980c1d2e3f4a5b6c7d8e9f0a1",
+		Author:   "Jane Doe",
+		Email:    "jdoe@example.com",
+		Date:     date,
+		Subject:  "Fix the parser",
+	}, newCommitResult(c))
+	assert.Equal(t, commitResult{}, newCommitResult(&git.GitCommitRef{}))
+}
+
+func TestPrintTemplate(t *testing.T) {
+	commits := []commitResult{
+		{CommitID: "3f2a9c1d4e5b6a7980c1d2e3f4a5b6c7d8e9f0a1", Author: "Jane Doe", Email: "jdoe@example.com", Subject: "Fix the parser"},
+		{CommitID: "0b7d6f4e83f84e0aa3d25c0e6b2a9e11aa2b3c4d", Author: "John Roe", Email: "jroe@example.com", Subject: "Add tests"},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, printTemplate(&buf, "{shortSha} {author} <{email}>: {subject} {unknown}", commits))
+	assert.Equal(t, "3f2a9c1 Jane Doe <jdoe@example.com>: Fix the parser {unknown}\n0b7d6f4 John Roe <jroe@example.com>: Add tests {unknown}\n", buf.String())
+}
@@ -0,0 +1,91 @@
+package revert
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type revertOptions struct {
+	shared.RefOperationOptions
+	scope string
+}
+
+func NewCmdRevert(ctx util.CmdContext) *cobra.Command {
+	opts := &revertOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "revert <commit> [<repository>] [organization/]project",
+		Short: "Revert a commit on a branch",
+		Long: heredoc.Doc(`
+			Revert a commit on a branch of a repository without a local clone and wait for the revert
+			to finish.
+
+			Like a cherry-pick, Azure DevOps does not commit the revert onto the target branch itself,
+			but creates a new branch from the target branch which contains the revert commit. The name
+			of the new branch defaults to "revert-<commit>-on-<target>" and can be set with --branch.
+			Merge the new branch into the target branch with a pull request.
+
+			If the revert fails because of a conflict, the files changed by the commit are listed
+			together with instructions to resolve the conflict locally.
+
+			If the repository is omitted, the Azure DevOps repository of the git remotes of the current
+			directory is used.
+		`),
+		Example: heredoc.Doc(`
+			# revert a commit on main
+			azdo repo commit revert 3f2a9c1d myrepo myproject --onto-branch main
+
+			# revert a commit into a branch with a custom name
+			azdo repo commit revert 3f2a9c1d myrepo myorg/myproject --onto-branch main --branch revert/login
+
+			# revert a commit of the repository of the current directory
+			azdo repo commit revert 3f2a9c1d myproject --onto-branch main
+		`),
+		Args: util.RangeArgs(2, 3, "cannot revert commit: commit and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.CommitID = args[0]
+			opts.scope = args[len(args)-1]
+			if len(args) == 3 {
+				opts.Repository = args[1]
+			} else {
+				repository, err := util.RepositoryFromRemote(ctx)
+				if err != nil {
+					return err
+				}
+				opts.Repository = repository
+			}
+
+			return runRevert(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.OntoBranch, "onto-branch", "", "Branch to revert the commit on")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Name of the branch to create with the revert commit")
+	_ = cmd.MarkFlagRequired("onto-branch")
+
+	return cmd
+}
+
+func runRevert(ctx util.CmdContext, opts *revertOptions) (err error) {
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	return shared.RevertOperation.Run(ctx, client, project, &opts.RefOperationOptions)
+}
@@ -0,0 +1,115 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	branchshared "github.com/tmeckel/azdo-cli/internal/cmd/repo/branch/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// PollInterval is the interval in which the status of an operation is checked while waiting for it
+var PollInterval = 2 * time.Second
+
+// OperationTimeout is the maximum time to wait for an operation to complete
+var OperationTimeout = 5 * time.Minute
+
+// OperationStatus is the status of an asynchronous git operation like a cherry-pick, revert or merge.
+type OperationStatus struct {
+	Status git.GitAsyncOperationStatus
+	Detail git.GitAsyncRefOperationDetail
+}
+
+// NewOperationStatus returns the status of an operation from its status fields.
+func NewOperationStatus(status *git.GitAsyncOperationStatus, detail *git.GitAsyncRefOperationDetail) *OperationStatus {
+	return &OperationStatus{
+		Status: lo.FromPtr(status),
+		Detail: lo.FromPtr(detail),
+	}
+}
+
+// Succeeded reports whether the operation completed.
+func (s *OperationStatus) Succeeded() bool {
+	return s.Status == git.GitAsyncOperationStatusValues.Completed
+}
+
+// Conflict reports whether the operation failed because of a conflict.
+func (s *OperationStatus) Conflict() bool {
+	return lo.FromPtr(s.Detail.Conflict)
+}
+
+// Err returns the error of an operation which failed for another reason than a conflict. action
+// describes the operation, e.g. "cherry-pick commit 3f2a9c1".
+func (s *OperationStatus) Err(action string) error {
+	if msg := lo.FromPtr(s.Detail.FailureMessage); msg != "" {
+		return fmt.Errorf("failed to %s: %s", action, msg)
+	}
+	if lo.FromPtr(s.Detail.Timedout) {
+		return fmt.Errorf("failed to %s: timed out", action)
+	}
+	return fmt.Errorf("failed to %s: %s", action, s.Status)
+}
+
+// WaitForOperation calls getStatus until the operation is no longer queued or in progress, or
+// OperationTimeout has passed. operation names the operation in the timeout error, e.g. "cherry-pick 42".
+func WaitForOperation(ctx context.Context, operation string, getStatus func(ctx context.Context) (*OperationStatus, error)) (*OperationStatus, error) {
+	ctx, cancel := context.WithTimeout(ctx, OperationTimeout)
+	defer cancel()
+	ticker := time.NewTicker(PollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := getStatus(ctx)
+		if err != nil {
+			return nil, err
+		}
+		switch status.Status {
+		case git.GitAsyncOperationStatusValues.Queued, git.GitAsyncOperationStatusValues.InProgress:
+		default:
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("%s did not complete within %s", operation, OperationTimeout)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetResultCommit returns the ID of the commit created by a cherry-pick or revert, which is the head
+// of the generated branch.
+func GetResultCommit(ctx context.Context, client git.Client, project, repositoryID, generatedRef string) (string, error) {
+	ref, err := branchshared.FindRef(ctx, client, project, repositoryID, generatedRef)
+	if err != nil {
+		return "", err
+	}
+	if ref == nil {
+		return "", fmt.Errorf("branch %s not found", util.ShortBranchName(generatedRef))
+	}
+	return lo.FromPtr(ref.ObjectId), nil
+}
+
+// PrintConflictHelp prints the files which possibly conflict and the git commands to resolve the
+// conflict locally. The operations do not report the conflicting files, so the files changed by the
+// commit are printed.
+func PrintConflictHelp(w io.Writer, changes []FileChange, commands []string) {
+	if len(changes) > 0 {
+		fmt.Fprintln(w, "\nThe conflict is in one of the files changed by the commit:")
+		for _, c := range changes {
+			fmt.Fprintf(w, "  %s\n", c.Path)
+		}
+	}
+	fmt.Fprintln(w, "\nResolve the conflict locally:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %s\n", c)
+	}
+}
@@ -0,0 +1,53 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWaitForOperation(t *testing.T) {
+	PollInterval = time.Millisecond
+	defer func() { PollInterval = 2 * time.Second }()
+
+	statuses := []git.GitAsyncOperationStatus{
+		git.GitAsyncOperationStatusValues.Queued,
+		git.GitAsyncOperationStatusValues.InProgress,
+		git.GitAsyncOperationStatusValues.Failed,
+	}
+	calls := 0
+	status, err := WaitForOperation(context.Background(), "cherry-pick 42", func(context.Context) (*OperationStatus, error) {
+		s := statuses[calls]
+		calls++
+		return NewOperationStatus(&s, &git.GitAsyncRefOperationDetail{Conflict: lo.ToPtr(true)}), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.False(t, status.Succeeded())
+	assert.True(t, status.Conflict())
+}
+
+func TestWaitForOperationTimeout(t *testing.T) {
+	pollInterval, operationTimeout := PollInterval, OperationTimeout
+	t.Cleanup(func() { PollInterval, OperationTimeout = pollInterval, operationTimeout })
+	PollInterval = time.Millisecond
+	OperationTimeout = 10 * time.Millisecond
+
+	_, err := WaitForOperation(context.Background(), "merge operation 7", func(context.Context) (*OperationStatus, error) {
+		return NewOperationStatus(&git.GitAsyncOperationStatusValues.InProgress, nil), nil
+	})
+	assert.EqualError(t, err, "merge operation 7 did not complete within 10ms")
+}
+
+func TestOperationStatusErr(t *testing.T) {
+	status := NewOperationStatus(&git.GitAsyncOperationStatusValues.Failed, nil)
+	assert.EqualError(t, status.Err("revert commit 3f2a9c1"), "failed to revert commit 3f2a9c1: failed")
+
+	status.Detail.FailureMessage = lo.ToPtr("the target branch does not exist")
+	assert.EqualError(t, status.Err("revert commit 3f2a9c1"), "failed to revert commit 3f2a9c1: the target branch does not exist")
+}
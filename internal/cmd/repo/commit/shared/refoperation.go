@@ -0,0 +1,205 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// RefOperation is an asynchronous operation which applies a commit onto a branch by creating a new
+// branch from it, i.e. a cherry-pick or a revert.
+type RefOperation struct {
+	// Verb is the git command of the operation, e.g. "cherry-pick"
+	Verb string
+	// Preposition connects the commit and the target branch, e.g. "onto"
+	Preposition string
+	// Progressive and Past are the capitalized verb forms used in the output, e.g. "Cherry-picking"
+	// and "Cherry-picked"
+	Progressive string
+	Past        string
+	// Create starts the operation and returns its ID
+	Create func(ctx context.Context, client git.Client, project, repositoryID string, params *git.GitAsyncRefOperationParameters) (int, error)
+	// Get returns the status of the operation
+	Get func(ctx context.Context, client git.Client, project, repositoryID string, id int) (*OperationStatus, error)
+}
+
+// RefOperationOptions are the options of a cherry-pick or revert.
+type RefOperationOptions struct {
+	CommitID   string
+	Repository string
+	OntoBranch string
+	Branch     string
+}
+
+// GeneratedBranchName returns the default name of the branch created by the operation, e.g.
+// "cherry-pick-3f2a9c1-onto-main".
+func (op *RefOperation) GeneratedBranchName(commitID, ontoBranch string) string {
+	return fmt.Sprintf("%s-%s-%s-%s", op.Verb, ShortID(commitID), op.Preposition, ontoBranch)
+}
+
+// Run applies the commit onto the branch and waits for the operation to finish. If the operation
+// fails because of a conflict, the files changed by the commit and the git commands to resolve the
+// conflict locally are printed.
+func (op *RefOperation) Run(ctx util.CmdContext, client git.Client, project string, opts *RefOperationOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		RepositoryId: &opts.Repository,
+		Project:      &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository %s: %w", opts.Repository, err)
+	}
+	repositoryID := repo.Id.String()
+
+	// resolve abbreviated commit IDs, which are not accepted by the operations
+	commit, err := client.GetCommit(rctx, git.GetCommitArgs{
+		CommitId:     &opts.CommitID,
+		RepositoryId: &repositoryID,
+		Project:      &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get commit %s: %w", opts.CommitID, err)
+	}
+	commitID := lo.FromPtr(commit.CommitId)
+
+	ontoRef := util.NormalizeBranchRef(opts.OntoBranch)
+	generatedRef := util.NormalizeBranchRef(lo.Ternary(opts.Branch != "", opts.Branch,
+		op.GeneratedBranchName(commitID, util.ShortBranchName(ontoRef))))
+
+	operationID, err := op.Create(rctx, client, project, repositoryID, &git.GitAsyncRefOperationParameters{
+		GeneratedRefName: &generatedRef,
+		OntoRefName:      &ontoRef,
+		Repository:       repo,
+		Source: &git.GitAsyncRefOperationSource{
+			CommitList: &[]git.GitCommitRef{{CommitId: &commitID}},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to %s commit %s: %w", op.Verb, commitID, err)
+	}
+
+	status, err := WaitForOperation(rctx, fmt.Sprintf("%s %d", op.Verb, operationID), func(ctx context.Context) (*OperationStatus, error) {
+		status, err := op.Get(ctx, client, project, repositoryID, operationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %s %d: %w", op.Verb, operationID, err)
+		}
+		return status, nil
+	})
+	if err != nil {
+		return
+	}
+
+	cs := iostrms.ColorScheme()
+	ontoBranch := util.ShortBranchName(ontoRef)
+	generatedBranch := util.ShortBranchName(generatedRef)
+	if !status.Succeeded() {
+		if !status.Conflict() {
+			return status.Err(op.Verb + " commit " + ShortID(commitID))
+		}
+		changes, err := GetChanges(rctx, client, project, repositoryID, commitID, lo.FromPtr(commit.Parents))
+		if err != nil {
+			return err
+		}
+		iostrms.StopProgressIndicator()
+
+		fmt.Fprintf(iostrms.ErrOut, "%s %s %s %s %s failed because of a conflict\n", cs.FailureIcon(), op.Progressive,
+			ShortID(commitID), op.Preposition, ontoBranch)
+		PrintConflictHelp(iostrms.ErrOut, changes, []string{
+			"git fetch origin " + ontoBranch,
+			fmt.Sprintf("git checkout -b %s origin/%s", generatedBranch, ontoBranch),
+			fmt.Sprintf("git %s %s", op.Verb, commitID),
+			"# resolve the conflicts, then",
+			fmt.Sprintf("git %s --continue", op.Verb),
+			"git push origin " + generatedBranch,
+		})
+		return util.ErrSilent
+	}
+
+	newCommitID, err := GetResultCommit(rctx, client, project, repositoryID, generatedRef)
+	if err != nil {
+		return
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		fmt.Fprintf(iostrms.Out, "%s %s %s %s %s as %s in branch %s\n", cs.SuccessIcon(), op.Past, ShortID(commitID),
+			op.Preposition, ontoBranch, cs.Bold(newCommitID), cs.Bold(generatedBranch))
+		return
+	}
+	fmt.Fprintln(iostrms.Out, newCommitID)
+	return
+}
+
+// CherryPickOperation creates a cherry-pick and reads its status.
+var CherryPickOperation = &RefOperation{
+	Verb:        "cherry-pick",
+	Preposition: "onto",
+	Progressive: "Cherry-picking",
+	Past:        "Cherry-picked",
+	Create: func(ctx context.Context, client git.Client, project, repositoryID string, params *git.GitAsyncRefOperationParameters) (int, error) {
+		cherryPick, err := client.CreateCherryPick(ctx, git.CreateCherryPickArgs{
+			CherryPickToCreate: params,
+			Project:            &project,
+			RepositoryId:       &repositoryID,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return lo.FromPtr(cherryPick.CherryPickId), nil
+	},
+	Get: func(ctx context.Context, client git.Client, project, repositoryID string, id int) (*OperationStatus, error) {
+		cherryPick, err := client.GetCherryPick(ctx, git.GetCherryPickArgs{
+			Project:      &project,
+			CherryPickId: &id,
+			RepositoryId: &repositoryID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewOperationStatus(cherryPick.Status, cherryPick.DetailedStatus), nil
+	},
+}
+
+// RevertOperation creates a revert and reads its status.
+var RevertOperation = &RefOperation{
+	Verb:        "revert",
+	Preposition: "on",
+	Progressive: "Reverting",
+	Past:        "Reverted",
+	Create: func(ctx context.Context, client git.Client, project, repositoryID string, params *git.GitAsyncRefOperationParameters) (int, error) {
+		revert, err := client.CreateRevert(ctx, git.CreateRevertArgs{
+			RevertToCreate: params,
+			Project:        &project,
+			RepositoryId:   &repositoryID,
+		})
+		if err != nil {
+			return 0, err
+		}
+		return lo.FromPtr(revert.RevertId), nil
+	},
+	Get: func(ctx context.Context, client git.Client, project, repositoryID string, id int) (*OperationStatus, error) {
+		revert, err := client.GetRevert(ctx, git.GetRevertArgs{
+			Project:      &project,
+			RevertId:     &id,
+			RepositoryId: &repositoryID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return NewOperationStatus(revert.Status, revert.DetailedStatus), nil
+	},
+}
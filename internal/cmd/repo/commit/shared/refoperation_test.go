@@ -0,0 +1,207 @@
+package shared
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+)
+
+const (
+	commitID    = "3f2a9c1d4e5b6a7980c1d2e3f4a5b6c7d8e9f0a1"
+	newCommitID = "9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e0d"
+)
+
+type fakeCmdContext struct {
+	util.CmdContext
+	ios *iostreams.IOStreams
+}
+
+func (c *fakeCmdContext) IOStreams() (*iostreams.IOStreams, error) {
+	return c.ios, nil
+}
+
+func (c *fakeCmdContext) Context() (context.Context, error) {
+	return context.Background(), nil
+}
+
+type fakeClient struct {
+	git.Client
+	create git.GitAsyncRefOperationParameters
+	id     int
+}
+
+func (c *fakeClient) GetRepository(_ context.Context, args git.GetRepositoryArgs) (*git.GitRepository, error) {
+	return &git.GitRepository{Id: lo.ToPtr(uuid.New()), Name: args.RepositoryId}, nil
+}
+
+func (c *fakeClient) GetCommit(_ context.Context, _ git.GetCommitArgs) (*git.GitCommit, error) {
+	return &git.GitCommit{CommitId: lo.ToPtr(commitID), Parents: &[]string{"parent"}}, nil
+}
+
+func (c *fakeClient) GetCommitDiffs(_ context.Context, _ git.GetCommitDiffsArgs) (*git.GitCommitDiffs, error) {
+	return &git.GitCommitDiffs{Changes: &[]interface{}{
+		map[string]any{"changeType": "edit", "item": map[string]any{"path": "/src/login.go"}},
+	}}, nil
+}
+
+func (c *fakeClient) GetRefs(_ context.Context, _ git.GetRefsArgs) (*git.GetRefsResponseValue, error) {
+	return &git.GetRefsResponseValue{Value: []git.GitRef{{
+		Name:     lo.ToPtr("refs/heads/cherry-pick-3f2a9c1-onto-release/1.2"),
+		ObjectId: lo.ToPtr(newCommitID),
+	}}}, nil
+}
+
+// fakeOperation returns a cherry-pick which records the parameters it is created with and returns
+// the statuses in order, repeating the last one.
+func fakeOperation(statuses []git.GitAsyncOperationStatus, detail git.GitAsyncRefOperationDetail, params **git.GitAsyncRefOperationParameters) *RefOperation {
+	polls := 0
+	return &RefOperation{
+		Verb:        "cherry-pick",
+		Preposition: "onto",
+		Progressive: "Cherry-picking",
+		Past:        "Cherry-picked",
+		Create: func(_ context.Context, _ git.Client, _, _ string, p *git.GitAsyncRefOperationParameters) (int, error) {
+			*params = p
+			return 5, nil
+		},
+		Get: func(_ context.Context, _ git.Client, _, _ string, _ int) (*OperationStatus, error) {
+			status := statuses[len(statuses)-1]
+			if polls < len(statuses) {
+				status = statuses[polls]
+			}
+			polls++
+			return NewOperationStatus(&status, &detail), nil
+		},
+	}
+}
+
+func TestRefOperationRun(t *testing.T) {
+	pollInterval, operationTimeout := PollInterval, OperationTimeout
+	t.Cleanup(func() { PollInterval, OperationTimeout = pollInterval, operationTimeout })
+	PollInterval = time.Millisecond
+
+	tests := []struct {
+		name       string
+		statuses   []git.GitAsyncOperationStatus
+		detail     git.GitAsyncRefOperationDetail
+		timeout    time.Duration
+		wantOut    string
+		wantErrOut []string
+		wantErr    string
+	}{
+		{
+			name:     "succeeded",
+			statuses: []git.GitAsyncOperationStatus{git.GitAsyncOperationStatusValues.InProgress, git.GitAsyncOperationStatusValues.Completed},
+			wantOut:  newCommitID + "\n",
+		},
+		{
+			name:     "conflict",
+			statuses: []git.GitAsyncOperationStatus{git.GitAsyncOperationStatusValues.Failed},
+			detail:   git.GitAsyncRefOperationDetail{Conflict: lo.ToPtr(true)},
+			wantErrOut: []string{
+				"Cherry-picking 3f2a9c1 onto release/1.2 failed because of a conflict",
+				"  /src/login.go",
+				"  git cherry-pick " + commitID,
+				"  git cherry-pick --continue",
+			},
+			wantErr: util.ErrSilent.Error(),
+		},
+		{
+			name:     "failed",
+			statuses: []git.GitAsyncOperationStatus{git.GitAsyncOperationStatusValues.Failed},
+			detail:   git.GitAsyncRefOperationDetail{FailureMessage: lo.ToPtr("the target branch does not exist")},
+			wantErr:  "failed to cherry-pick commit 3f2a9c1: the target branch does not exist",
+		},
+		{
+			name:     "timed out",
+			statuses: []git.GitAsyncOperationStatus{git.GitAsyncOperationStatusValues.InProgress},
+			timeout:  10 * time.Millisecond,
+			wantErr:  "cherry-pick 5 did not complete within 10ms",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			OperationTimeout = lo.Ternary(tt.timeout != 0, tt.timeout, operationTimeout)
+
+			ios, _, stdout, stderr := iostreams.Test()
+			var params *git.GitAsyncRefOperationParameters
+			err := fakeOperation(tt.statuses, tt.detail, &params).Run(&fakeCmdContext{ios: ios}, &fakeClient{}, "myproject", &RefOperationOptions{
+				CommitID:   "3f2a9c1",
+				Repository: "myrepo",
+				OntoBranch: "release/1.2",
+			})
+
+			require.NotNil(t, params)
+			assert.Equal(t, "refs/heads/release/1.2", *params.OntoRefName)
+			assert.Equal(t, "refs/heads/cherry-pick-3f2a9c1-onto-release/1.2", *params.GeneratedRefName)
+			assert.Equal(t, commitID, *(*params.Source.CommitList)[0].CommitId)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOut, stdout.String())
+			for _, s := range tt.wantErrOut {
+				assert.Contains(t, stderr.String(), s)
+			}
+		})
+	}
+}
+
+func (c *fakeClient) CreateCherryPick(_ context.Context, args git.CreateCherryPickArgs) (*git.GitCherryPick, error) {
+	c.create = *args.CherryPickToCreate
+	return &git.GitCherryPick{CherryPickId: lo.ToPtr(5)}, nil
+}
+
+func (c *fakeClient) GetCherryPick(_ context.Context, args git.GetCherryPickArgs) (*git.GitCherryPick, error) {
+	c.id = *args.CherryPickId
+	return &git.GitCherryPick{Status: &git.GitAsyncOperationStatusValues.Failed, DetailedStatus: &git.GitAsyncRefOperationDetail{Conflict: lo.ToPtr(true)}}, nil
+}
+
+func (c *fakeClient) CreateRevert(_ context.Context, args git.CreateRevertArgs) (*git.GitRevert, error) {
+	c.create = *args.RevertToCreate
+	return &git.GitRevert{RevertId: lo.ToPtr(5)}, nil
+}
+
+func (c *fakeClient) GetRevert(_ context.Context, args git.GetRevertArgs) (*git.GitRevert, error) {
+	c.id = *args.RevertId
+	return &git.GitRevert{Status: &git.GitAsyncOperationStatusValues.Failed, DetailedStatus: &git.GitAsyncRefOperationDetail{Conflict: lo.ToPtr(true)}}, nil
+}
+
+func TestOperations(t *testing.T) {
+	tests := []struct {
+		op         *RefOperation
+		wantBranch string
+	}{
+		{op: CherryPickOperation, wantBranch: "cherry-pick-3f2a9c1-onto-main"},
+		{op: RevertOperation, wantBranch: "revert-3f2a9c1-on-main"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.op.Verb, func(t *testing.T) {
+			client := &fakeClient{}
+			params := &git.GitAsyncRefOperationParameters{OntoRefName: lo.ToPtr("refs/heads/main")}
+
+			id, err := tt.op.Create(context.Background(), client, "myproject", "repo-id", params)
+			require.NoError(t, err)
+			assert.Equal(t, 5, id)
+			assert.Equal(t, *params, client.create)
+
+			status, err := tt.op.Get(context.Background(), client, "myproject", "repo-id", id)
+			require.NoError(t, err)
+			assert.Equal(t, 5, client.id)
+			assert.True(t, status.Conflict())
+
+			assert.Equal(t, tt.wantBranch, tt.op.GeneratedBranchName(commitID, "main"))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+)
+
+// pageSize is the number of changes fetched with a single request
+const pageSize = 100
+
+// FileChange is a file changed by a commit.
+type FileChange struct {
+	Path       string `json:"path"`
+	ChangeType string `json:"changeType"`
+}
+
+// GetChanges returns the files changed by a commit compared to its first parent. All files of a
+// commit without parent are added by the commit.
+func GetChanges(ctx context.Context, client git.Client, project, repository, commitID string, parents []string) ([]FileChange, error) {
+	var changes []FileChange
+	for skip := 0; ; skip += pageSize {
+		var page []interface{}
+		if len(parents) == 0 {
+			res, err := client.GetChanges(ctx, git.GetChangesArgs{
+				CommitId:     &commitID,
+				RepositoryId: &repository,
+				Project:      &project,
+				Top:          lo.ToPtr(pageSize),
+				Skip:         &skip,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get changes of commit %s: %w", commitID, err)
+			}
+			page = lo.FromPtr(res.Changes)
+		} else {
+			res, err := client.GetCommitDiffs(ctx, git.GetCommitDiffsArgs{
+				RepositoryId:     &repository,
+				Project:          &project,
+				DiffCommonCommit: lo.ToPtr(false),
+				Top:              lo.ToPtr(pageSize),
+				Skip:             &skip,
+				BaseVersionDescriptor: &git.GitBaseVersionDescriptor{
+					BaseVersion:     &parents[0],
+					BaseVersionType: &git.GitVersionTypeValues.Commit,
+				},
+				TargetVersionDescriptor: &git.GitTargetVersionDescriptor{
+					TargetVersion:     &commitID,
+					TargetVersionType: &git.GitVersionTypeValues.Commit,
+				},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get changes of commit %s: %w", commitID, err)
+			}
+			page = lo.FromPtr(res.Changes)
+		}
+		parsed, err := parseChanges(page)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, parsed...)
+		if len(page) < pageSize {
+			break
+		}
+	}
+	return changes, nil
+}
+
+// parseChanges converts the untyped changes returned by the API into the changed files. Changes of
+// folders are skipped.
+func parseChanges(raw []interface{}) ([]FileChange, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var changes []struct {
+		ChangeType string `json:"changeType"`
+		Item       struct {
+			Path     string `json:"path"`
+			IsFolder bool   `json:"isFolder"`
+		} `json:"item"`
+	}
+	if err := json.Unmarshal(b, &changes); err != nil {
+		return nil, fmt.Errorf("failed to parse changes: %w", err)
+	}
+	result := make([]FileChange, 0, len(changes))
+	for _, c := range changes {
+		if c.Item.IsFolder {
+			continue
+		}
+		result = append(result, FileChange{
+			Path:       c.Item.Path,
+			ChangeType: c.ChangeType,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Path < result[j].Path })
+	return result, nil
+}
+
+// ShortID returns the abbreviated commit ID.
+func ShortID(commitID string) string {
+	if len(commitID) > 7 {
+		return commitID[:7]
+	}
+	return commitID
+}
@@ -0,0 +1,32 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChanges(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"changeType": "edit",
+			"item":       map[string]interface{}{"path": "/src/main.go"},
+		},
+		map[string]interface{}{
+			"changeType": "edit",
+			"item":       map[string]interface{}{"path": "/src", "isFolder": true},
+		},
+		map[string]interface{}{
+			"changeType": "add",
+			"item":       map[string]interface{}{"path": "/README.md"},
+		},
+	}
+
+	changes, err := parseChanges(raw)
+	require.NoError(t, err)
+	assert.Equal(t, []FileChange{
+		{Path: "/README.md", ChangeType: "add"},
+		{Path: "/src/main.go", ChangeType: "edit"},
+	}, changes)
+}
@@ -0,0 +1,219 @@
+package show
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// dateLayout is the layout of dates, which matches the default of git log.
+const dateLayout = "Mon Jan 2 15:04:05 2006 -0700"
+
+type showOptions struct {
+	commitID   string
+	repository string
+	scope      string
+	stat       bool
+	format     string
+}
+
+type userDate struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Date  string `json:"date,omitempty"`
+}
+
+type commitResult struct {
+	CommitID     string              `json:"commitId"`
+	Author       userDate            `json:"author"`
+	Committer    userDate            `json:"committer"`
+	Comment      string              `json:"comment"`
+	Parents      []string            `json:"parents"`
+	ChangeCounts map[string]int      `json:"changeCounts"`
+	Changes      []shared.FileChange `json:"changes,omitempty"`
+}
+
+func NewCmdShow(ctx util.CmdContext) *cobra.Command {
+	opts := &showOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "show <commit> [<repository>] [organization/]project",
+		Short: "Show a commit of a repository",
+		Long: heredoc.Doc(`
+			Show the author, committer, date and message of a commit.
+
+			The short format prints the author and the first line of the message, the full format
+			additionally prints the committer and the complete message. With --stat the files changed
+			by the commit are listed.
+
+			If the repository is omitted, the Azure DevOps repository of the git remotes of the current
+			directory is used.
+		`),
+		Example: heredoc.Doc(`
+			# show a commit
+			azdo repo commit show 3f2a9c1d4e5b6a7980c1d2e3f4a5b6c7d8e9f0a1 myrepo myproject
+
+			# list the files changed by a commit
+			azdo repo commit show 3f2a9c1d4e5b6a7980c1d2e3f4a5b6c7d8e9f0a1 myrepo myorg/myproject --stat
+
+			# print the commit as JSON
+			azdo repo commit show 3f2a9c1d4e5b6a7980c1d2e3f4a5b6c7d8e9f0a1 myrepo myproject --format json
+
+			# show a commit of the repository of the current directory
+			azdo repo commit show 3f2a9c1d myproject
+		`),
+		Args: util.RangeArgs(2, 3, "cannot show commit: commit and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.commitID = args[0]
+			opts.scope = args[len(args)-1]
+			if len(args) == 3 {
+				opts.repository = args[1]
+			} else {
+				repository, err := util.RepositoryFromRemote(ctx)
+				if err != nil {
+					return err
+				}
+				opts.repository = repository
+			}
+
+			return runShow(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.stat, "stat", false, "List the files changed by the commit")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "full", []string{"short", "full", "json"}, "Output format")
+
+	return cmd
+}
+
+func runShow(ctx util.CmdContext, opts *showOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	commit, err := client.GetCommit(rctx, git.GetCommitArgs{
+		CommitId:     &opts.commitID,
+		RepositoryId: &opts.repository,
+		Project:      &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get commit %s: %w", opts.commitID, err)
+	}
+	res := newCommitResult(commit)
+
+	// The change counts of a commit are not returned by the API client, so they are computed from
+	// the changes of the commit.
+	if opts.stat || opts.format == "json" {
+		changes, err := shared.GetChanges(rctx, client, project, opts.repository, res.CommitID, res.Parents)
+		if err != nil {
+			return err
+		}
+		res.ChangeCounts = countChanges(changes)
+		if opts.stat {
+			res.Changes = changes
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(res)
+	}
+
+	cs := iostrms.ColorScheme()
+	out := iostrms.Out
+	fmt.Fprintln(out, cs.Bold("commit "+res.CommitID))
+	if len(res.Parents) > 1 {
+		fmt.Fprintf(out, "Merge: %s\n", strings.Join(lo.Map(res.Parents, func(p string, _ int) string { return shared.ShortID(p) }), " "))
+	}
+	fmt.Fprintf(out, "Author: %s <%s>\n", res.Author.Name, res.Author.Email)
+	comment := res.Comment
+	if opts.format == "short" {
+		comment, _, _ = strings.Cut(comment, "\n")
+	} else {
+		fmt.Fprintf(out, "AuthorDate: %s\n", res.Author.Date)
+		fmt.Fprintf(out, "Commit: %s <%s>\n", res.Committer.Name, res.Committer.Email)
+		fmt.Fprintf(out, "CommitDate: %s\n", res.Committer.Date)
+	}
+	fmt.Fprintln(out)
+	for _, line := range strings.Split(strings.TrimRight(comment, "\n"), "\n") {
+		fmt.Fprintf(out, "    %s\n", line)
+	}
+
+	if !opts.stat {
+		return nil
+	}
+	fmt.Fprintln(out)
+	width := lo.Max(lo.Map(res.Changes, func(c shared.FileChange, _ int) int { return len(c.ChangeType) }))
+	for _, c := range res.Changes {
+		fmt.Fprintf(out, " %-*s  %s\n", width, c.ChangeType, c.Path)
+	}
+	fmt.Fprintf(out, " %s changed\n", text.Pluralize(len(res.Changes), "file"))
+	return nil
+}
+
+func newCommitResult(commit *git.GitCommit) *commitResult {
+	return &commitResult{
+		CommitID:  lo.FromPtr(commit.CommitId),
+		Author:    newUserDate(commit.Author),
+		Committer: newUserDate(commit.Committer),
+		Comment:   lo.FromPtr(commit.Comment),
+		Parents:   lo.FromPtr(commit.Parents),
+	}
+}
+
+func newUserDate(u *git.GitUserDate) userDate {
+	if u == nil {
+		return userDate{}
+	}
+	res := userDate{
+		Name:  lo.FromPtr(u.Name),
+		Email: lo.FromPtr(u.Email),
+	}
+	if u.Date != nil {
+		res.Date = u.Date.Time.Format(dateLayout)
+	}
+	return res
+}
+
+// countChanges returns the number of changed files per kind of change. A change with multiple kinds,
+// like "edit, rename", is counted for each kind.
+func countChanges(changes []shared.FileChange) map[string]int {
+	counts := map[string]int{}
+	for _, c := range changes {
+		for _, kind := range strings.Split(c.ChangeType, ",") {
+			if kind = strings.TrimSpace(kind); kind != "" {
+				counts[kind]++
+			}
+		}
+	}
+	return counts
+}
@@ -0,0 +1,18 @@
+package show
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/shared"
+)
+
+func TestCountChanges(t *testing.T) {
+	counts := countChanges([]shared.FileChange{
+		{Path: "/a", ChangeType: "add"},
+		{Path: "/b", ChangeType: "edit"},
+		{Path: "/c", ChangeType: "edit, rename"},
+		{Path: "/d", ChangeType: "delete"},
+	})
+	assert.Equal(t, map[string]int{"add": 1, "edit": 2, "rename": 1, "delete": 1}, counts)
+}
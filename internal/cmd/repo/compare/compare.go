@@ -0,0 +1,269 @@
+package compare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// pageSize is the number of changes fetched with a single request
+const pageSize = 100
+
+var commitIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+type compareOptions struct {
+	base       string
+	head       string
+	repository string
+	scope      string
+	stat       bool
+	format     string
+}
+
+// fileChange is a change of a file between the base and the head version.
+type fileChange struct {
+	Path             string `json:"path"`
+	ChangeType       string `json:"changeType"`
+	ObjectID         string `json:"-"`
+	OriginalObjectID string `json:"-"`
+	IsFolder         bool   `json:"-"`
+}
+
+type compareResult struct {
+	AheadCount  int          `json:"aheadCount"`
+	BehindCount int          `json:"behindCount"`
+	Changes     []fileChange `json:"changes"`
+}
+
+func NewCmdRepoCompare(ctx util.CmdContext) *cobra.Command {
+	opts := &compareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "compare [<base> <head>] [<repository>] [organization/]project",
+		Short: "Compare two branches or commits of a repository",
+		Long: heredoc.Doc(`
+			Compare two versions of a repository and print the changes as unified diff.
+
+			A version is either the name of a branch or the ID of a commit. The versions can be passed
+			as positional arguments or with the --base and --head flags. If the repository is omitted,
+			the Azure DevOps repository of the git remotes of the current directory is used.
+		`),
+		Example: heredoc.Doc(`
+			# show the changes of branch feature compared to main
+			azdo repo compare main feature myrepo myproject
+
+			# show a summary of the changed files
+			azdo repo compare myrepo myorg/myproject --base main --head feature --stat
+
+			# compare two branches of the repository of the current directory
+			azdo repo compare main feature myproject
+
+			# print the number of commits ahead and behind and the changed files as JSON
+			azdo repo compare main feature myrepo myproject --format json
+		`),
+		Args: util.RangeArgs(1, 4, "cannot compare: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// without --base and --head the versions are the first two arguments
+			if opts.base == "" && opts.head == "" {
+				if len(args) < 3 {
+					return util.FlagErrorf("cannot compare: base and head version required")
+				}
+				opts.base, opts.head = args[0], args[1]
+				args = args[2:]
+			} else if opts.base == "" || opts.head == "" {
+				return util.FlagErrorf("cannot compare: base and head version required")
+			}
+			if len(args) > 2 {
+				return util.FlagErrorf("specify the versions either as arguments or with --base and --head")
+			}
+			opts.scope = args[len(args)-1]
+			if len(args) == 2 {
+				opts.repository = args[0]
+			} else {
+				repository, err := util.RepositoryFromRemote(ctx)
+				if err != nil {
+					return err
+				}
+				opts.repository = repository
+			}
+
+			return runCompare(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.base, "base", "", "Base branch or commit")
+	cmd.Flags().StringVar(&opts.head, "head", "", "Head branch or commit")
+	cmd.Flags().BoolVar(&opts.stat, "stat", false, "Show a summary of the changed files instead of the diff")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runCompare(ctx util.CmdContext, opts *compareOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	res, err := getCommitDiffs(rctx, client, project, opts)
+	if err != nil {
+		return
+	}
+
+	if opts.format == "json" {
+		iostrms.StopProgressIndicator()
+		return json.NewEncoder(iostrms.Out).Encode(res)
+	}
+
+	files := lo.Filter(res.Changes, func(c fileChange, _ int) bool { return !c.IsFolder })
+	diffs := make([]fileDiff, 0, len(files))
+	for _, c := range files {
+		d, err := diffFile(rctx, client, project, opts.repository, c)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, d)
+	}
+	iostrms.StopProgressIndicator()
+
+	if len(diffs) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No changes between %s and %s", opts.base, opts.head))
+	}
+
+	if opts.stat {
+		tp, err := ctx.Printer("table")
+		if err != nil {
+			return err
+		}
+		return printStat(tp, iostrms.Out, diffs)
+	}
+	cs := iostrms.ColorScheme()
+	for _, d := range diffs {
+		printDiff(iostrms.Out, cs, d)
+	}
+	return
+}
+
+func getCommitDiffs(ctx context.Context, client git.Client, project string, opts *compareOptions) (*compareResult, error) {
+	res := &compareResult{}
+	for skip := 0; ; skip += pageSize {
+		page, err := client.GetCommitDiffs(ctx, git.GetCommitDiffsArgs{
+			RepositoryId:     &opts.repository,
+			Project:          &project,
+			DiffCommonCommit: lo.ToPtr(true),
+			Top:              lo.ToPtr(pageSize),
+			Skip:             &skip,
+			BaseVersionDescriptor: &git.GitBaseVersionDescriptor{
+				BaseVersion:     &opts.base,
+				BaseVersionType: versionType(opts.base),
+			},
+			TargetVersionDescriptor: &git.GitTargetVersionDescriptor{
+				TargetVersion:     &opts.head,
+				TargetVersionType: versionType(opts.head),
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to compare %s and %s: %w", opts.base, opts.head, err)
+		}
+		res.AheadCount = lo.FromPtr(page.AheadCount)
+		res.BehindCount = lo.FromPtr(page.BehindCount)
+		if page.Changes == nil || len(*page.Changes) == 0 {
+			break
+		}
+		changes, err := parseChanges(*page.Changes)
+		if err != nil {
+			return nil, err
+		}
+		res.Changes = append(res.Changes, changes...)
+		if len(*page.Changes) < pageSize {
+			break
+		}
+	}
+	return res, nil
+}
+
+// parseChanges converts the untyped changes returned by the API into file changes.
+func parseChanges(raw []interface{}) ([]fileChange, error) {
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var changes []git.GitChange
+	if err := json.Unmarshal(b, &changes); err != nil {
+		return nil, fmt.Errorf("failed to parse changes: %w", err)
+	}
+	result := make([]fileChange, 0, len(changes))
+	for _, c := range changes {
+		b, err := json.Marshal(c.Item)
+		if err != nil {
+			return nil, err
+		}
+		var item git.GitItem
+		if err := json.Unmarshal(b, &item); err != nil {
+			return nil, fmt.Errorf("failed to parse changed item: %w", err)
+		}
+		result = append(result, fileChange{
+			Path:             lo.FromPtr(item.Path),
+			ChangeType:       string(lo.FromPtr(c.ChangeType)),
+			ObjectID:         lo.FromPtr(item.ObjectId),
+			OriginalObjectID: lo.FromPtr(item.OriginalObjectId),
+			IsFolder:         lo.FromPtr(item.IsFolder),
+		})
+	}
+	return result, nil
+}
+
+func versionType(version string) *git.GitVersionType {
+	if commitIDPattern.MatchString(version) {
+		return &git.GitVersionTypeValues.Commit
+	}
+	return &git.GitVersionTypeValues.Branch
+}
+
+func readBlob(ctx context.Context, client git.Client, project, repository, objectID string) (string, error) {
+	if objectID == "" {
+		return "", nil
+	}
+	r, err := client.GetBlobContent(ctx, git.GetBlobContentArgs{
+		RepositoryId: &repository,
+		Sha1:         &objectID,
+		Project:      &project,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get blob %s: %w", objectID, err)
+	}
+	defer r.Close()
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
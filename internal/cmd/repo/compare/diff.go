@@ -0,0 +1,130 @@
+package compare
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// fileDiff is the unified diff of a single file.
+type fileDiff struct {
+	change    fileChange
+	binary    bool
+	diff      string
+	additions int
+	deletions int
+}
+
+func diffFile(ctx context.Context, client git.Client, project, repository string, c fileChange) (d fileDiff, err error) {
+	d.change = c
+
+	oldID, newID := c.OriginalObjectID, c.ObjectID
+	switch {
+	case strings.Contains(c.ChangeType, "add"):
+		oldID = ""
+	case strings.Contains(c.ChangeType, "delete"):
+		if oldID == "" {
+			oldID = newID
+		}
+		newID = ""
+	}
+
+	oldContent, err := readBlob(ctx, client, project, repository, oldID)
+	if err != nil {
+		return
+	}
+	newContent, err := readBlob(ctx, client, project, repository, newID)
+	if err != nil {
+		return
+	}
+	if isBinary(oldContent) || isBinary(newContent) {
+		d.binary = true
+		return
+	}
+
+	fromFile, toFile := "a"+c.Path, "b"+c.Path
+	if oldID == "" {
+		fromFile = "/dev/null"
+	}
+	if newID == "" {
+		toFile = "/dev/null"
+	}
+	ud := difflib.UnifiedDiff{
+		A:        difflib.SplitLines(oldContent),
+		B:        difflib.SplitLines(newContent),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	}
+	d.diff, err = difflib.GetUnifiedDiffString(ud)
+	if err != nil {
+		return
+	}
+	for _, line := range strings.Split(d.diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		case strings.HasPrefix(line, "+"):
+			d.additions++
+		case strings.HasPrefix(line, "-"):
+			d.deletions++
+		}
+	}
+	return
+}
+
+func isBinary(content string) bool {
+	return strings.ContainsRune(content, 0)
+}
+
+func printDiff(out io.Writer, cs *iostreams.ColorScheme, d fileDiff) {
+	path := strings.TrimPrefix(d.change.Path, "/")
+	fmt.Fprintln(out, cs.Bold(fmt.Sprintf("diff --git a/%s b/%s", path, path)))
+	if d.binary {
+		fmt.Fprintf(out, "Binary files a/%s and b/%s differ\n", path, path)
+		return
+	}
+	for _, line := range strings.SplitAfter(d.diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+			fmt.Fprint(out, cs.Bold(line))
+		case strings.HasPrefix(line, "+"):
+			fmt.Fprint(out, cs.Green(line))
+		case strings.HasPrefix(line, "-"):
+			fmt.Fprint(out, cs.Red(line))
+		case strings.HasPrefix(line, "@@"):
+			fmt.Fprint(out, cs.Cyan(line))
+		default:
+			fmt.Fprint(out, line)
+		}
+	}
+}
+
+func printStat(tp printer.Printer, out io.Writer, diffs []fileDiff) error {
+	var additions, deletions int
+	tp.AddColumns("Path", "Change", "Additions", "Deletions")
+	for _, d := range diffs {
+		tp.AddField(strings.TrimPrefix(d.change.Path, "/"))
+		tp.AddField(d.change.ChangeType)
+		if d.binary {
+			tp.AddField("-")
+			tp.AddField("-")
+		} else {
+			tp.AddField(fmt.Sprintf("+%d", d.additions))
+			tp.AddField(fmt.Sprintf("-%d", d.deletions))
+		}
+		tp.EndRow()
+		additions += d.additions
+		deletions += d.deletions
+	}
+	if err := tp.Render(); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%d files changed, %d insertions(+), %d deletions(-)\n", len(diffs), additions, deletions)
+	return nil
+}
@@ -0,0 +1,125 @@
+package defaultbranch
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type defaultBranchOptions struct {
+	repository string
+	scope      string
+	branch     string
+}
+
+func NewCmdRepoDefaultBranch(ctx util.CmdContext) *cobra.Command {
+	opts := &defaultBranchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "default-branch <repository> [organization/]project",
+		Short: "Show or change the default branch of a repository",
+		Example: heredoc.Doc(`
+			# show the default branch of a repository
+			azdo repo default-branch myrepo myproject
+
+			# change the default branch of a repository
+			azdo repo default-branch myrepo myorg/myproject --set develop
+		`),
+		Args: util.ExactArgs(2, "cannot get default branch: repository and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.repository = args[0]
+			opts.scope = args[1]
+
+			return runDefaultBranch(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.branch, "set", "", "Set the default branch of the repository")
+
+	return cmd
+}
+
+func runDefaultBranch(ctx util.CmdContext, opts *defaultBranchOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &opts.repository,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+	}
+	oldBranch := util.ShortBranchName(lo.FromPtr(repo.DefaultBranch))
+
+	if opts.branch == "" {
+		if oldBranch == "" {
+			return fmt.Errorf("repository %s has no default branch", *repo.Name)
+		}
+		fmt.Fprintln(iostrms.Out, oldBranch)
+		return
+	}
+
+	ref := util.NormalizeBranchRef(opts.branch)
+	newBranch := util.ShortBranchName(ref)
+	if newBranch == oldBranch {
+		if iostrms.IsStdoutTTY() {
+			fmt.Fprintf(iostrms.Out, "Default branch of %s is already %s\n", *repo.Name, newBranch)
+		}
+		return
+	}
+
+	refs, err := client.GetRefs(rctx, git.GetRefsArgs{
+		RepositoryId: lo.ToPtr(repo.Id.String()),
+		Project:      &project,
+		Filter:       lo.ToPtr(ref[len("refs/"):]),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get branches of repository %s: %w", *repo.Name, err)
+	}
+	if !lo.ContainsBy(refs.Value, func(r git.GitRef) bool { return lo.FromPtr(r.Name) == ref }) {
+		return fmt.Errorf("branch %s does not exist in repository %s", newBranch, *repo.Name)
+	}
+
+	_, err = client.UpdateRepository(rctx, git.UpdateRepositoryArgs{
+		NewRepositoryInfo: &git.GitRepository{
+			DefaultBranch: &ref,
+		},
+		RepositoryId: repo.Id,
+		Project:      &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update default branch of repository %s: %w", *repo.Name, err)
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Changed default branch of %s from %s to %s\n", cs.SuccessIcon(), *repo.Name, cs.Bold(oldBranch), cs.Bold(newBranch))
+	} else {
+		fmt.Fprintf(iostrms.Out, "%s\t%s\n", oldBranch, newBranch)
+	}
+	return
+}
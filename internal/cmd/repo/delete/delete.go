@@ -0,0 +1,128 @@
+package delete
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type deleteOptions struct {
+	repository  string
+	scope       string
+	yes         bool
+	confirmName string
+	dryRun      bool
+}
+
+func NewCmdRepoDelete(ctx util.CmdContext) *cobra.Command {
+	opts := &deleteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <repository> [organization/]project",
+		Short: "Delete a repository",
+		Long: heredoc.Doc(`
+			Delete a repository of a project.
+
+			Unless --yes is given, the name of the repository must be typed to confirm the deletion.
+			In scripts, pass the name of the repository with --confirm-name instead; the deletion is
+			aborted if it does not match the name of the repository.
+		`),
+		Example: heredoc.Doc(`
+			# delete the repository myrepo after typing its name
+			azdo repo delete myrepo myproject
+
+			# delete the repository in a script
+			azdo repo delete myrepo myorg/myproject --confirm-name myrepo
+		`),
+		Args: util.ExactArgs(2, "cannot delete repository: repository and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.repository = args[0]
+			opts.scope = args[1]
+
+			return runDelete(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	cmd.Flags().StringVar(&opts.confirmName, "confirm-name", "", "Name of the repository to confirm the deletion without prompting")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be deleted without deleting it")
+	cmd.MarkFlagsMutuallyExclusive("yes", "confirm-name")
+
+	return cmd
+}
+
+func runDelete(ctx util.CmdContext, opts *deleteOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &opts.repository,
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+	}
+
+	switch {
+	case opts.confirmName != "":
+		if opts.confirmName != *repo.Name {
+			return fmt.Errorf("--confirm-name %q does not match the name of repository %s", opts.confirmName, *repo.Name)
+		}
+	case !opts.yes && !opts.dryRun:
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--yes or --confirm-name required when not running interactively")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		if err := p.ConfirmDeletion(*repo.Name); err != nil {
+			return err
+		}
+	}
+
+	iostrms.StartProgressIndicator()
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, fmt.Sprintf("delete repository %s of project %s", *repo.Name, project), func() error {
+		return client.DeleteRepository(rctx, git.DeleteRepositoryArgs{
+			RepositoryId: repo.Id,
+			Project:      &project,
+		})
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to delete repository %s: %w", *repo.Name, err)
+	}
+	if opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Deleted repository %s\n", cs.SuccessIcon(), cs.Bold(*repo.Name))
+	}
+	return
+}
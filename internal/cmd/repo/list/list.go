@@ -48,26 +48,16 @@ func NewCmdRepoList(ctx util.CmdContext) *cobra.Command {
 	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Get per-organization configuration")
 	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 30, "Maximum number of repositories to list")
 	util.StringEnumFlag(cmd, &opts.visibility, "visibility", "", "", []string{"public", "private"}, "Filter by repository visibility")
-	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+	util.AddOutputFormatFlag(cmd, &opts.format)
 	cmd.Flags().BoolVar(&opts.includeHidden, "include-hidden", false, "Include hidden repositories")
 
 	return cmd
 }
 
 func runList(ctx util.CmdContext, opts *listOptions) (err error) {
-	cfg, err := ctx.Config()
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
 	if err != nil {
-		return util.FlagErrorf("error getting io configuration: %w", err)
-	}
-
-	var organizationName string
-	if opts.organizationName != "" {
-		organizationName = opts.organizationName
-	} else {
-		organizationName, _ = cfg.Authentication().GetDefaultOrganization()
-	}
-	if organizationName == "" {
-		return util.FlagErrorf("no organization specified")
+		return
 	}
 	conn, err := ctx.Connection(organizationName)
 	if err != nil {
@@ -0,0 +1,263 @@
+package merge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	branchshared "github.com/tmeckel/azdo-cli/internal/cmd/repo/branch/shared"
+	commitshared "github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+const strategyNoFastForward = "noFastForward"
+
+type mergeOptions struct {
+	sourceBranch string
+	targetBranch string
+	repository   string
+	scope        string
+	message      string
+	strategy     string
+	yes          bool
+	dryRun       bool
+}
+
+func NewCmdMerge(ctx util.CmdContext) *cobra.Command {
+	opts := &mergeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "merge <source-branch> <target-branch> [<repository>] [organization/]project",
+		Short: "Merge a branch into another branch",
+		Long: heredoc.Doc(`
+			Merge a branch into another branch on the server without a pull request.
+
+			A merge commit with the heads of the target and the source branch as parents is created
+			and the target branch is updated to it. If the target branch is protected by branch
+			policies, the update is rejected unless you are allowed to bypass the policies.
+
+			The merge API of Azure DevOps only creates merge commits, so noFastForward is the only
+			strategy. Squash and rebase merges are available when completing a pull request with
+			"azdo pr merge".
+
+			If the repository is omitted, the Azure DevOps repository of the git remotes of the current
+			directory is used.
+		`),
+		Example: heredoc.Doc(`
+			# merge the release branch back into main
+			azdo repo merge release/1.2 main myrepo myproject
+
+			# merge without confirmation and with a custom message
+			azdo repo merge feature/login develop myrepo myorg/myproject -m "Merge login feature" --yes
+
+			# show what would be merged
+			azdo repo merge release/1.2 main myrepo myproject --dry-run
+
+			# merge a branch of the repository of the current directory
+			azdo repo merge release/1.2 main myproject
+		`),
+		Args: util.RangeArgs(3, 4, "cannot merge branches: source branch, target branch and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.sourceBranch = args[0]
+			opts.targetBranch = args[1]
+			opts.scope = args[len(args)-1]
+			if len(args) == 4 {
+				opts.repository = args[2]
+			} else {
+				repository, err := util.RepositoryFromRemote(ctx)
+				if err != nil {
+					return err
+				}
+				opts.repository = repository
+			}
+
+			return runMerge(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.message, "message", "m", "", "Message of the merge commit")
+	util.StringEnumFlag(cmd, &opts.strategy, "strategy", "", strategyNoFastForward, []string{strategyNoFastForward}, "Merge strategy")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Do not prompt for confirmation")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print what would be merged without merging")
+
+	return cmd
+}
+
+func runMerge(ctx util.CmdContext, opts *mergeOptions) (err error) {
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	return mergeBranches(ctx, client, project, opts)
+}
+
+// mergeBranches merges the source into the target branch and updates the target branch to the merge commit.
+func mergeBranches(ctx util.CmdContext, client git.Client, project string, opts *mergeOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	sourceRef := util.NormalizeBranchRef(opts.sourceBranch)
+	targetRef := util.NormalizeBranchRef(opts.targetBranch)
+	sourceName := util.ShortBranchName(sourceRef)
+	targetName := util.ShortBranchName(targetRef)
+	if sourceRef == targetRef {
+		return util.FlagErrorf("source and target branch are the same")
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &opts.repository,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+	}
+	repositoryID := repo.Id.String()
+
+	source, err := branchshared.FindRef(rctx, client, project, repositoryID, sourceRef)
+	if err != nil {
+		return
+	}
+	if source == nil {
+		return fmt.Errorf("branch %s does not exist in repository %s", sourceName, *repo.Name)
+	}
+	target, err := branchshared.FindRef(rctx, client, project, repositoryID, targetRef)
+	if err != nil {
+		return
+	}
+	if target == nil {
+		return fmt.Errorf("branch %s does not exist in repository %s", targetName, *repo.Name)
+	}
+
+	bases, err := client.GetMergeBases(rctx, git.GetMergeBasesArgs{
+		RepositoryNameOrId: &repositoryID,
+		CommitId:           target.ObjectId,
+		OtherCommitId:      source.ObjectId,
+		Project:            &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get merge base of %s and %s: %w", sourceName, targetName, err)
+	}
+	if lo.ContainsBy(lo.FromPtr(bases), func(c git.GitCommitRef) bool { return lo.FromPtr(c.CommitId) == *source.ObjectId }) {
+		iostrms.StopProgressIndicator()
+		fmt.Fprintf(iostrms.ErrOut, "branch %s already contains %s, nothing to merge\n", targetName, sourceName)
+		return
+	}
+	iostrms.StopProgressIndicator()
+
+	if !opts.yes && !opts.dryRun {
+		if !iostrms.CanPrompt() {
+			return util.FlagErrorf("--yes required when not running interactively")
+		}
+		p, err := ctx.Prompter()
+		if err != nil {
+			return err
+		}
+		confirmed, err := p.Confirm(fmt.Sprintf("Merge branch %s into %s of repository %s?", sourceName, targetName, *repo.Name), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return util.ErrCancel
+		}
+	}
+
+	iostrms.StartProgressIndicator()
+	message := lo.Ternary(opts.message != "", opts.message, defaultMessage(sourceName, targetName))
+	var mergeCommitID string
+	action := fmt.Sprintf("merge branch %s into %s of repository %s", sourceName, targetName, *repo.Name)
+	err = util.DryRunWrap(opts.dryRun, iostrms.Out, action, func() error {
+		merge, err := client.CreateMergeRequest(rctx, git.CreateMergeRequestArgs{
+			MergeParameters: &git.GitMergeParameters{
+				Comment: &message,
+				// the first parent is the target branch, as with git merge
+				Parents: &[]string{*target.ObjectId, *source.ObjectId},
+			},
+			Project:            &project,
+			RepositoryNameOrId: &repositoryID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to merge %s into %s: %w", sourceName, targetName, err)
+		}
+		if merge == nil || merge.MergeOperationId == nil {
+			return fmt.Errorf("failed to merge %s into %s: no merge operation was created", sourceName, targetName)
+		}
+
+		mergeOperationID := *merge.MergeOperationId
+		status, err := commitshared.WaitForOperation(rctx, fmt.Sprintf("merge operation %d", mergeOperationID), func(ctx context.Context) (*commitshared.OperationStatus, error) {
+			merge, err := client.GetMergeRequest(ctx, git.GetMergeRequestArgs{
+				Project:            &project,
+				RepositoryNameOrId: &repositoryID,
+				MergeOperationId:   &mergeOperationID,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get merge %d: %w", mergeOperationID, err)
+			}
+			detail := lo.FromPtr(merge.DetailedStatus)
+			mergeCommitID = lo.FromPtr(detail.MergeCommitId)
+			return commitshared.NewOperationStatus(merge.Status, &git.GitAsyncRefOperationDetail{
+				FailureMessage: detail.FailureMessage,
+			}), nil
+		})
+		if err != nil {
+			return err
+		}
+		if !status.Succeeded() {
+			return status.Err(fmt.Sprintf("merge %s into %s", sourceName, targetName))
+		}
+
+		err = branchshared.UpdateRef(rctx, client, project, repositoryID, git.GitRefUpdate{
+			Name:        &targetRef,
+			OldObjectId: target.ObjectId,
+			NewObjectId: &mergeCommitID,
+		})
+		if err != nil {
+			return fmt.Errorf("created merge commit %s but failed to update branch %s: %w", mergeCommitID, targetName, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+	iostrms.StopProgressIndicator()
+	if opts.dryRun {
+		return
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Merged %s into %s as %s\n", cs.SuccessIcon(), cs.Bold(sourceName), cs.Bold(targetName), cs.Bold(mergeCommitID))
+		return
+	}
+	fmt.Fprintln(iostrms.Out, mergeCommitID)
+	return
+}
+
+// defaultMessage returns the message of the merge commit in the format of git merge.
+func defaultMessage(sourceBranch, targetBranch string) string {
+	return fmt.Sprintf("Merge branch '%s' into %s", sourceBranch, targetBranch)
+}
@@ -0,0 +1,218 @@
+package merge
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commitshared "github.com/tmeckel/azdo-cli/internal/cmd/repo/commit/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+)
+
+const (
+	sourceHead  = "1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b"
+	targetHead  = "0f9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b3a2f1e"
+	mergeCommit = "5c4b3a2f1e0d9c8b7a6f5e4d3c2b1a0f9e8d7c6b"
+)
+
+type fakeCmdContext struct {
+	util.CmdContext
+	ios *iostreams.IOStreams
+}
+
+func (c *fakeCmdContext) IOStreams() (*iostreams.IOStreams, error) {
+	return c.ios, nil
+}
+
+func (c *fakeCmdContext) Context() (context.Context, error) {
+	return context.Background(), nil
+}
+
+type fakeClient struct {
+	git.Client
+	bases        []git.GitCommitRef
+	statuses     []git.GitAsyncOperationStatus
+	detail       git.GitMergeOperationStatusDetail
+	updateStatus git.GitRefUpdateStatus
+	polls        int
+	noOperation  bool
+	merge        *git.GitMergeParameters
+	update       *git.GitRefUpdate
+}
+
+func (c *fakeClient) GetRepository(_ context.Context, args git.GetRepositoryArgs) (*git.GitRepository, error) {
+	return &git.GitRepository{Id: lo.ToPtr(uuid.New()), Name: args.RepositoryId}, nil
+}
+
+func (c *fakeClient) GetRefs(_ context.Context, _ git.GetRefsArgs) (*git.GetRefsResponseValue, error) {
+	return &git.GetRefsResponseValue{Value: []git.GitRef{
+		{Name: lo.ToPtr("refs/heads/release/1.2"), ObjectId: lo.ToPtr(sourceHead)},
+		{Name: lo.ToPtr("refs/heads/main"), ObjectId: lo.ToPtr(targetHead)},
+	}}, nil
+}
+
+func (c *fakeClient) GetMergeBases(_ context.Context, _ git.GetMergeBasesArgs) (*[]git.GitCommitRef, error) {
+	return &c.bases, nil
+}
+
+func (c *fakeClient) CreateMergeRequest(_ context.Context, args git.CreateMergeRequestArgs) (*git.GitMerge, error) {
+	c.merge = args.MergeParameters
+	if c.noOperation {
+		return &git.GitMerge{}, nil
+	}
+	return &git.GitMerge{MergeOperationId: lo.ToPtr(7)}, nil
+}
+
+// GetMergeRequest returns the statuses in order and repeats the last one.
+func (c *fakeClient) GetMergeRequest(_ context.Context, _ git.GetMergeRequestArgs) (*git.GitMerge, error) {
+	status := c.statuses[len(c.statuses)-1]
+	if c.polls < len(c.statuses) {
+		status = c.statuses[c.polls]
+	}
+	c.polls++
+	return &git.GitMerge{Status: &status, DetailedStatus: &c.detail}, nil
+}
+
+func (c *fakeClient) UpdateRefs(_ context.Context, args git.UpdateRefsArgs) (*[]git.GitRefUpdateResult, error) {
+	c.update = &(*args.RefUpdates)[0]
+	return &[]git.GitRefUpdateResult{{
+		Success:      lo.ToPtr(c.updateStatus == git.GitRefUpdateStatusValues.Succeeded),
+		UpdateStatus: &c.updateStatus,
+	}}, nil
+}
+
+func TestMergeBranches(t *testing.T) {
+	pollInterval, operationTimeout := commitshared.PollInterval, commitshared.OperationTimeout
+	t.Cleanup(func() { commitshared.PollInterval, commitshared.OperationTimeout = pollInterval, operationTimeout })
+	commitshared.PollInterval = time.Millisecond
+
+	completed := []git.GitAsyncOperationStatus{git.GitAsyncOperationStatusValues.Queued, git.GitAsyncOperationStatusValues.Completed}
+
+	tests := []struct {
+		name         string
+		bases        []git.GitCommitRef
+		statuses     []git.GitAsyncOperationStatus
+		detail       git.GitMergeOperationStatusDetail
+		updateStatus git.GitRefUpdateStatus
+		noOperation  bool
+		dryRun       bool
+		timeout      time.Duration
+		wantMerge    bool
+		wantUpdate   bool
+		wantOut      string
+		wantErrOut   string
+		wantErr      string
+	}{
+		{
+			name:         "merged",
+			bases:        []git.GitCommitRef{{CommitId: lo.ToPtr("base")}},
+			statuses:     completed,
+			detail:       git.GitMergeOperationStatusDetail{MergeCommitId: lo.ToPtr(mergeCommit)},
+			updateStatus: git.GitRefUpdateStatusValues.Succeeded,
+			wantMerge:    true,
+			wantUpdate:   true,
+			wantOut:      mergeCommit + "\n",
+		},
+		{
+			name:    "dry run",
+			bases:   []git.GitCommitRef{{CommitId: lo.ToPtr("base")}},
+			dryRun:  true,
+			wantOut: "Would merge branch release/1.2 into main of repository myrepo\n",
+		},
+		{
+			name:        "no merge operation",
+			bases:       []git.GitCommitRef{{CommitId: lo.ToPtr("base")}},
+			noOperation: true,
+			wantMerge:   true,
+			wantErr:     "failed to merge release/1.2 into main: no merge operation was created",
+		},
+		{
+			name:       "already contained",
+			bases:      []git.GitCommitRef{{CommitId: lo.ToPtr(sourceHead)}},
+			wantErrOut: "branch main already contains release/1.2, nothing to merge\n",
+		},
+		{
+			name:      "failed",
+			bases:     []git.GitCommitRef{{CommitId: lo.ToPtr("base")}},
+			statuses:  []git.GitAsyncOperationStatus{git.GitAsyncOperationStatusValues.Failed},
+			detail:    git.GitMergeOperationStatusDetail{FailureMessage: lo.ToPtr("the merge has conflicts")},
+			wantMerge: true,
+			wantErr:   "failed to merge release/1.2 into main: the merge has conflicts",
+		},
+		{
+			name:      "timed out",
+			bases:     []git.GitCommitRef{{CommitId: lo.ToPtr("base")}},
+			statuses:  []git.GitAsyncOperationStatus{git.GitAsyncOperationStatusValues.InProgress},
+			timeout:   10 * time.Millisecond,
+			wantMerge: true,
+			wantErr:   "merge operation 7 did not complete within 10ms",
+		},
+		{
+			name:         "target branch moved",
+			bases:        []git.GitCommitRef{{CommitId: lo.ToPtr("base")}},
+			statuses:     completed,
+			detail:       git.GitMergeOperationStatusDetail{MergeCommitId: lo.ToPtr(mergeCommit)},
+			updateStatus: git.GitRefUpdateStatusValues.StaleOldObjectId,
+			wantMerge:    true,
+			wantUpdate:   true,
+			wantErr:      "created merge commit " + mergeCommit + " but failed to update branch main: staleOldObjectId",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commitshared.OperationTimeout = lo.Ternary(tt.timeout != 0, tt.timeout, operationTimeout)
+
+			ios, _, stdout, stderr := iostreams.Test()
+			client := &fakeClient{
+				bases:        tt.bases,
+				statuses:     tt.statuses,
+				detail:       tt.detail,
+				updateStatus: tt.updateStatus,
+				noOperation:  tt.noOperation,
+			}
+			err := mergeBranches(&fakeCmdContext{ios: ios}, client, "myproject", &mergeOptions{
+				sourceBranch: "release/1.2",
+				targetBranch: "main",
+				repository:   "myrepo",
+				yes:          true,
+				dryRun:       tt.dryRun,
+			})
+
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOut, stdout.String())
+			assert.Equal(t, tt.wantErrOut, stderr.String())
+
+			if !tt.wantMerge {
+				assert.Nil(t, client.merge)
+				return
+			}
+			require.NotNil(t, client.merge)
+			assert.Equal(t, []string{targetHead, sourceHead}, *client.merge.Parents)
+			assert.Equal(t, "Merge branch 'release/1.2' into main", *client.merge.Comment)
+
+			if !tt.wantUpdate {
+				assert.Nil(t, client.update)
+				return
+			}
+			require.NotNil(t, client.update)
+			assert.Equal(t, "refs/heads/main", *client.update.Name)
+			assert.Equal(t, targetHead, *client.update.OldObjectId)
+			assert.Equal(t, mergeCommit, *client.update.NewObjectId)
+		})
+	}
+}
+
+func TestDefaultMessage(t *testing.T) {
+	assert.Equal(t, "Merge branch 'release/1.2' into main", defaultMessage("release/1.2", "main"))
+}
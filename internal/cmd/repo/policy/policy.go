@@ -0,0 +1,17 @@
+package policy
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/policy/update"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdPolicy(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy <command>",
+		Short: "Manage branch policies of repositories",
+	}
+
+	cmd.AddCommand(update.NewCmdUpdate(ctx))
+	return cmd
+}
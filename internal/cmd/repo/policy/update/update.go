@@ -0,0 +1,184 @@
+package update
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	jsonpatch "github.com/tmeckel/azdo-cli/internal/json"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type updateOptions struct {
+	policyID     int
+	scope        string
+	blocking     *bool
+	enabled      *bool
+	settingsJSON string
+	format       string
+}
+
+func NewCmdUpdate(ctx util.CmdContext) *cobra.Command {
+	opts := &updateOptions{}
+	var blocking, enabled bool
+
+	cmd := &cobra.Command{
+		Use:   "update <id> [organization/]project",
+		Short: "Update a policy",
+		Long: heredoc.Doc(`
+			Update a policy configuration of a project.
+
+			The settings of the policy are changed with --settings-json, which takes a JSON merge patch
+			(RFC 7386): members of the patch replace the settings of the policy, objects are merged
+			and members with a null value are removed.
+		`),
+		Example: heredoc.Doc(`
+			# make policy 12 blocking
+			azdo repo policy update 12 myproject --blocking
+
+			# disable policy 12
+			azdo repo policy update 12 myorg/myproject --enabled=false
+
+			# require two reviewers
+			azdo repo policy update 12 myproject --settings-json '{"minimumApproverCount": 2}'
+		`),
+		Args: util.ExactArgs(2, "cannot update policy: policy ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.Atoi(args[0])
+			if err != nil || id < 1 {
+				return util.FlagErrorf("invalid policy ID: %s", args[0])
+			}
+			opts.policyID = id
+			opts.scope = args[1]
+			if cmd.Flags().Changed("blocking") {
+				opts.blocking = &blocking
+			}
+			if cmd.Flags().Changed("enabled") {
+				opts.enabled = &enabled
+			}
+			if opts.blocking == nil && opts.enabled == nil && opts.settingsJSON == "" {
+				return util.FlagErrorf("at least one of --blocking, --enabled or --settings-json is required")
+			}
+
+			return runUpdate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&blocking, "blocking", false, "Whether the policy blocks the completion of pull requests")
+	cmd.Flags().BoolVar(&enabled, "enabled", false, "Whether the policy is enabled")
+	cmd.Flags().StringVar(&opts.settingsJSON, "settings-json", "", "JSON merge patch applied to the settings of the policy")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runUpdate(ctx util.CmdContext, opts *updateOptions) (err error) {
+	var patch any
+	if opts.settingsJSON != "" {
+		if err := json.Unmarshal([]byte(opts.settingsJSON), &patch); err != nil {
+			return util.FlagErrorf("invalid value for --settings-json: %w", err)
+		}
+		if _, ok := patch.(map[string]any); !ok {
+			return util.FlagErrorf("invalid value for --settings-json: must be a JSON object")
+		}
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := policy.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	config, err := client.GetPolicyConfiguration(rctx, policy.GetPolicyConfigurationArgs{
+		Project:         &project,
+		ConfigurationId: &opts.policyID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get policy %d: %w", opts.policyID, err)
+	}
+
+	if err := applyChanges(config, opts.blocking, opts.enabled, patch); err != nil {
+		return err
+	}
+
+	config, err = client.UpdatePolicyConfiguration(rctx, policy.UpdatePolicyConfigurationArgs{
+		Configuration:   config,
+		Project:         &project,
+		ConfigurationId: &opts.policyID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update policy %d: %w", opts.policyID, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(config)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Type", "Enabled", "Blocking", "Revision")
+	tp.AddField(strconv.Itoa(*config.Id), printer.WithTruncate(nil))
+	if config.Type != nil {
+		tp.AddField(lo.FromPtr(config.Type.DisplayName))
+	} else {
+		tp.AddField("")
+	}
+	tp.AddField(strconv.FormatBool(lo.FromPtr(config.IsEnabled)))
+	tp.AddField(strconv.FormatBool(lo.FromPtr(config.IsBlocking)))
+	tp.AddField(strconv.Itoa(lo.FromPtr(config.Revision)), printer.WithTruncate(nil))
+	tp.EndRow()
+	return tp.Render()
+}
+
+// applyChanges sets the given flags of the policy configuration and applies the merge patch to its
+// settings.
+func applyChanges(config *policy.PolicyConfiguration, blocking, enabled *bool, patch any) error {
+	if blocking != nil {
+		config.IsBlocking = blocking
+	}
+	if enabled != nil {
+		config.IsEnabled = enabled
+	}
+	if patch == nil {
+		return nil
+	}
+
+	// normalize the settings to the types decoded by encoding/json
+	b, err := json.Marshal(config.Settings)
+	if err != nil {
+		return err
+	}
+	var settings any
+	if err := json.Unmarshal(b, &settings); err != nil {
+		return fmt.Errorf("failed to parse settings of policy: %w", err)
+	}
+	config.Settings = jsonpatch.MergePatch(settings, patch)
+	return nil
+}
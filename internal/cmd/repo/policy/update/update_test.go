@@ -0,0 +1,39 @@
+package update
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/policy"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyChanges(t *testing.T) {
+	config := &policy.PolicyConfiguration{
+		IsBlocking: lo.ToPtr(false),
+		IsEnabled:  lo.ToPtr(true),
+		Settings: map[string]interface{}{
+			"minimumApproverCount": 1,
+			"creatorVoteCounts":    false,
+			"scope": []interface{}{
+				map[string]interface{}{"refName": "refs/heads/main", "matchKind": "Exact"},
+			},
+		},
+	}
+
+	err := applyChanges(config, lo.ToPtr(true), nil, map[string]any{
+		"minimumApproverCount": 2,
+		"creatorVoteCounts":    nil,
+	})
+	require.NoError(t, err)
+
+	assert.True(t, *config.IsBlocking)
+	assert.True(t, *config.IsEnabled)
+	assert.Equal(t, map[string]any{
+		"minimumApproverCount": 2,
+		"scope": []any{
+			map[string]any{"refName": "refs/heads/main", "matchKind": "Exact"},
+		},
+	}, config.Settings)
+}
@@ -3,8 +3,18 @@ package repo
 import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/branch"
 	"github.com/tmeckel/azdo-cli/internal/cmd/repo/clone"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/commit"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/compare"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/defaultbranch"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/delete"
 	"github.com/tmeckel/azdo-cli/internal/cmd/repo/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/merge"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/policy"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/search"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/stat"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/webhook"
 	"github.com/tmeckel/azdo-cli/internal/cmd/util"
 )
 
@@ -31,5 +41,15 @@ func NewCmdRepo(ctx util.CmdContext) *cobra.Command {
 
 	cmd.AddCommand(list.NewCmdRepoList(ctx))
 	cmd.AddCommand(clone.NewCmdRepoClone(ctx))
+	cmd.AddCommand(defaultbranch.NewCmdRepoDefaultBranch(ctx))
+	cmd.AddCommand(compare.NewCmdRepoCompare(ctx))
+	cmd.AddCommand(search.NewCmdRepoSearch(ctx))
+	cmd.AddCommand(stat.NewCmdStat(ctx))
+	cmd.AddCommand(branch.NewCmdBranch(ctx))
+	cmd.AddCommand(commit.NewCmdCommit(ctx))
+	cmd.AddCommand(merge.NewCmdMerge(ctx))
+	cmd.AddCommand(delete.NewCmdRepoDelete(ctx))
+	cmd.AddCommand(policy.NewCmdPolicy(ctx))
+	cmd.AddCommand(webhook.NewCmdWebhook(ctx))
 	return cmd
 }
@@ -0,0 +1,226 @@
+package search
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/search"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/searchshared"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// maxPageSize is the maximum number of results returned by a single code search request
+const maxPageSize = 1000
+
+type searchOptions struct {
+	query      string
+	scope      string
+	repository string
+	branch     string
+	extensions []string
+	limit      int
+	format     string
+}
+
+type searchMatch struct {
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+	Preview string `json:"preview"`
+}
+
+type searchResult struct {
+	Path       string        `json:"path"`
+	Matches    []searchMatch `json:"matches"`
+	Repository string        `json:"repository"`
+	Branch     string        `json:"branch"`
+}
+
+func NewCmdRepoSearch(ctx util.CmdContext) *cobra.Command {
+	opts := &searchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "search <query> [organization/]project",
+		Short: "Search code in the repositories of a project",
+		Long: heredoc.Doc(`
+			Search code in the repositories of a project using Azure DevOps code search.
+
+			The query supports the code search syntax, e.g. "class:Parser" or "def:NewClient".
+			Code search must be enabled for the organization.
+		`),
+		Example: heredoc.Doc(`
+			# search for NewClient in all repositories of a project
+			azdo repo search NewClient myproject
+
+			# search in the Go and TypeScript files of the main branch of a repository
+			azdo repo search "func main" myorg/myproject --repo myrepo --branch main --ext .go,.ts
+		`),
+		Args: util.ExactArgs(2, "cannot search: query and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.query = args[0]
+			opts.scope = args[1]
+
+			if opts.limit < 1 {
+				return util.FlagErrorf("invalid limit: %d", opts.limit)
+			}
+			if opts.branch != "" && opts.repository == "" {
+				return util.FlagErrorf("--branch requires --repo")
+			}
+
+			return runSearch(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.repository, "repo", "r", "", "Only search in this repository")
+	cmd.Flags().StringVarP(&opts.branch, "branch", "b", "", "Only search in this branch of the repository")
+	cmd.Flags().StringSliceVar(&opts.extensions, "ext", nil, "Only search in files with these extensions, e.g. \".go,.ts\"")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 100, "Maximum number of files to return")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runSearch(ctx util.CmdContext, opts *searchOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := search.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	filters := map[string][]string{
+		"Project": {project},
+	}
+	if opts.repository != "" {
+		filters["Repository"] = []string{opts.repository}
+	}
+	if opts.branch != "" {
+		filters["Branch"] = []string{util.ShortBranchName(opts.branch)}
+	}
+
+	iostrms.StartProgressIndicator()
+	var results []searchResult
+	for skip := 0; len(results) < opts.limit; {
+		top := lo.Min([]int{opts.limit - len(results), maxPageSize})
+		res, err := client.FetchCodeSearchResults(rctx, search.FetchCodeSearchResultsArgs{
+			Request: &search.CodeSearchRequest{
+				SearchText:     lo.ToPtr(buildSearchText(opts.query, opts.extensions)),
+				Filters:        &filters,
+				Skip:           &skip,
+				Top:            &top,
+				IncludeSnippet: lo.ToPtr(true),
+			},
+			Project: &project,
+		})
+		if err != nil {
+			iostrms.StopProgressIndicator()
+			return fmt.Errorf("failed to search code: %w", err)
+		}
+		if res.Results == nil || len(*res.Results) == 0 {
+			break
+		}
+		for _, r := range *res.Results {
+			results = append(results, newSearchResult(&r))
+		}
+		skip += len(*res.Results)
+		if skip >= lo.FromPtr(res.Count) {
+			break
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	if len(results) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No code found matching %q", opts.query))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(results)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("File", "Line", "Preview", "Repo", "Branch")
+	for _, r := range results {
+		matches := r.Matches
+		if len(matches) == 0 {
+			matches = []searchMatch{{}}
+		}
+		for _, m := range matches {
+			tp.AddField(r.Path)
+			if m.Line > 0 {
+				tp.AddField(strconv.Itoa(m.Line), printer.WithTruncate(nil))
+			} else {
+				tp.AddField("")
+			}
+			tp.AddField(m.Preview)
+			tp.AddField(r.Repository)
+			tp.AddField(r.Branch)
+			tp.EndRow()
+		}
+	}
+	return tp.Render()
+}
+
+// buildSearchText adds the extension filters to the query.
+func buildSearchText(query string, extensions []string) string {
+	exts := lo.FilterMap(extensions, func(e string, _ int) (string, bool) {
+		e = strings.TrimPrefix(strings.TrimSpace(e), ".")
+		return "ext:" + e, e != ""
+	})
+	switch len(exts) {
+	case 0:
+		return query
+	case 1:
+		return fmt.Sprintf("%s %s", query, exts[0])
+	default:
+		return fmt.Sprintf("%s (%s)", query, strings.Join(exts, " OR "))
+	}
+}
+
+func newSearchResult(r *search.CodeResult) searchResult {
+	result := searchResult{
+		Path: lo.FromPtr(r.Path),
+	}
+	if r.Repository != nil {
+		result.Repository = lo.FromPtr(r.Repository.Name)
+	}
+	if r.Versions != nil && len(*r.Versions) > 0 {
+		result.Branch = lo.FromPtr((*r.Versions)[0].BranchName)
+	}
+	if r.Matches != nil {
+		for _, hit := range (*r.Matches)["content"] {
+			result.Matches = append(result.Matches, newSearchMatch(hit))
+		}
+	}
+	return result
+}
+
+func newSearchMatch(hit searchshared.Hit) searchMatch {
+	return searchMatch{
+		Line:    lo.FromPtr(hit.Line),
+		Column:  lo.FromPtr(hit.Column),
+		Preview: strings.TrimSpace(lo.FromPtr(hit.CodeSnippet)),
+	}
+}
@@ -0,0 +1,282 @@
+package stat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// commitsLocationID is the location of the commits of a repository. The commits are requested
+// without the git client of the SDK, which drops the change counts of the commits.
+var commitsLocationID = uuid.MustParse("c2570c3b-5b3f-41b8-98bf-5407bfde8d58")
+
+const apiVersion = "7.1-preview.1"
+
+// pageSize is the number of commits fetched with a single request
+const pageSize = 1000
+
+var periods = []string{"day", "week", "month", "year"}
+
+type statOptions struct {
+	repository string
+	scope      string
+	period     string
+	format     string
+}
+
+// commit is a commit as returned by the commits API.
+type commit struct {
+	CommitID string `json:"commitId"`
+	Author   struct {
+		Name  string    `json:"name"`
+		Email string    `json:"email"`
+		Date  time.Time `json:"date"`
+	} `json:"author"`
+	ChangeCounts map[string]int `json:"changeCounts"`
+}
+
+type repoStats struct {
+	Repository      string         `json:"repository"`
+	Branch          string         `json:"branch"`
+	Period          string         `json:"period"`
+	Since           time.Time      `json:"since"`
+	Commits         int            `json:"commits"`
+	Contributors    int            `json:"contributors"`
+	FilesChanged    int            `json:"filesChanged"`
+	ChangeCounts    map[string]int `json:"changeCounts"`
+	ActiveBranches  int            `json:"activeBranches"`
+	TotalBranches   int            `json:"totalBranches"`
+	TopContributors []contributor  `json:"topContributors"`
+}
+
+type contributor struct {
+	Name    string `json:"name"`
+	Email   string `json:"email"`
+	Commits int    `json:"commits"`
+}
+
+func NewCmdStat(ctx util.CmdContext) *cobra.Command {
+	opts := &statOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "stat [<repository>] [organization/]project",
+		Short: "Show statistics of a repository",
+		Long: heredoc.Doc(`
+			Show the activity of a repository during the last day, week, month or year.
+
+			The number of commits, contributors and changed files is computed from the commits of the
+			default branch in the period. Files changed by several commits are counted once per
+			commit. A branch is active if its last commit is in the period.
+
+			If the repository is omitted, the Azure DevOps repository of the git remotes of the current
+			directory is used.
+		`),
+		Example: heredoc.Doc(`
+			# show the activity of the last month
+			azdo repo stat myrepo myproject
+
+			# print the statistics of the last year as JSON
+			azdo repo stat myrepo myorg/myproject --period year --format json
+
+			# show the activity of the repository of the current directory
+			azdo repo stat myproject
+		`),
+		Args: util.RangeArgs(1, 2, "cannot show statistics: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[len(args)-1]
+			if len(args) == 2 {
+				opts.repository = args[0]
+			} else {
+				repository, err := util.RepositoryFromRemote(ctx)
+				if err != nil {
+					return err
+				}
+				opts.repository = repository
+			}
+
+			return runStat(ctx, opts)
+		},
+	}
+
+	util.StringEnumFlag(cmd, &opts.period, "period", "", "month", periods, "Period to compute the statistics for")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "", []string{"json"}, "Output format")
+
+	return cmd
+}
+
+func runStat(ctx util.CmdContext, opts *statOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := git.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	rawClient, err := conn.GetClientByResourceAreaId(rctx, git.ResourceAreaId)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+		Project:      &project,
+		RepositoryId: &opts.repository,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+	}
+	if repo.DefaultBranch == nil {
+		return fmt.Errorf("repository %s has no default branch", *repo.Name)
+	}
+
+	since := periodStart(time.Now(), opts.period)
+	commits, err := getCommits(rctx, rawClient, project, repo.Id.String(), since)
+	if err != nil {
+		return fmt.Errorf("failed to get commits of repository %s: %w", *repo.Name, err)
+	}
+	branches, err := client.GetBranches(rctx, git.GetBranchesArgs{
+		RepositoryId: lo.ToPtr(repo.Id.String()),
+		Project:      &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get branches of repository %s: %w", *repo.Name, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	stats := computeStats(commits, lo.FromPtr(branches), since)
+	stats.Repository = *repo.Name
+	stats.Branch = util.ShortBranchName(*repo.DefaultBranch)
+	stats.Period = opts.period
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(stats)
+	}
+
+	cs := iostrms.ColorScheme()
+	out := iostrms.Out
+	fmt.Fprintf(out, "%s (%s), last %s since %s\n\n", cs.Bold(stats.Repository), stats.Branch, stats.Period, stats.Since.Local().Format("2006-01-02 15:04"))
+	fmt.Fprintf(out, "Commits:          %d\n", stats.Commits)
+	fmt.Fprintf(out, "Contributors:     %d\n", stats.Contributors)
+	fmt.Fprintf(out, "Files changed:    %d", stats.FilesChanged)
+	if stats.FilesChanged > 0 {
+		fmt.Fprintf(out, " (%d added, %d edited, %d deleted)", stats.ChangeCounts["Add"], stats.ChangeCounts["Edit"], stats.ChangeCounts["Delete"])
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "Active branches:  %d of %d\n", stats.ActiveBranches, stats.TotalBranches)
+	if len(stats.TopContributors) > 0 {
+		fmt.Fprintln(out, "\nTop contributors:")
+		for _, c := range stats.TopContributors {
+			fmt.Fprintf(out, "  %5d  %s <%s>\n", c.Commits, c.Name, c.Email)
+		}
+	}
+	return
+}
+
+// periodStart returns the start of the period ending at now.
+func periodStart(now time.Time, period string) time.Time {
+	switch period {
+	case "day":
+		return now.AddDate(0, 0, -1)
+	case "week":
+		return now.AddDate(0, 0, -7)
+	case "year":
+		return now.AddDate(-1, 0, 0)
+	default:
+		return now.AddDate(0, -1, 0)
+	}
+}
+
+// getCommits returns the commits of the default branch of the repository since the given time.
+func getCommits(ctx context.Context, client *azuredevops.Client, project, repositoryID string, since time.Time) ([]commit, error) {
+	var commits []commit
+	for skip := 0; ; skip += pageSize {
+		query := url.Values{}
+		query.Add("searchCriteria.fromDate", since.UTC().Format(time.RFC3339))
+		query.Add("searchCriteria.$skip", strconv.Itoa(skip))
+		query.Add("searchCriteria.$top", strconv.Itoa(pageSize))
+		resp, err := client.Send(ctx, http.MethodGet, commitsLocationID, apiVersion, map[string]string{
+			"project":      project,
+			"repositoryId": repositoryID,
+		}, query, nil, "", "application/json", nil)
+		if err != nil {
+			return nil, err
+		}
+		var page []commit
+		if err := client.UnmarshalCollectionBody(resp, &page); err != nil {
+			return nil, err
+		}
+		commits = append(commits, page...)
+		if len(page) < pageSize {
+			return commits, nil
+		}
+	}
+}
+
+// computeStats computes the statistics of the commits and branches. Branches are active if their
+// last commit is after since. Contributors are identified by their email address.
+func computeStats(commits []commit, branches []git.GitBranchStats, since time.Time) *repoStats {
+	stats := &repoStats{
+		Since:         since,
+		Commits:       len(commits),
+		ChangeCounts:  map[string]int{},
+		TotalBranches: len(branches),
+	}
+
+	byEmail := map[string]*contributor{}
+	for _, c := range commits {
+		key := strings.ToLower(c.Author.Email)
+		if _, ok := byEmail[key]; !ok {
+			byEmail[key] = &contributor{Name: c.Author.Name, Email: c.Author.Email}
+		}
+		byEmail[key].Commits++
+		for changeType, n := range c.ChangeCounts {
+			stats.ChangeCounts[changeType] += n
+			stats.FilesChanged += n
+		}
+	}
+	stats.Contributors = len(byEmail)
+
+	contributors := lo.Map(lo.Values(byEmail), func(c *contributor, _ int) contributor { return *c })
+	sort.Slice(contributors, func(i, j int) bool {
+		if contributors[i].Commits != contributors[j].Commits {
+			return contributors[i].Commits > contributors[j].Commits
+		}
+		return contributors[i].Name < contributors[j].Name
+	})
+	stats.TopContributors = lo.Subset(contributors, 0, 5)
+
+	stats.ActiveBranches = lo.CountBy(branches, func(b git.GitBranchStats) bool {
+		return b.Commit != nil && b.Commit.Committer != nil && b.Commit.Committer.Date != nil && b.Commit.Committer.Date.Time.After(since)
+	})
+	return stats
+}
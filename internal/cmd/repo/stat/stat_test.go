@@ -0,0 +1,52 @@
+package stat
+
+import (
+	"testing"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComputeStats(t *testing.T) {
+	since := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	newCommit := func(name, email string, changes map[string]int) commit {
+		c := commit{ChangeCounts: changes}
+		c.Author.Name = name
+		c.Author.Email = email
+		return c
+	}
+	commits := []commit{
+		newCommit("Alice", "alice@example.com", map[string]int{"Add": 2, "Edit": 1}),
+		newCommit("Bob", "bob@example.com", map[string]int{"Edit": 3}),
+		newCommit("Alice", "Alice@Example.com", map[string]int{"Delete": 1}),
+	}
+	branch := func(date time.Time) git.GitBranchStats {
+		return git.GitBranchStats{Commit: &git.GitCommitRef{Committer: &git.GitUserDate{Date: &azuredevops.Time{Time: date}}}}
+	}
+	branches := []git.GitBranchStats{
+		branch(since.AddDate(0, 0, 3)),
+		branch(since.AddDate(0, 0, -3)),
+		{},
+	}
+
+	stats := computeStats(commits, branches, since)
+	assert.Equal(t, 3, stats.Commits)
+	assert.Equal(t, 2, stats.Contributors)
+	assert.Equal(t, 7, stats.FilesChanged)
+	assert.Equal(t, map[string]int{"Add": 2, "Edit": 4, "Delete": 1}, stats.ChangeCounts)
+	assert.Equal(t, 1, stats.ActiveBranches)
+	assert.Equal(t, 3, stats.TotalBranches)
+	assert.Equal(t, []contributor{
+		{Name: "Alice", Email: "alice@example.com", Commits: 2},
+		{Name: "Bob", Email: "bob@example.com", Commits: 1},
+	}, stats.TopContributors)
+}
+
+func TestPeriodStart(t *testing.T) {
+	now := time.Date(2024, 3, 31, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2024, 3, 30, 12, 0, 0, 0, time.UTC), periodStart(now, "day"))
+	assert.Equal(t, time.Date(2024, 3, 24, 12, 0, 0, 0, time.UTC), periodStart(now, "week"))
+	assert.Equal(t, time.Date(2023, 3, 31, 12, 0, 0, 0, time.UTC), periodStart(now, "year"))
+}
@@ -0,0 +1,176 @@
+package create
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/webhook/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	scope      string
+	event      string
+	url        string
+	secret     string
+	repository string
+	branch     string
+	format     string
+}
+
+func NewCmdCreate(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create [organization/]project",
+		Short: "Create a web hook for repository events",
+		Long: heredoc.Docf(`
+			Create a service hook subscription which posts repository events of a project to a URL.
+
+			The events are:
+			- push: code pushed to a repository (git.push)
+			- pullRequestCreated: pull request created (git.pullrequest.created)
+			- pullRequestUpdated: pull request updated (git.pullrequest.updated)
+			- pullRequestMerged: pull request merge attempted (git.pullrequest.merged)
+			- pullRequestCommented: comment added to a pull request (ms.vss-code.git-pullrequest-comment-event)
+
+			Without %[1]s--repo%[1]s the events of all repositories of the project are sent. %[1]s--branch%[1]s
+			limits push events to the pushed branch and pull request events to the target branch.
+
+			The value of %[1]s--secret%[1]s is sent as password of HTTP basic authentication, so the
+			receiver can verify that the request comes from Azure DevOps.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# send pushes to the main branch of a repository to a URL
+			azdo repo webhook create myproject --event push --url https://ci.example.com/hook --repo myrepo --branch main
+
+			# send new pull requests of all repositories with a secret
+			azdo repo webhook create myorg/myproject --event pullRequestCreated --url https://bot.example.com/hook --secret s3cr3t
+		`),
+		Args: util.ExactArgs(1, "cannot create web hook: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			u, err := url.Parse(opts.url)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return util.FlagErrorf("invalid value for --url: %q is not an HTTP(S) URL", opts.url)
+			}
+
+			return runCreate(ctx, opts)
+		},
+	}
+
+	util.StringEnumFlag(cmd, &opts.event, "event", "", "", shared.EventNames(), "Repository event to send")
+	cmd.Flags().StringVar(&opts.url, "url", "", "URL to send the events to")
+	cmd.Flags().StringVar(&opts.secret, "secret", "", "Password of HTTP basic authentication sent with the events")
+	cmd.Flags().StringVarP(&opts.repository, "repo", "R", "", "Only send the events of this repository")
+	cmd.Flags().StringVar(&opts.branch, "branch", "", "Only send the events of this branch")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "table", []string{"json"}, "Output format")
+	_ = cmd.MarkFlagRequired("event")
+	_ = cmd.MarkFlagRequired("url")
+
+	return cmd
+}
+
+func runCreate(ctx util.CmdContext, opts *createOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, projectName, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	client := servicehooks.NewClient(rctx, conn)
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	project, err := coreClient.GetProject(rctx, core.GetProjectArgs{
+		ProjectId: &projectName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get project %s: %w", projectName, err)
+	}
+
+	publisherInputs := map[string]string{
+		"projectId": project.Id.String(),
+	}
+	if opts.repository != "" {
+		gitClient, err := git.NewClient(rctx, conn)
+		if err != nil {
+			return err
+		}
+		repo, err := gitClient.GetRepository(rctx, git.GetRepositoryArgs{
+			Project:      &projectName,
+			RepositoryId: &opts.repository,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+		}
+		publisherInputs["repository"] = repo.Id.String()
+	}
+	if opts.branch != "" {
+		publisherInputs["branch"] = util.ShortBranchName(util.NormalizeBranchRef(opts.branch))
+	}
+
+	consumerInputs := map[string]string{
+		"url": opts.url,
+	}
+	if opts.secret != "" {
+		consumerInputs["basicAuthPassword"] = opts.secret
+	}
+
+	hook, err := client.CreateSubscription(rctx, servicehooks.CreateSubscriptionArgs{
+		Subscription: &servicehooks.Subscription{
+			PublisherId:      lo.ToPtr(shared.PublisherID),
+			EventType:        lo.ToPtr(shared.Events[opts.event]),
+			ResourceVersion:  lo.ToPtr("1.0"),
+			PublisherInputs:  &publisherInputs,
+			ConsumerId:       lo.ToPtr(shared.ConsumerID),
+			ConsumerActionId: lo.ToPtr(shared.ConsumerActionID),
+			ConsumerInputs:   &consumerInputs,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create web hook: %w", err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(hook)
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		target := lo.Ternary(opts.repository != "", "repository "+opts.repository, "project "+*project.Name)
+		fmt.Fprintf(iostrms.Out, "%s Created web hook for %s events of %s\n", cs.SuccessIcon(), cs.Bold(opts.event), target)
+	}
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	shared.AddWebhookRows(tp, []servicehooks.Subscription{*hook}, iostrms.IsStdoutTTY(), time.Now())
+	return tp.Render()
+}
@@ -0,0 +1,131 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/webhook/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type listOptions struct {
+	scope      string
+	repository string
+	format     string
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List the web hooks for repository events of a project",
+		Long: heredoc.Doc(`
+			List the service hook subscriptions of a project which send repository events, like
+			pushes and pull request changes, to a URL.
+		`),
+		Example: heredoc.Doc(`
+			# list the web hooks of a project
+			azdo repo webhook list myproject
+
+			# list the web hooks of a repository
+			azdo repo webhook list myorg/myproject --repo myrepo
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list web hooks: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.repository, "repo", "R", "", "Only list the web hooks of this repository")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, projectName, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	client := servicehooks.NewClient(rctx, conn)
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	project, err := coreClient.GetProject(rctx, core.GetProjectArgs{
+		ProjectId: &projectName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get project %s: %w", projectName, err)
+	}
+
+	var repositoryID string
+	if opts.repository != "" {
+		gitClient, err := git.NewClient(rctx, conn)
+		if err != nil {
+			return err
+		}
+		repo, err := gitClient.GetRepository(rctx, git.GetRepositoryArgs{
+			Project:      &projectName,
+			RepositoryId: &opts.repository,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+		}
+		repositoryID = repo.Id.String()
+	}
+
+	res, err := client.ListSubscriptions(rctx, servicehooks.ListSubscriptionsArgs{
+		PublisherId: lo.ToPtr(shared.PublisherID),
+		ConsumerId:  lo.ToPtr(shared.ConsumerID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list service hook subscriptions: %w", err)
+	}
+	hooks := lo.Filter(lo.FromPtr(res), func(s servicehooks.Subscription, _ int) bool {
+		return shared.IsRepositoryWebhook(&s, project.Id.String(), repositoryID)
+	})
+	iostrms.StopProgressIndicator()
+	if len(hooks) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No web hooks found in project %s", *project.Name))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(hooks)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	shared.AddWebhookRows(tp, hooks, iostrms.IsStdoutTTY(), time.Now())
+	return tp.Render()
+}
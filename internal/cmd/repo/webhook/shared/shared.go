@@ -0,0 +1,78 @@
+package shared
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+const (
+	// PublisherID is the ID of the service hook publisher of Azure DevOps events
+	PublisherID = "tfs"
+	// ConsumerID is the ID of the service hook consumer which sends events to a URL
+	ConsumerID = "webHooks"
+	// ConsumerActionID is the ID of the action of the web hook consumer which posts an event
+	ConsumerActionID = "httpRequest"
+)
+
+// Events maps the event names accepted by the webhook commands to the service hook event types.
+var Events = map[string]string{
+	"push":                 "git.push",
+	"pullRequestCreated":   "git.pullrequest.created",
+	"pullRequestUpdated":   "git.pullrequest.updated",
+	"pullRequestMerged":    "git.pullrequest.merged",
+	"pullRequestCommented": "ms.vss-code.git-pullrequest-comment-event",
+}
+
+// EventNames returns the sorted names of the events.
+func EventNames() []string {
+	names := lo.Keys(Events)
+	sort.Strings(names)
+	return names
+}
+
+// IsRepositoryWebhook reports whether the subscription is a web hook for repository events of the project.
+// If repositoryID is not empty, the subscription must be for that repository.
+func IsRepositoryWebhook(s *servicehooks.Subscription, projectID, repositoryID string) bool {
+	if lo.FromPtr(s.ConsumerId) != ConsumerID {
+		return false
+	}
+	if !lo.Contains(lo.Values(Events), lo.FromPtr(s.EventType)) {
+		return false
+	}
+	inputs := lo.FromPtr(s.PublisherInputs)
+	if !strings.EqualFold(inputs["projectId"], projectID) {
+		return false
+	}
+	return repositoryID == "" || strings.EqualFold(inputs["repository"], repositoryID)
+}
+
+// AddWebhookRows adds the web hooks to the printer. Times are printed relative to now if tty is true.
+func AddWebhookRows(tp printer.Printer, subscriptions []servicehooks.Subscription, tty bool, now time.Time) {
+	tp.AddColumns("ID", "Event Type", "URL", "Status", "Modified")
+	for _, s := range subscriptions {
+		tp.AddField(lo.FromPtr(s.Id).String(), printer.WithTruncate(nil))
+		tp.AddField(lo.FromPtr(s.EventType))
+		tp.AddField(lo.FromPtr(s.ConsumerInputs)["url"], printer.WithTruncate(nil))
+		tp.AddField(string(lo.FromPtr(s.Status)))
+		tp.AddField(formatTime(s.ModifiedDate, tty, now))
+		tp.EndRow()
+	}
+}
+
+func formatTime(t *azuredevops.Time, tty bool, now time.Time) string {
+	switch {
+	case t == nil:
+		return ""
+	case tty:
+		return text.FuzzyAgo(now, t.Time)
+	default:
+		return t.Time.Format(time.RFC3339)
+	}
+}
@@ -0,0 +1,36 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/servicehooks"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsRepositoryWebhook(t *testing.T) {
+	hook := func(consumer, eventType string, inputs map[string]string) *servicehooks.Subscription {
+		return &servicehooks.Subscription{
+			ConsumerId:      &consumer,
+			EventType:       &eventType,
+			PublisherInputs: &inputs,
+		}
+	}
+	const project = "0d2c3a5e-2a5f-4d7c-9f0b-6c1f0e3b7a11"
+	const repository = "8e5f4a1c-7b3d-4e2a-a9c6-1d0b2f3e4a55"
+
+	push := hook(ConsumerID, "git.push", map[string]string{"projectId": project, "repository": repository})
+	assert.True(t, IsRepositoryWebhook(push, project, ""))
+	assert.True(t, IsRepositoryWebhook(push, project, repository))
+	assert.False(t, IsRepositoryWebhook(push, project, "4f1e2d3c-0000-0000-0000-000000000000"))
+	assert.False(t, IsRepositoryWebhook(push, "4f1e2d3c-0000-0000-0000-000000000000", ""))
+
+	assert.True(t, IsRepositoryWebhook(hook(ConsumerID, "git.pullrequest.merged", map[string]string{"projectId": project}), project, ""))
+	assert.False(t, IsRepositoryWebhook(hook(ConsumerID, "workitem.created", map[string]string{"projectId": project}), project, ""))
+	assert.False(t, IsRepositoryWebhook(hook("slack", "git.push", map[string]string{"projectId": project}), project, ""))
+}
+
+func TestEventNames(t *testing.T) {
+	assert.Equal(t, []string{"pullRequestCommented", "pullRequestCreated", "pullRequestMerged", "pullRequestUpdated", "push"}, EventNames())
+	assert.Len(t, lo.Uniq(lo.Values(Events)), len(Events))
+}
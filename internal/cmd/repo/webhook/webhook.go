@@ -0,0 +1,19 @@
+package webhook
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/webhook/create"
+	"github.com/tmeckel/azdo-cli/internal/cmd/repo/webhook/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdWebhook(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook <command>",
+		Short: "Manage web hooks for repository events",
+	}
+
+	cmd.AddCommand(create.NewCmdCreate(ctx))
+	cmd.AddCommand(list.NewCmdList(ctx))
+	return cmd
+}
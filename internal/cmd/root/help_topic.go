@@ -54,8 +54,9 @@ var HelpTopics = []helpTopic{
 
 			AZDO_BROWSER, BROWSER (in order of precedence): the web browser to use for opening links.
 
-			AZDO_DEBUG: set to a truthy value to enable verbose output on standard error. Set to "api"
-			to additionally log details of HTTP traffic.
+			AZDO_DEBUG: set to a truthy value to enable verbose output on standard error, including the
+			method, URL, status and duration of HTTP requests to Azure DevOps. Credentials in request
+			headers are redacted. The --debug-http flag has the same effect.
 
 			AZDO_PAGER, PAGER (in order of precedence): a terminal paging program to send standard output
 			to, e.g. "less".
@@ -64,6 +65,7 @@ var HelpTopics = []helpTopic{
 			<https://github.com/charmbracelet/glamour#styles>
 
 			NO_COLOR: set to any value to avoid printing ANSI escape sequences for color output.
+			The "--color" flag takes precedence over this setting.
 
 			CLICOLOR: set to "0" to disable printing ANSI colors in output.
 
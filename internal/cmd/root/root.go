@@ -7,13 +7,23 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/google/shlex"
 	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/artifacts"
 	"github.com/tmeckel/azdo-cli/internal/cmd/auth"
+	"github.com/tmeckel/azdo-cli/internal/cmd/boards"
 	"github.com/tmeckel/azdo-cli/internal/cmd/config"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pipelines"
+	"github.com/tmeckel/azdo-cli/internal/cmd/pr"
 	"github.com/tmeckel/azdo-cli/internal/cmd/project"
 	"github.com/tmeckel/azdo-cli/internal/cmd/repo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/security"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint"
+	"github.com/tmeckel/azdo-cli/internal/cmd/test"
 	"github.com/tmeckel/azdo-cli/internal/cmd/util"
 	versionCmd "github.com/tmeckel/azdo-cli/internal/cmd/version"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki"
+	"github.com/tmeckel/azdo-cli/internal/json"
 	"github.com/tmeckel/azdo-cli/internal/validation"
+	"go.uber.org/zap"
 )
 
 type AuthError struct {
@@ -46,6 +56,34 @@ func NewCmdRoot(ctx util.CmdContext, version, buildDate string) (*cobra.Command,
 			"versionInfo": versionCmd.Format(version, buildDate),
 		},
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if debugHTTP, _ := cmd.Flags().GetBool("debug-http"); debugHTTP {
+				// same as AZDO_DEBUG, which is evaluated before the flags are parsed
+				zap.ReplaceGlobals(zap.Must(zap.NewDevelopment()))
+				util.EnableHTTPDebugLogging()
+			}
+			if noProgress, _ := cmd.Flags().GetBool("no-progress"); noProgress {
+				iostrms.DisableProgress()
+			}
+			colorMode, _ := cmd.Flags().GetString("color")
+			switch colorMode {
+			case "always":
+				iostrms.SetColorEnabled(true)
+			case "never":
+				iostrms.SetColorEnabled(false)
+			case "auto":
+			default:
+				return util.FlagErrorf("invalid value for --color: %q; valid values are {always|never|auto}", colorMode)
+			}
+			if expr, _ := cmd.Flags().GetString("json-path"); expr != "" {
+				if err := checkJSONOutput(cmd); err != nil {
+					return err
+				}
+				path, err := json.ParsePath(expr)
+				if err != nil {
+					return util.FlagErrorWrap(err)
+				}
+				iostrms.StartOutputFilter(path.Filter)
+			}
 			// require that the user is authenticated before running most commands
 			if util.IsAuthCheckEnabled(cmd) && !util.CheckAuth(cfg) {
 				return &AuthError{}
@@ -55,6 +93,13 @@ func NewCmdRoot(ctx util.CmdContext, version, buildDate string) (*cobra.Command,
 	}
 
 	cmd.PersistentFlags().Bool("help", false, "Show help for command")
+	cmd.PersistentFlags().Bool("no-progress", false, "Do not show progress indicators")
+	cmd.PersistentFlags().String("color", "auto", "Use color in output: {always|never|auto}")
+	cmd.PersistentFlags().Bool("debug-http", false, "Log HTTP requests to Azure DevOps; same as setting AZDO_DEBUG=1")
+	cmd.PersistentFlags().String("json-path", "", "Filter JSON output by a path like fields.System.Title or value[0].name")
+	_ = cmd.RegisterFlagCompletionFunc("color", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"always", "never", "auto"}, cobra.ShellCompDirectiveNoFileComp
+	})
 
 	cmd.SilenceErrors = true
 	cmd.SilenceUsage = true
@@ -74,10 +119,18 @@ func NewCmdRoot(ctx util.CmdContext, version, buildDate string) (*cobra.Command,
 	})
 
 	cmd.AddCommand(versionCmd.NewCmdVersion(ctx, version, buildDate))
+	cmd.AddCommand(artifacts.NewCmdArtifacts(ctx))
 	cmd.AddCommand(auth.NewCmdAuth(ctx))
+	cmd.AddCommand(boards.NewCmdBoards(ctx))
 	cmd.AddCommand(config.NewCmdConfig(ctx))
+	cmd.AddCommand(pipelines.NewCmdPipelines(ctx))
+	cmd.AddCommand(pr.NewCmdPR(ctx))
 	cmd.AddCommand(project.NewCmdProject(ctx))
 	cmd.AddCommand(repo.NewCmdRepo(ctx))
+	cmd.AddCommand(security.NewCmdSecurity(ctx))
+	cmd.AddCommand(serviceendpoint.NewCmdServiceEndpoint(ctx))
+	cmd.AddCommand(test.NewCmdTest(ctx))
+	cmd.AddCommand(wiki.NewCmdWiki(ctx))
 
 	// Help topics
 	var referenceCmd *cobra.Command
@@ -143,3 +196,17 @@ func NewCmdRoot(ctx util.CmdContext, version, buildDate string) (*cobra.Command,
 
 	return cmd, nil
 }
+
+// checkJSONOutput returns an error unless the command writes JSON, which commands do when their
+// --format flag is json, or raw for pr view. The output filter of --json-path fails on other output.
+func checkJSONOutput(cmd *cobra.Command) error {
+	f := cmd.Flags().Lookup("format")
+	if f == nil {
+		return util.FlagErrorf("--json-path is not supported by %s because it does not print JSON", cmd.CommandPath())
+	}
+	switch f.Value.String() {
+	case "json", "raw":
+		return nil
+	}
+	return util.FlagErrorf("--json-path requires JSON output; use --format json")
+}
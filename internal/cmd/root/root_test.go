@@ -0,0 +1,60 @@
+package root
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func TestCheckJSONOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		formats []string
+		format  string
+		args    []string
+		wantErr string
+	}{
+		{
+			name:    "no format flag",
+			wantErr: "--json-path is not supported by test because it does not print JSON",
+		},
+		{
+			name:    "table format",
+			formats: util.OutputFormats,
+			format:  "table",
+			wantErr: "--json-path requires JSON output; use --format json",
+		},
+		{
+			name:    "json format",
+			formats: util.OutputFormats,
+			format:  "table",
+			args:    []string{"--format", "json"},
+		},
+		{
+			name:    "raw format",
+			formats: []string{"full", "raw"},
+			format:  "full",
+			args:    []string{"--format", "raw"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Use: "test"}
+			if tt.formats != nil {
+				var format string
+				util.StringEnumFlag(cmd, &format, "format", "", tt.format, tt.formats, "Output format")
+			}
+			assert.NoError(t, cmd.ParseFlags(tt.args))
+
+			err := checkJSONOutput(cmd)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/security/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+// identityBatchSize is the number of descriptors resolved with a single request
+const identityBatchSize = 50
+
+type listOptions struct {
+	organizationName string
+	namespace        string
+	token            string
+	subject          string
+	recurse          bool
+	showInherited    bool
+	format           string
+}
+
+// permission are the permissions of a subject on a token.
+type permission struct {
+	Token          string   `json:"token"`
+	Descriptor     string   `json:"descriptor"`
+	Subject        string   `json:"subject"`
+	Allow          []string `json:"allow"`
+	Deny           []string `json:"deny"`
+	InheritedAllow []string `json:"inheritedAllow,omitempty"`
+	InheritedDeny  []string `json:"inheritedDeny,omitempty"`
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the permissions in a security namespace",
+		Long: heredoc.Doc(`
+			List the permissions which are explicitly allowed or denied for users and groups on a
+			token of a security namespace. The namespace is given by its name or ID.
+
+			With --show-inherited the permissions which are effective for a subject without being set
+			explicitly, e.g. because they are inherited from a parent token or a group, are listed
+			as well.
+		`),
+		Example: heredoc.Doc(`
+			# list the permissions on a repository
+			azdo security permission list --namespace "Git Repositories" --token repoV2/6f1c3e2a-2b61-4a4d-9f60-2c4d1e1a7b3c/0b7d6f4e-83f8-4e0a-a3d2-5c0e6b2a9e11
+
+			# show the explicit and inherited permissions of a user on all repositories of a project
+			azdo security permission list --namespace "Git Repositories" --token repoV2/6f1c3e2a-2b61-4a4d-9f60-2c4d1e1a7b3c --recurse --subject jdoe@example.com --show-inherited
+		`),
+		Aliases: []string{"ls"},
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.organizationName, "organization", "o", "", "Organization of the security namespace")
+	cmd.Flags().StringVar(&opts.namespace, "namespace", "", "Name or ID of the security namespace")
+	cmd.Flags().StringVar(&opts.token, "token", "", "Security token to list the permissions of (default: all tokens)")
+	cmd.Flags().StringVar(&opts.subject, "subject", "", "Only list the permissions of the user or group with this email address, account name or descriptor")
+	cmd.Flags().BoolVar(&opts.recurse, "recurse", false, "Include the tokens below --token in hierarchical namespaces")
+	cmd.Flags().BoolVar(&opts.showInherited, "show-inherited", false, "Add the inherited permissions")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+	_ = cmd.MarkFlagRequired("namespace")
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, err := util.ParseOrganizationArg(ctx, opts.organizationName)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client := security.NewClient(rctx, conn)
+	identityClient, err := identity.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	ns, err := shared.FindNamespace(rctx, client, opts.namespace)
+	if err != nil {
+		return
+	}
+
+	args := security.QueryAccessControlListsArgs{
+		SecurityNamespaceId: ns.NamespaceId,
+		IncludeExtendedInfo: &opts.showInherited,
+		Recurse:             &opts.recurse,
+	}
+	if opts.token != "" {
+		args.Token = &opts.token
+	}
+	switch {
+	case strings.Contains(opts.subject, ";"):
+		// identity descriptors like Microsoft.TeamFoundation.Identity;S-1-9-..., which are used by
+		// access control entries, are passed as they are
+		args.Descriptors = &opts.subject
+	case opts.subject != "":
+		id, err := util.ResolveIdentity(rctx, identityClient, opts.subject)
+		if err != nil {
+			return err
+		}
+		args.Descriptors = id.Descriptor
+	}
+	acls, err := client.QueryAccessControlLists(rctx, args)
+	if err != nil {
+		return fmt.Errorf("failed to get access control lists of namespace %s: %w", lo.FromPtr(ns.Name), err)
+	}
+
+	permissions := permissions(ns, lo.FromPtr(acls), opts.showInherited)
+	if len(permissions) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No permissions found in namespace %s", lo.FromPtr(ns.Name)))
+	}
+	names, err := subjectNames(rctx, identityClient, lo.Uniq(lo.Map(permissions, func(p permission, _ int) string { return p.Descriptor })))
+	if err != nil {
+		return
+	}
+	for i := range permissions {
+		permissions[i].Subject = lo.ValueOr(names, permissions[i].Descriptor, permissions[i].Descriptor)
+	}
+	iostrms.StopProgressIndicator()
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(permissions)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	columns := []string{"Token", "Subject", "Allow", "Deny"}
+	if opts.showInherited {
+		columns = append(columns, "Inherited Allow", "Inherited Deny")
+	}
+	tp.AddColumns(columns...)
+	for _, p := range permissions {
+		tp.AddField(p.Token)
+		tp.AddField(p.Subject)
+		tp.AddField(strings.Join(p.Allow, ", "))
+		tp.AddField(strings.Join(p.Deny, ", "))
+		if opts.showInherited {
+			tp.AddField(strings.Join(p.InheritedAllow, ", "))
+			tp.AddField(strings.Join(p.InheritedDeny, ", "))
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// permissions returns the permissions of the access control entries sorted by token. The inherited
+// permissions are the effective permissions which are not set explicitly.
+func permissions(ns *security.SecurityNamespaceDescription, acls []security.AccessControlList, withInherited bool) []permission {
+	var result []permission
+	for _, acl := range acls {
+		for descriptor, ace := range lo.FromPtr(acl.AcesDictionary) {
+			allow := lo.FromPtr(ace.Allow)
+			deny := lo.FromPtr(ace.Deny)
+			p := permission{
+				Token:      lo.FromPtr(acl.Token),
+				Descriptor: lo.Ternary(ace.Descriptor != nil, lo.FromPtr(ace.Descriptor), descriptor),
+				Allow:      shared.ActionNames(ns, allow),
+				Deny:       shared.ActionNames(ns, deny),
+			}
+			if withInherited && ace.ExtendedInfo != nil {
+				p.InheritedAllow = shared.ActionNames(ns, lo.FromPtr(ace.ExtendedInfo.EffectiveAllow)&^allow)
+				p.InheritedDeny = shared.ActionNames(ns, lo.FromPtr(ace.ExtendedInfo.EffectiveDeny)&^deny)
+			}
+			if len(p.Allow)+len(p.Deny)+len(p.InheritedAllow)+len(p.InheritedDeny) == 0 {
+				continue
+			}
+			result = append(result, p)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Token != result[j].Token {
+			return result[i].Token < result[j].Token
+		}
+		return result[i].Descriptor < result[j].Descriptor
+	})
+	return result
+}
+
+// subjectNames returns the display names of the identities with the given descriptors.
+func subjectNames(ctx context.Context, client identity.Client, descriptors []string) (map[string]string, error) {
+	names := map[string]string{}
+	for _, batch := range lo.Chunk(descriptors, identityBatchSize) {
+		res, err := client.ReadIdentities(ctx, identity.ReadIdentitiesArgs{
+			Descriptors: lo.ToPtr(strings.Join(batch, ",")),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve subjects: %w", err)
+		}
+		for _, id := range lo.FromPtr(res) {
+			// identities which no longer exist are returned without descriptor
+			if id.Descriptor == nil {
+				continue
+			}
+			names[*id.Descriptor], _ = lo.Coalesce(lo.FromPtr(id.CustomDisplayName), lo.FromPtr(id.ProviderDisplayName))
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,58 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissions(t *testing.T) {
+	ns := &security.SecurityNamespaceDescription{
+		Actions: &[]security.ActionDefinition{
+			{Bit: lo.ToPtr(1), Name: lo.ToPtr("Administer")},
+			{Bit: lo.ToPtr(2), Name: lo.ToPtr("GenericRead")},
+			{Bit: lo.ToPtr(4), Name: lo.ToPtr("GenericContribute")},
+		},
+	}
+	acls := []security.AccessControlList{
+		{
+			Token: lo.ToPtr("repoV2/b"),
+			AcesDictionary: &map[string]security.AccessControlEntry{
+				"group": {
+					Descriptor:   lo.ToPtr("group"),
+					Allow:        lo.ToPtr(2),
+					ExtendedInfo: &security.AceExtendedInformation{EffectiveAllow: lo.ToPtr(6), EffectiveDeny: lo.ToPtr(1)},
+				},
+				"empty": {Descriptor: lo.ToPtr("empty")},
+			},
+		},
+		{
+			Token: lo.ToPtr("repoV2/a"),
+			AcesDictionary: &map[string]security.AccessControlEntry{
+				"user": {Deny: lo.ToPtr(4)},
+			},
+		},
+	}
+
+	t.Run("explicit", func(t *testing.T) {
+		assert.Equal(t, []permission{
+			{Token: "repoV2/a", Descriptor: "user", Deny: []string{"GenericContribute"}},
+			{Token: "repoV2/b", Descriptor: "group", Allow: []string{"GenericRead"}},
+		}, permissions(ns, acls, false))
+	})
+
+	t.Run("inherited", func(t *testing.T) {
+		assert.Equal(t, []permission{
+			{Token: "repoV2/a", Descriptor: "user", Deny: []string{"GenericContribute"}},
+			{
+				Token:          "repoV2/b",
+				Descriptor:     "group",
+				Allow:          []string{"GenericRead"},
+				InheritedAllow: []string{"GenericContribute"},
+				InheritedDeny:  []string{"Administer"},
+			},
+		}, permissions(ns, acls, true))
+	})
+}
@@ -0,0 +1,17 @@
+package permission
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/security/permission/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdPermission(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "permission <command>",
+		Short: "Inspect the permissions of users and groups",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	return cmd
+}
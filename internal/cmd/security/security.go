@@ -0,0 +1,26 @@
+package security
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/security/permission"
+	"github.com/tmeckel/azdo-cli/internal/cmd/security/token"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdSecurity(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "security <command>",
+		Short: "Work with security namespaces and permissions",
+		Long:  `Work with the access control lists of Azure DevOps security namespaces.`,
+		Example: heredoc.Doc(`
+			$ azdo security token generate myproject --namespace git --repo myrepo
+			$ azdo security permission list --namespace "Git Repositories" --token repoV2/6f1c3e2a-2b61-4a4d-9f60-2c4d1e1a7b3c
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(permission.NewCmdPermission(ctx))
+	cmd.AddCommand(token.NewCmdToken(ctx))
+	return cmd
+}
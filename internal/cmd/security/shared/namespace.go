@@ -0,0 +1,53 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/samber/lo"
+)
+
+// FindNamespace returns the security namespace with the given ID, name or display name, e.g.
+// "Git Repositories".
+func FindNamespace(ctx context.Context, client security.Client, namespace string) (*security.SecurityNamespaceDescription, error) {
+	res, err := client.QuerySecurityNamespaces(ctx, security.QuerySecurityNamespacesArgs{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get security namespaces: %w", err)
+	}
+	id, idErr := uuid.Parse(namespace)
+	ns, ok := lo.Find(lo.FromPtr(res), func(ns security.SecurityNamespaceDescription) bool {
+		if idErr == nil {
+			return lo.FromPtr(ns.NamespaceId) == id
+		}
+		return strings.EqualFold(lo.FromPtr(ns.Name), namespace) || strings.EqualFold(lo.FromPtr(ns.DisplayName), namespace)
+	})
+	if !ok {
+		return nil, fmt.Errorf("security namespace %s not found", namespace)
+	}
+	return &ns, nil
+}
+
+// ActionNames returns the names of the actions of the namespace whose bits are set in permissions,
+// ordered by bit. Bits without an action are returned as hexadecimal numbers.
+func ActionNames(ns *security.SecurityNamespaceDescription, permissions int) []string {
+	actions := append([]security.ActionDefinition{}, lo.FromPtr(ns.Actions)...)
+	sort.Slice(actions, func(i, j int) bool { return lo.FromPtr(actions[i].Bit) < lo.FromPtr(actions[j].Bit) })
+
+	var names []string
+	for _, a := range actions {
+		bit := lo.FromPtr(a.Bit)
+		if bit == 0 || permissions&bit != bit {
+			continue
+		}
+		names = append(names, lo.FromPtr(a.Name))
+		permissions &^= bit
+	}
+	if permissions != 0 {
+		names = append(names, fmt.Sprintf("0x%x", permissions))
+	}
+	return names
+}
@@ -0,0 +1,23 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/security"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActionNames(t *testing.T) {
+	ns := &security.SecurityNamespaceDescription{
+		Actions: &[]security.ActionDefinition{
+			{Bit: lo.ToPtr(4), Name: lo.ToPtr("GenericContribute")},
+			{Bit: lo.ToPtr(1), Name: lo.ToPtr("Administer")},
+			{Bit: lo.ToPtr(2), Name: lo.ToPtr("GenericRead")},
+		},
+	}
+
+	assert.Nil(t, ActionNames(ns, 0))
+	assert.Equal(t, []string{"Administer", "GenericContribute"}, ActionNames(ns, 5))
+	assert.Equal(t, []string{"GenericRead", "0x18"}, ActionNames(ns, 0x1a))
+}
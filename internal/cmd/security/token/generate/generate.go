@@ -0,0 +1,198 @@
+package generate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/release"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+const (
+	namespaceGit     = "git"
+	namespaceProject = "project"
+	namespaceBuild   = "build"
+	namespaceRelease = "release"
+)
+
+type generateOptions struct {
+	scope      string
+	namespace  string
+	repository string
+	pipelineID int
+}
+
+func NewCmdGenerate(ctx util.CmdContext) *cobra.Command {
+	opts := &generateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "generate [organization/]project",
+		Short: "Generate the security token of a project, repository or pipeline",
+		Long: heredoc.Doc(`
+			Print the token which identifies a project, repository or pipeline in its security
+			namespace, e.g. to pass it to "azdo security permission list --token".
+
+			The namespaces and their tokens are:
+
+			  git      Git Repositories: repoV2/<project id>[/<repository id>]
+			  project  Project: $PROJECT:vstfs:///Classification/TeamProject/<project id>
+			  build    Build: <project id>[/<folder>/<definition id>]
+			  release  ReleaseManagement: <project id>[/<folder>/<definition id>]
+
+			Without --repo or --pipeline-id the token of the whole project in the namespace is printed.
+		`),
+		Example: heredoc.Doc(`
+			# generate the token of a repository
+			azdo security token generate myproject --namespace git --repo myrepo
+
+			# list the permissions on a build pipeline
+			azdo security permission list --namespace Build --token "$(azdo security token generate myorg/myproject --namespace build --pipeline-id 42)"
+		`),
+		Args: util.ExactArgs(1, "cannot generate token: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			if opts.repository != "" && opts.namespace != namespaceGit {
+				return util.FlagErrorf("--repo is only valid with --namespace %s", namespaceGit)
+			}
+			if cmd.Flags().Changed("pipeline-id") && opts.namespace != namespaceBuild && opts.namespace != namespaceRelease {
+				return util.FlagErrorf("--pipeline-id is only valid with --namespace %s or %s", namespaceBuild, namespaceRelease)
+			}
+
+			return runGenerate(ctx, opts)
+		},
+	}
+
+	util.StringEnumFlag(cmd, &opts.namespace, "namespace", "", "", []string{namespaceGit, namespaceProject, namespaceBuild, namespaceRelease}, "Security namespace of the token")
+	cmd.Flags().StringVar(&opts.repository, "repo", "", "Name or ID of the repository")
+	cmd.Flags().IntVar(&opts.pipelineID, "pipeline-id", 0, "ID of the build or release pipeline")
+	_ = cmd.MarkFlagRequired("namespace")
+
+	return cmd
+}
+
+func runGenerate(ctx util.CmdContext, opts *generateOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	p, err := coreClient.GetProject(rctx, core.GetProjectArgs{
+		ProjectId: &project,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get project %s: %w", project, err)
+	}
+	projectID := p.Id.String()
+
+	var token string
+	switch opts.namespace {
+	case namespaceGit:
+		token = gitToken(projectID, "")
+		if opts.repository != "" {
+			client, err := git.NewClient(rctx, conn)
+			if err != nil {
+				return err
+			}
+			repo, err := client.GetRepository(rctx, git.GetRepositoryArgs{
+				Project:      &projectID,
+				RepositoryId: &opts.repository,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get repository %s: %w", opts.repository, err)
+			}
+			token = gitToken(projectID, repo.Id.String())
+		}
+	case namespaceProject:
+		token = projectToken(projectID)
+	case namespaceBuild:
+		token = projectID
+		if opts.pipelineID != 0 {
+			client, err := build.NewClient(rctx, conn)
+			if err != nil {
+				return err
+			}
+			def, err := client.GetDefinition(rctx, build.GetDefinitionArgs{
+				Project:      &projectID,
+				DefinitionId: &opts.pipelineID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get pipeline %d: %w", opts.pipelineID, err)
+			}
+			token = definitionToken(projectID, lo.FromPtr(def.Path), opts.pipelineID)
+		}
+	case namespaceRelease:
+		token = projectID
+		if opts.pipelineID != 0 {
+			client, err := release.NewClient(rctx, conn)
+			if err != nil {
+				return err
+			}
+			def, err := client.GetReleaseDefinition(rctx, release.GetReleaseDefinitionArgs{
+				Project:      &projectID,
+				DefinitionId: &opts.pipelineID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get release pipeline %d: %w", opts.pipelineID, err)
+			}
+			token = definitionToken(projectID, lo.FromPtr(def.Path), opts.pipelineID)
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	fmt.Fprintln(iostrms.Out, token)
+	return
+}
+
+// gitToken returns the token of a project or, if repositoryID is not empty, of a repository in the
+// Git Repositories namespace.
+func gitToken(projectID, repositoryID string) string {
+	if repositoryID == "" {
+		return "repoV2/" + projectID
+	}
+	return fmt.Sprintf("repoV2/%s/%s", projectID, repositoryID)
+}
+
+// projectToken returns the token of a project in the Project namespace.
+func projectToken(projectID string) string {
+	return "$PROJECT:vstfs:///Classification/TeamProject/" + projectID
+}
+
+// definitionToken returns the token of a build or release definition, which includes the folder
+// of the definition. Folders are given as \folder\subfolder and are separated by / in the token.
+func definitionToken(projectID, path string, definitionID int) string {
+	parts := []string{projectID}
+	for _, folder := range strings.Split(path, `\`) {
+		if folder != "" {
+			parts = append(parts, folder)
+		}
+	}
+	parts = append(parts, fmt.Sprint(definitionID))
+	return strings.Join(parts, "/")
+}
@@ -0,0 +1,36 @@
+package generate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const projectID = "6f1c3e2a-2b61-4a4d-9f60-2c4d1e1a7b3c"
+
+func TestGitToken(t *testing.T) {
+	assert.Equal(t, "repoV2/"+projectID, gitToken(projectID, ""))
+	assert.Equal(t, "repoV2/"+projectID+"/0b7d6f4e-83f8-4e0a-a3d2-5c0e6b2a9e11", gitToken(projectID, "0b7d6f4e-83f8-4e0a-a3d2-5c0e6b2a9e11"))
+}
+
+func TestProjectToken(t *testing.T) {
+	assert.Equal(t, "$PROJECT:vstfs:///Classification/TeamProject/"+projectID, projectToken(projectID))
+}
+
+func TestDefinitionToken(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{"root", `\`, projectID + "/42"},
+		{"no path", "", projectID + "/42"},
+		{"folder", `\CI\Nightly`, projectID + "/CI/Nightly/42"},
+		{"trailing separator", `\CI\`, projectID + "/CI/42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, definitionToken(projectID, tt.path, 42))
+		})
+	}
+}
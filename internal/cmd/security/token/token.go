@@ -0,0 +1,17 @@
+package token
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/security/token/generate"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdToken(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token <command>",
+		Short: "Work with security tokens",
+	}
+
+	cmd.AddCommand(generate.NewCmdGenerate(ctx))
+	return cmd
+}
@@ -0,0 +1,88 @@
+package azurerm
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	shared.CreateOptions
+	tenantID            string
+	servicePrincipalID  string
+	servicePrincipalKey string
+	subscriptionID      string
+	subscriptionName    string
+	environment         string
+}
+
+func NewCmdCreateAzureRM(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "azurerm [organization/]project",
+		Short: "Create an Azure Resource Manager service endpoint",
+		Long: heredoc.Doc(`
+			Create an Azure Resource Manager service endpoint which authenticates with a service principal
+			and is scoped to a subscription.
+
+			If the service principal key is not passed with --service-principal-key, it will be prompted for.
+		`),
+		Example: heredoc.Doc(`
+			# create an Azure Resource Manager service endpoint usable by all pipelines
+			azdo service-endpoint create azurerm myproject --name azure \
+				--tenant-id 00000000-0000-0000-0000-000000000000 \
+				--service-principal-id 00000000-0000-0000-0000-000000000000 \
+				--subscription-id 00000000-0000-0000-0000-000000000000 \
+				--subscription-name "My Subscription" \
+				--grant-permission-to-all-pipelines
+		`),
+		Args: util.ExactArgs(1, "cannot create service endpoint: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Scope = args[0]
+
+			key, err := shared.ReadSecret(ctx, opts.servicePrincipalKey, "service-principal-key", "Service principal key:")
+			if err != nil {
+				return err
+			}
+
+			return shared.CreateServiceEndpoint(ctx, &opts.CreateOptions, &serviceendpoint.ServiceEndpoint{
+				Type: lo.ToPtr("azurerm"),
+				Url:  lo.ToPtr("https://management.azure.com/"),
+				Authorization: &serviceendpoint.EndpointAuthorization{
+					Scheme: lo.ToPtr("ServicePrincipal"),
+					Parameters: &map[string]string{
+						"tenantid":            opts.tenantID,
+						"serviceprincipalid":  opts.servicePrincipalID,
+						"authenticationType":  "spnKey",
+						"serviceprincipalkey": key,
+					},
+				},
+				Data: &map[string]string{
+					"subscriptionId":   opts.subscriptionID,
+					"subscriptionName": opts.subscriptionName,
+					"environment":      opts.environment,
+					"scopeLevel":       "Subscription",
+					"creationMode":     "Manual",
+				},
+			})
+		},
+	}
+
+	shared.AddCreateFlags(cmd, &opts.CreateOptions)
+	cmd.Flags().StringVar(&opts.tenantID, "tenant-id", "", "ID of the Azure AD tenant of the service principal")
+	cmd.Flags().StringVar(&opts.servicePrincipalID, "service-principal-id", "", "Application ID of the service principal")
+	cmd.Flags().StringVar(&opts.servicePrincipalKey, "service-principal-key", "", "Secret of the service principal")
+	cmd.Flags().StringVar(&opts.subscriptionID, "subscription-id", "", "ID of the Azure subscription")
+	cmd.Flags().StringVar(&opts.subscriptionName, "subscription-name", "", "Name of the Azure subscription")
+	cmd.Flags().StringVar(&opts.environment, "environment", "AzureCloud", "Azure cloud environment")
+	_ = cmd.MarkFlagRequired("tenant-id")
+	_ = cmd.MarkFlagRequired("service-principal-id")
+	_ = cmd.MarkFlagRequired("subscription-id")
+	_ = cmd.MarkFlagRequired("subscription-name")
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package create
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/create/azurerm"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/create/docker"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/create/generic"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/create/github"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/create/kubernetes"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/create/ssh"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdCreate(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create <type>",
+		Short: "Create a service endpoint",
+	}
+
+	cmd.AddCommand(azurerm.NewCmdCreateAzureRM(ctx))
+	cmd.AddCommand(docker.NewCmdCreateDocker(ctx))
+	cmd.AddCommand(generic.NewCmdCreateGeneric(ctx))
+	cmd.AddCommand(github.NewCmdCreateGitHub(ctx))
+	cmd.AddCommand(kubernetes.NewCmdCreateKubernetes(ctx))
+	cmd.AddCommand(ssh.NewCmdCreateSSH(ctx))
+	return cmd
+}
@@ -0,0 +1,196 @@
+package create
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinepermissions"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+const (
+	testProjectID  = "0f6b7c7e-44f2-4c37-9a3c-6a1f7a0b5e21"
+	testEndpointID = "8b8fb8cd-07f4-4e3b-a1ea-07bd1c4f7f0e"
+)
+
+// apiLocations are the resource locations the create commands resolve through the OPTIONS request of the SDK.
+var apiLocations = []azuredevops.ApiResourceLocation{
+	apiLocation("e81700f7-3be2-46de-8624-2eb35882fcaa", "Location", "ResourceAreas", "_apis/{resource}/{areaId}"),
+	apiLocation("603fe2ac-9723-48b9-88ad-09305aa6c6e1", "core", "projects", "_apis/projects/{projectId}"),
+	apiLocation("14e48fdc-2c8b-41ce-a0c3-e26f6cc55bd0", "serviceendpoint", "endpoints", "{project}/_apis/serviceendpoint/endpoints/{endpointId}"),
+	apiLocation("b5b9a4a4-e6cd-4096-853c-ab7d8b0c4eb2", "pipelinePermissions", "pipelinePermissions", "{project}/_apis/pipelines/pipelinePermissions/{resourceType}/{resourceId}"),
+}
+
+func apiLocation(id, area, resourceName, routeTemplate string) azuredevops.ApiResourceLocation {
+	var location azuredevops.ApiResourceLocation
+	_ = json.Unmarshal([]byte(fmt.Sprintf(`{"id":%q,"area":%q,"resourceName":%q,"routeTemplate":%q,"resourceVersion":1,"minVersion":"1.0","maxVersion":"7.1","releasedVersion":"7.0"}`,
+		id, area, resourceName, routeTemplate)), &location)
+	return location
+}
+
+// fakeServer records the requests the create commands send to Azure DevOps.
+type fakeServer struct {
+	mu          sync.Mutex
+	endpoint    *serviceendpoint.ServiceEndpoint
+	permissions []string
+	permission  *pipelinepermissions.ResourcePipelinePermissions
+}
+
+func (s *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	writeCollection := func(v any) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"count": 0, "value": v})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodOptions && r.URL.Path == "/_apis":
+		writeCollection(apiLocations)
+	case r.Method == http.MethodGet && r.URL.Path == "/_apis/ResourceAreas":
+		writeCollection([]any{})
+	case r.Method == http.MethodGet && r.URL.Path == "/_apis/projects/myproject":
+		_ = json.NewEncoder(w).Encode(map[string]string{"id": testProjectID, "name": "myproject"})
+	case r.Method == http.MethodPost && r.URL.Path == "/_apis/serviceendpoint/endpoints":
+		var endpoint serviceendpoint.ServiceEndpoint
+		if err := json.NewDecoder(r.Body).Decode(&endpoint); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.endpoint = &endpoint
+		response := map[string]any{"id": testEndpointID, "name": endpoint.Name, "type": endpoint.Type, "url": endpoint.Url}
+		_ = json.NewEncoder(w).Encode(response)
+	case r.Method == http.MethodPatch && strings.Contains(r.URL.Path, "/_apis/pipelines/pipelinePermissions/"):
+		var permission pipelinepermissions.ResourcePipelinePermissions
+		if err := json.NewDecoder(r.Body).Decode(&permission); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.permissions = append(s.permissions, r.URL.Path)
+		s.permission = &permission
+		_ = json.NewEncoder(w).Encode(permission)
+	default:
+		http.Error(w, fmt.Sprintf("unexpected request %s %s", r.Method, r.URL.Path), http.StatusNotFound)
+	}
+}
+
+type fakeCmdContext struct {
+	util.CmdContext
+	ios  *iostreams.IOStreams
+	conn *azuredevops.Connection
+}
+
+func (c *fakeCmdContext) Context() (context.Context, error) {
+	return context.Background(), nil
+}
+
+func (c *fakeCmdContext) IOStreams() (*iostreams.IOStreams, error) {
+	return c.ios, nil
+}
+
+func (c *fakeCmdContext) Connection(string) (*azuredevops.Connection, error) {
+	return c.conn, nil
+}
+
+func (c *fakeCmdContext) Printer(format string) (printer.Printer, error) {
+	return util.NewPrinter(c.ios, format)
+}
+
+func TestCreateGrantPermissionToAllPipelines(t *testing.T) {
+	kubeconfig := filepath.Join(t.TempDir(), "config")
+	require.NoError(t, os.WriteFile(kubeconfig, []byte("apiVersion: v1\nkind: Config\n"), 0o600))
+
+	tests := []struct {
+		name         string
+		args         []string
+		endpointType string
+	}{
+		{
+			name:         "azurerm",
+			args:         []string{"azurerm", "--tenant-id", "tenant", "--service-principal-id", "sp", "--service-principal-key", "key", "--subscription-id", "sub", "--subscription-name", "subscription"},
+			endpointType: "azurerm",
+		},
+		{
+			name:         "docker",
+			args:         []string{"docker", "--username", "user", "--password", "secret"},
+			endpointType: "dockerregistry",
+		},
+		{
+			name:         "generic",
+			args:         []string{"generic", "--url", "https://example.com", "--username", "user", "--password", "secret"},
+			endpointType: "generic",
+		},
+		{
+			name:         "github",
+			args:         []string{"github", "--token", "token"},
+			endpointType: "github",
+		},
+		{
+			name:         "kubernetes",
+			args:         []string{"kubernetes", "--url", "https://aks.example.com", "--kubeconfig", kubeconfig},
+			endpointType: "kubernetes",
+		},
+		{
+			name:         "ssh",
+			args:         []string{"ssh", "--host", "example.com", "--username", "user", "--password", "secret"},
+			endpointType: "ssh",
+		},
+	}
+
+	for _, tt := range tests {
+		for _, grant := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s grant=%t", tt.name, grant), func(t *testing.T) {
+				fake := &fakeServer{}
+				server := httptest.NewServer(fake)
+				defer server.Close()
+
+				ios, _, _, _ := iostreams.Test()
+				ctx := &fakeCmdContext{
+					ios:  ios,
+					conn: azuredevops.NewPatConnection(server.URL, "pat"),
+				}
+
+				args := append([]string{}, tt.args...)
+				args = append(args, "myorg/myproject", "--name", "endpoint")
+				if grant {
+					args = append(args, "--grant-permission-to-all-pipelines")
+				}
+
+				cmd := NewCmdCreate(ctx)
+				cmd.SetArgs(args)
+				cmd.SetOut(ios.Out)
+				cmd.SetErr(ios.ErrOut)
+				require.NoError(t, cmd.Execute())
+
+				require.NotNil(t, fake.endpoint)
+				assert.Equal(t, tt.endpointType, *fake.endpoint.Type)
+				require.NotNil(t, fake.endpoint.ServiceEndpointProjectReferences)
+				require.Len(t, *fake.endpoint.ServiceEndpointProjectReferences, 1)
+				assert.Equal(t, testProjectID, (*fake.endpoint.ServiceEndpointProjectReferences)[0].ProjectReference.Id.String())
+
+				if !grant {
+					assert.Empty(t, fake.permissions)
+					return
+				}
+				require.Equal(t, []string{fmt.Sprintf("/%s/_apis/pipelines/pipelinePermissions/endpoint/%s", testProjectID, testEndpointID)}, fake.permissions)
+				require.NotNil(t, fake.permission.AllPipelines)
+				assert.True(t, *fake.permission.AllPipelines.Authorized)
+			})
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package docker
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	shared.CreateOptions
+	registry string
+	username string
+	password string
+	email    string
+}
+
+func NewCmdCreateDocker(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "docker [organization/]project",
+		Short: "Create a Docker registry service endpoint",
+		Long: heredoc.Doc(`
+			Create a Docker registry service endpoint which authenticates with username and password.
+
+			If the password is not passed with --password, it will be prompted for.
+		`),
+		Example: heredoc.Doc(`
+			# create a service endpoint for Docker Hub
+			azdo service-endpoint create docker myproject --name dockerhub --username me
+		`),
+		Args: util.ExactArgs(1, "cannot create service endpoint: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Scope = args[0]
+
+			password, err := shared.ReadSecret(ctx, opts.password, "password", "Docker registry password:")
+			if err != nil {
+				return err
+			}
+
+			return shared.CreateServiceEndpoint(ctx, &opts.CreateOptions, &serviceendpoint.ServiceEndpoint{
+				Type: lo.ToPtr("dockerregistry"),
+				Url:  &opts.registry,
+				Authorization: &serviceendpoint.EndpointAuthorization{
+					Scheme: lo.ToPtr("UsernamePassword"),
+					Parameters: &map[string]string{
+						"registry": opts.registry,
+						"username": opts.username,
+						"password": password,
+						"email":    opts.email,
+					},
+				},
+				Data: &map[string]string{
+					"registrytype": "Others",
+				},
+			})
+		},
+	}
+
+	shared.AddCreateFlags(cmd, &opts.CreateOptions)
+	cmd.Flags().StringVar(&opts.registry, "registry", "https://index.docker.io/v1/", "URL of the Docker registry")
+	cmd.Flags().StringVar(&opts.username, "username", "", "Username to authenticate with the registry")
+	cmd.Flags().StringVar(&opts.password, "password", "", "Password to authenticate with the registry")
+	cmd.Flags().StringVar(&opts.email, "email", "", "Email address of the registry account")
+	_ = cmd.MarkFlagRequired("username")
+
+	return cmd
+}
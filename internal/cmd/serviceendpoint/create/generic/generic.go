@@ -0,0 +1,65 @@
+package generic
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	shared.CreateOptions
+	url      string
+	username string
+	password string
+}
+
+func NewCmdCreateGeneric(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "generic [organization/]project",
+		Short: "Create a generic service endpoint",
+		Long: heredoc.Doc(`
+			Create a generic service endpoint for any server reachable by URL which authenticates
+			with username and password.
+
+			If the password is not passed with --password, it will be prompted for.
+		`),
+		Example: heredoc.Doc(`
+			# create a generic service endpoint
+			azdo service-endpoint create generic myproject --name artifacts --url https://artifacts.example.com --username deploy
+		`),
+		Args: util.ExactArgs(1, "cannot create service endpoint: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Scope = args[0]
+
+			password, err := shared.ReadSecret(ctx, opts.password, "password", "Password:")
+			if err != nil {
+				return err
+			}
+
+			return shared.CreateServiceEndpoint(ctx, &opts.CreateOptions, &serviceendpoint.ServiceEndpoint{
+				Type: lo.ToPtr("generic"),
+				Url:  &opts.url,
+				Authorization: &serviceendpoint.EndpointAuthorization{
+					Scheme: lo.ToPtr("UsernamePassword"),
+					Parameters: &map[string]string{
+						"username": opts.username,
+						"password": password,
+					},
+				},
+			})
+		},
+	}
+
+	shared.AddCreateFlags(cmd, &opts.CreateOptions)
+	cmd.Flags().StringVar(&opts.url, "url", "", "URL of the server")
+	cmd.Flags().StringVar(&opts.username, "username", "", "Username to authenticate with the server")
+	cmd.Flags().StringVar(&opts.password, "password", "", "Password to authenticate with the server")
+	_ = cmd.MarkFlagRequired("url")
+
+	return cmd
+}
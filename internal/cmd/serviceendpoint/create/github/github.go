@@ -0,0 +1,60 @@
+package github
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	shared.CreateOptions
+	url   string
+	token string
+}
+
+func NewCmdCreateGitHub(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "github [organization/]project",
+		Short: "Create a GitHub service endpoint",
+		Long: heredoc.Doc(`
+			Create a GitHub service endpoint which authenticates with a personal access token.
+
+			If the token is not passed with --token, it will be prompted for.
+		`),
+		Example: heredoc.Doc(`
+			# create a GitHub service endpoint usable by all pipelines
+			azdo service-endpoint create github myorg/myproject --name github --grant-permission-to-all-pipelines
+		`),
+		Args: util.ExactArgs(1, "cannot create service endpoint: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Scope = args[0]
+
+			token, err := shared.ReadSecret(ctx, opts.token, "token", "GitHub personal access token:")
+			if err != nil {
+				return err
+			}
+
+			return shared.CreateServiceEndpoint(ctx, &opts.CreateOptions, &serviceendpoint.ServiceEndpoint{
+				Type: lo.ToPtr("github"),
+				Url:  &opts.url,
+				Authorization: &serviceendpoint.EndpointAuthorization{
+					Scheme: lo.ToPtr("PersonalAccessToken"),
+					Parameters: &map[string]string{
+						"accessToken": token,
+					},
+				},
+			})
+		},
+	}
+
+	shared.AddCreateFlags(cmd, &opts.CreateOptions)
+	cmd.Flags().StringVar(&opts.url, "url", "https://github.com", "URL of the GitHub server")
+	cmd.Flags().StringVar(&opts.token, "token", "", "GitHub personal access token")
+
+	return cmd
+}
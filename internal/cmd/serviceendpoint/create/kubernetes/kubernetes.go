@@ -0,0 +1,70 @@
+package kubernetes
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	shared.CreateOptions
+	url            string
+	kubeconfigFile string
+	clusterContext string
+}
+
+func NewCmdCreateKubernetes(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "kubernetes [organization/]project",
+		Short: "Create a Kubernetes service endpoint",
+		Long: heredoc.Doc(`
+			Create a Kubernetes service endpoint which authenticates with a kubeconfig file.
+		`),
+		Example: heredoc.Doc(`
+			# create a Kubernetes service endpoint from a kubeconfig file
+			azdo service-endpoint create kubernetes myproject --name aks --url https://aks.example.com --kubeconfig ~/.kube/config
+		`),
+		Args: util.ExactArgs(1, "cannot create service endpoint: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Scope = args[0]
+
+			kubeconfig, err := os.ReadFile(opts.kubeconfigFile)
+			if err != nil {
+				return fmt.Errorf("failed to read kubeconfig: %w", err)
+			}
+
+			return shared.CreateServiceEndpoint(ctx, &opts.CreateOptions, &serviceendpoint.ServiceEndpoint{
+				Type: lo.ToPtr("kubernetes"),
+				Url:  &opts.url,
+				Authorization: &serviceendpoint.EndpointAuthorization{
+					Scheme: lo.ToPtr("Kubernetes"),
+					Parameters: &map[string]string{
+						"authorizationType": "Kubeconfig",
+						"kubeconfig":        string(kubeconfig),
+						"clusterContext":    opts.clusterContext,
+					},
+				},
+				Data: &map[string]string{
+					"authorizationType": "Kubeconfig",
+				},
+			})
+		},
+	}
+
+	shared.AddCreateFlags(cmd, &opts.CreateOptions)
+	cmd.Flags().StringVar(&opts.url, "url", "", "URL of the Kubernetes API server")
+	cmd.Flags().StringVar(&opts.kubeconfigFile, "kubeconfig", "", "Path of the kubeconfig file")
+	cmd.Flags().StringVar(&opts.clusterContext, "cluster-context", "", "Context of the kubeconfig to use")
+	_ = cmd.MarkFlagRequired("url")
+	_ = cmd.MarkFlagRequired("kubeconfig")
+
+	return cmd
+}
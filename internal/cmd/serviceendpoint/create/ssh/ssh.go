@@ -0,0 +1,103 @@
+package ssh
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type createOptions struct {
+	shared.CreateOptions
+	host           string
+	port           int
+	username       string
+	password       string
+	privateKeyFile string
+	passphrase     string
+}
+
+func NewCmdCreateSSH(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "ssh [organization/]project",
+		Short: "Create an SSH service endpoint",
+		Long: heredoc.Doc(`
+			Create an SSH service endpoint which authenticates with a password or a private key.
+
+			If neither --password nor --private-key is passed, the password will be prompted for.
+			The passphrase of an encrypted private key is passed with --private-key-passphrase.
+		`),
+		Example: heredoc.Doc(`
+			# create an SSH service endpoint which authenticates with a private key
+			azdo service-endpoint create ssh myproject --name web01 --host web01.example.com --username deploy --private-key ~/.ssh/id_ed25519
+
+			# create an SSH service endpoint on a non-standard port and prompt for the password
+			azdo service-endpoint create ssh myorg/myproject --name web02 --host web02.example.com --port 2222 --username deploy
+		`),
+		Args: util.ExactArgs(1, "cannot create service endpoint: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Scope = args[0]
+
+			if opts.port < 1 || opts.port > 65535 {
+				return util.FlagErrorf("invalid port %d", opts.port)
+			}
+			if opts.passphrase != "" && opts.privateKeyFile == "" {
+				return util.FlagErrorf("--private-key-passphrase requires --private-key")
+			}
+
+			var privateKey []byte
+			var err error
+			// the password parameter holds the passphrase when authenticating with a private key
+			password := opts.passphrase
+			if opts.privateKeyFile != "" {
+				privateKey, err = os.ReadFile(opts.privateKeyFile)
+				if err != nil {
+					return fmt.Errorf("failed to read private key: %w", err)
+				}
+			} else {
+				password, err = shared.ReadSecret(ctx, opts.password, "password", "SSH password:")
+				if err != nil {
+					return err
+				}
+			}
+
+			return shared.CreateServiceEndpoint(ctx, &opts.CreateOptions, &serviceendpoint.ServiceEndpoint{
+				Type: lo.ToPtr("ssh"),
+				Url:  lo.ToPtr("ssh://" + opts.host),
+				Authorization: &serviceendpoint.EndpointAuthorization{
+					Scheme: lo.ToPtr("UsernamePassword"),
+					Parameters: &map[string]string{
+						"username": opts.username,
+						"password": password,
+					},
+				},
+				Data: &map[string]string{
+					"Host":       opts.host,
+					"Port":       strconv.Itoa(opts.port),
+					"PrivateKey": string(privateKey),
+				},
+			})
+		},
+	}
+
+	shared.AddCreateFlags(cmd, &opts.CreateOptions)
+	cmd.Flags().StringVar(&opts.host, "host", "", "Host name or IP address of the SSH server")
+	cmd.Flags().IntVar(&opts.port, "port", 22, "Port of the SSH server")
+	cmd.Flags().StringVar(&opts.username, "username", "", "Username to authenticate with the server")
+	cmd.Flags().StringVar(&opts.password, "password", "", "Password to authenticate with the server")
+	cmd.Flags().StringVar(&opts.privateKeyFile, "private-key", "", "Path of the private key file to authenticate with the server")
+	cmd.Flags().StringVar(&opts.passphrase, "private-key-passphrase", "", "Passphrase of the private key")
+	_ = cmd.MarkFlagRequired("host")
+	_ = cmd.MarkFlagRequired("username")
+	cmd.MarkFlagsMutuallyExclusive("password", "private-key")
+
+	return cmd
+}
@@ -0,0 +1,26 @@
+package serviceendpoint
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/create"
+	"github.com/tmeckel/azdo-cli/internal/cmd/serviceendpoint/share"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdServiceEndpoint(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "service-endpoint <command>",
+		Short: "Manage service endpoints",
+		Long:  `Work with Azure DevOps service endpoints (service connections).`,
+		Example: heredoc.Doc(`
+			$ azdo service-endpoint create github myproject --name github --grant-permission-to-all-pipelines
+		`),
+		Aliases: []string{"se"},
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(create.NewCmdCreate(ctx))
+	cmd.AddCommand(share.NewCmdShare(ctx))
+	return cmd
+}
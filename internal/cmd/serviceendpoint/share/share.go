@@ -0,0 +1,146 @@
+package share
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+type shareOptions struct {
+	endpointID     uuid.UUID
+	scope          string
+	targetProjects []string
+}
+
+func NewCmdShare(ctx util.CmdContext) *cobra.Command {
+	opts := &shareOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "share <id> [organization/]project",
+		Short: "Share a service endpoint with other projects",
+		Long: heredoc.Doc(`
+			Share a service endpoint of a project with other projects of the same organization.
+
+			The endpoint keeps its name and description in the projects it is shared with. Projects
+			the endpoint is already shared with are skipped.
+		`),
+		Example: heredoc.Doc(`
+			# share a service endpoint with another project
+			azdo service-endpoint share 5b0a0a3c-4a9c-4e6b-8f3a-2b1c2d3e4f50 myproject --with-project otherproject
+
+			# share a service endpoint with several projects
+			azdo service-endpoint share 5b0a0a3c-4a9c-4e6b-8f3a-2b1c2d3e4f50 myorg/myproject --with-project web --with-project api
+		`),
+		Args: util.ExactArgs(2, "cannot share service endpoint: ID and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := uuid.Parse(args[0])
+			if err != nil {
+				return util.FlagErrorf("invalid service endpoint ID: %s", args[0])
+			}
+			opts.endpointID = id
+			opts.scope = args[1]
+
+			return runShare(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.targetProjects, "with-project", nil, "Project to share the service endpoint with; can be repeated")
+	_ = cmd.MarkFlagRequired("with-project")
+
+	return cmd
+}
+
+func runShare(ctx util.CmdContext, opts *shareOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	client, err := serviceendpoint.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	endpoint, err := client.GetServiceEndpointDetails(rctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		Project:    &project,
+		EndpointId: &opts.endpointID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get service endpoint %s: %w", opts.endpointID, err)
+	}
+	if endpoint == nil || endpoint.Id == nil {
+		return fmt.Errorf("service endpoint %s does not exist in project %s", opts.endpointID, project)
+	}
+
+	var refs []serviceendpoint.ServiceEndpointProjectReference
+	var skipped []string
+	for _, name := range lo.Uniq(opts.targetProjects) {
+		target, err := coreClient.GetProject(rctx, core.GetProjectArgs{
+			ProjectId: &name,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get project %s: %w", name, err)
+		}
+		alreadyShared := lo.ContainsBy(lo.FromPtr(endpoint.ServiceEndpointProjectReferences), func(r serviceendpoint.ServiceEndpointProjectReference) bool {
+			return r.ProjectReference != nil && r.ProjectReference.Id != nil && *r.ProjectReference.Id == *target.Id
+		})
+		if alreadyShared {
+			skipped = append(skipped, *target.Name)
+			continue
+		}
+		refs = append(refs, serviceendpoint.ServiceEndpointProjectReference{
+			Name:        endpoint.Name,
+			Description: endpoint.Description,
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id:   target.Id,
+				Name: target.Name,
+			},
+		})
+	}
+
+	if len(refs) > 0 {
+		err = client.ShareServiceEndpoint(rctx, serviceendpoint.ShareServiceEndpointArgs{
+			EndpointProjectReferences: &refs,
+			EndpointId:                endpoint.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to share service endpoint %s: %w", *endpoint.Name, err)
+		}
+	}
+	iostrms.StopProgressIndicator()
+
+	cs := iostrms.ColorScheme()
+	if len(skipped) > 0 {
+		fmt.Fprintf(iostrms.ErrOut, "%s Service endpoint %s is already shared with %s\n", cs.WarningIcon(), *endpoint.Name, strings.Join(skipped, ", "))
+	}
+	for _, r := range refs {
+		fmt.Fprintf(iostrms.Out, "%s Shared service endpoint %s with project %s\n", cs.SuccessIcon(), cs.Bold(*endpoint.Name), *r.ProjectReference.Name)
+	}
+	return
+}
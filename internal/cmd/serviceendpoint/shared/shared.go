@@ -0,0 +1,151 @@
+package shared
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinepermissions"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/serviceendpoint"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// endpointResourceType is the pipeline permissions resource type of service endpoints
+const endpointResourceType = "endpoint"
+
+// CreateOptions holds the options shared by all service endpoint create commands.
+type CreateOptions struct {
+	Scope                         string
+	Name                          string
+	Description                   string
+	GrantPermissionToAllPipelines bool
+	Format                        string
+}
+
+// AddCreateFlags registers the flags shared by all service endpoint create commands.
+func AddCreateFlags(cmd *cobra.Command, opts *CreateOptions) {
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Name of the service endpoint")
+	cmd.Flags().StringVar(&opts.Description, "description", "", "Description of the service endpoint")
+	cmd.Flags().BoolVar(&opts.GrantPermissionToAllPipelines, "grant-permission-to-all-pipelines", false, "Grant access permission to all pipelines to use the service endpoint")
+	util.StringEnumFlag(cmd, &opts.Format, "format", "", "table", []string{"json"}, "Output format")
+	_ = cmd.MarkFlagRequired("name")
+}
+
+// CreateServiceEndpoint creates the service endpoint in the project specified by the create options
+// and, if requested, grants all pipelines of the project access to the new endpoint.
+func CreateServiceEndpoint(ctx util.CmdContext, opts *CreateOptions, endpoint *serviceendpoint.ServiceEndpoint) (err error) {
+	organizationName, projectName, err := util.ParseProjectScope(ctx, opts.Scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	coreClient, err := core.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	project, err := coreClient.GetProject(rctx, core.GetProjectArgs{
+		ProjectId: &projectName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get project %s: %w", projectName, err)
+	}
+
+	endpoint.Name = &opts.Name
+	if opts.Description != "" {
+		endpoint.Description = &opts.Description
+	}
+	endpoint.Owner = lo.ToPtr("library")
+	endpoint.ServiceEndpointProjectReferences = &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			Name:        &opts.Name,
+			Description: endpoint.Description,
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id:   project.Id,
+				Name: project.Name,
+			},
+		},
+	}
+
+	client, err := serviceendpoint.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+	created, err := client.CreateServiceEndpoint(rctx, serviceendpoint.CreateServiceEndpointArgs{
+		Endpoint: endpoint,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create service endpoint: %w", err)
+	}
+
+	if opts.GrantPermissionToAllPipelines {
+		permissionsClient, err := pipelinepermissions.NewClient(rctx, conn)
+		if err != nil {
+			return err
+		}
+		err = GrantAllPipelinesAccessToEndpoint(rctx, permissionsClient, project.Id.String(), *created.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	tp, err := ctx.Printer(opts.Format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Name", "Type", "URL")
+	tp.AddField(created.Id.String(), printer.WithTruncate(nil))
+	tp.AddField(*created.Name)
+	tp.AddField(*created.Type)
+	tp.AddField(lo.FromPtr(created.Url))
+	tp.EndRow()
+	return tp.Render()
+}
+
+// GrantAllPipelinesAccessToEndpoint authorizes all pipelines of the project to use the service endpoint.
+func GrantAllPipelinesAccessToEndpoint(ctx context.Context, client pipelinepermissions.Client, project string, endpointID uuid.UUID) error {
+	_, err := client.UpdatePipelinePermisionsForResource(ctx, pipelinepermissions.UpdatePipelinePermisionsForResourceArgs{
+		ResourceAuthorization: &pipelinepermissions.ResourcePipelinePermissions{
+			AllPipelines: &pipelinepermissions.Permission{
+				Authorized: lo.ToPtr(true),
+			},
+		},
+		Project:      &project,
+		ResourceType: lo.ToPtr(endpointResourceType),
+		ResourceId:   lo.ToPtr(endpointID.String()),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to grant all pipelines access to service endpoint %s: %w", endpointID, err)
+	}
+	return nil
+}
+
+// ReadSecret returns the value if it is not empty, otherwise the user is prompted for the secret.
+func ReadSecret(ctx util.CmdContext, value, flagName, prompt string) (string, error) {
+	if value != "" {
+		return value, nil
+	}
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return "", err
+	}
+	if !iostrms.CanPrompt() {
+		return "", util.FlagErrorf("--%s required when not running interactively", flagName)
+	}
+	p, err := ctx.Prompter()
+	if err != nil {
+		return "", err
+	}
+	return p.Password(prompt)
+}
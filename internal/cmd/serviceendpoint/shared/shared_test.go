@@ -0,0 +1,61 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/pipelinepermissions"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePermissionsClient struct {
+	pipelinepermissions.Client
+	args *pipelinepermissions.UpdatePipelinePermisionsForResourceArgs
+	err  error
+}
+
+func (c *fakePermissionsClient) UpdatePipelinePermisionsForResource(_ context.Context, args pipelinepermissions.UpdatePipelinePermisionsForResourceArgs) (*pipelinepermissions.ResourcePipelinePermissions, error) {
+	c.args = &args
+	return args.ResourceAuthorization, c.err
+}
+
+func TestGrantAllPipelinesAccessToEndpoint(t *testing.T) {
+	endpointID := uuid.MustParse("8b8fb8cd-07f4-4e3b-a1ea-07bd1c4f7f0e")
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr string
+	}{
+		{
+			name: "grants access",
+		},
+		{
+			name:    "propagates error",
+			err:     errors.New("forbidden"),
+			wantErr: "failed to grant all pipelines access to service endpoint 8b8fb8cd-07f4-4e3b-a1ea-07bd1c4f7f0e: forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &fakePermissionsClient{err: tt.err}
+
+			err := GrantAllPipelinesAccessToEndpoint(context.Background(), client, "myproject", endpointID)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			require.NotNil(t, client.args)
+			assert.Equal(t, "myproject", *client.args.Project)
+			assert.Equal(t, "endpoint", *client.args.ResourceType)
+			assert.Equal(t, endpointID.String(), *client.args.ResourceId)
+			assert.True(t, *client.args.ResourceAuthorization.AllPipelines.Authorized)
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/test/shared"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// queryPeriod is the longest period of time test runs can be queried for.
+const queryPeriod = 7 * 24 * time.Hour
+
+// runStates maps the values of --state to the states of test runs.
+var runStates = map[string][]test.TestRunState{
+	"pending":   {test.TestRunStateValues.NotStarted, test.TestRunStateValues.Waiting},
+	"running":   {test.TestRunStateValues.InProgress},
+	"completed": {test.TestRunStateValues.Completed},
+}
+
+type listOptions struct {
+	scope      string
+	buildID    int
+	pipelineID int
+	state      string
+	limit      int
+	format     string
+}
+
+type runResult struct {
+	ID            int                    `json:"id"`
+	Name          string                 `json:"name"`
+	Build         *test.ShallowReference `json:"build,omitempty"`
+	State         string                 `json:"state"`
+	TotalTests    int                    `json:"totalTests"`
+	PassedTests   int                    `json:"passedTests"`
+	FailedTests   int                    `json:"failedTests"`
+	RunStatistics []test.RunStatistic    `json:"runStatistics,omitempty"`
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List test runs",
+		Long: heredoc.Doc(`
+			List the test runs of a project, most recent first.
+
+			Without --build-id only the test runs updated within the last seven days are listed, which
+			is the longest period Azure DevOps allows to query.
+		`),
+		Example: heredoc.Doc(`
+			# list the test runs of pipeline run 1234
+			azdo test run list myproject --build-id 1234
+
+			# list the completed test runs of pipeline 12
+			azdo test run list myorg/myproject --pipeline-id 12 --state completed
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list test runs: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+			if err := util.MutuallyExclusive("specify only one of --build-id or --pipeline-id", opts.buildID != 0, opts.pipelineID != 0); err != nil {
+				return err
+			}
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.buildID, "build-id", 0, "Only list test runs of this pipeline run")
+	cmd.Flags().IntVar(&opts.pipelineID, "pipeline-id", 0, "Only list test runs of this pipeline")
+	util.StringEnumFlag(cmd, &opts.state, "state", "", "all", []string{"pending", "running", "completed", "all"}, "Only list test runs in this state")
+	cmd.Flags().IntVarP(&opts.limit, "limit", "L", 30, "Maximum number of test runs to list")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	if opts.limit < 1 {
+		return util.FlagErrorf("invalid value for --limit: %d", opts.limit)
+	}
+
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := test.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	var testRuns []test.TestRun
+	if opts.buildID > 0 {
+		testRuns, err = getBuildTestRuns(rctx, client, project, opts.buildID)
+	} else {
+		testRuns, err = queryTestRuns(rctx, client, project, opts, time.Now())
+	}
+	if err != nil {
+		return
+	}
+	testRuns = filterTestRuns(testRuns, opts)
+	iostrms.StopProgressIndicator()
+
+	if len(testRuns) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No test runs found in project %s", project))
+	}
+
+	results := lo.Map(testRuns, func(tr test.TestRun, _ int) runResult {
+		total, passed, failed := shared.CountTests(&tr)
+		return runResult{
+			ID:            lo.FromPtr(tr.Id),
+			Name:          lo.FromPtr(tr.Name),
+			Build:         tr.Build,
+			State:         lo.FromPtr(tr.State),
+			TotalTests:    total,
+			PassedTests:   passed,
+			FailedTests:   failed,
+			RunStatistics: lo.FromPtr(tr.RunStatistics),
+		}
+	})
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(results)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Name", "Build", "State", "Total", "Passed", "Failed", "Duration")
+	for i, r := range results {
+		tp.AddField(strconv.Itoa(r.ID), printer.WithTruncate(nil))
+		tp.AddField(r.Name)
+		if r.Build != nil {
+			tp.AddField(lo.FromPtr(r.Build.Id), printer.WithTruncate(nil))
+		} else {
+			tp.AddField("")
+		}
+		tp.AddField(r.State)
+		tp.AddField(strconv.Itoa(r.TotalTests), printer.WithTruncate(nil))
+		tp.AddField(strconv.Itoa(r.PassedTests), printer.WithTruncate(nil))
+		tp.AddField(strconv.Itoa(r.FailedTests), printer.WithTruncate(nil))
+		if tr := testRuns[i]; tr.StartedDate != nil && tr.CompletedDate != nil {
+			tp.AddField(tr.CompletedDate.Time.Sub(tr.StartedDate.Time).Round(time.Second).String())
+		} else {
+			tp.AddField("")
+		}
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+func getBuildTestRuns(ctx context.Context, client test.Client, project string, buildID int) ([]test.TestRun, error) {
+	res, err := client.GetTestRuns(ctx, test.GetTestRunsArgs{
+		Project:           &project,
+		BuildUri:          lo.ToPtr(shared.BuildURI(buildID)),
+		IncludeRunDetails: lo.ToPtr(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get test runs of pipeline run %d: %w", buildID, err)
+	}
+	return lo.FromPtr(res), nil
+}
+
+// queryTestRuns returns the test runs updated within the query period before now.
+func queryTestRuns(ctx context.Context, client test.Client, project string, opts *listOptions, now time.Time) ([]test.TestRun, error) {
+	args := test.QueryTestRunsArgs{
+		Project:            &project,
+		MinLastUpdatedDate: &azuredevops.Time{Time: now.Add(-queryPeriod)},
+		MaxLastUpdatedDate: &azuredevops.Time{Time: now},
+	}
+	if opts.pipelineID > 0 {
+		args.BuildDefIds = &[]int{opts.pipelineID}
+	}
+	if states := runStates[opts.state]; len(states) == 1 {
+		args.State = &states[0]
+	}
+	var testRuns []test.TestRun
+	for {
+		res, err := client.QueryTestRuns(ctx, args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query test runs of project %s: %w", project, err)
+		}
+		testRuns = append(testRuns, res.Value...)
+		if res.ContinuationToken == "" || len(filterTestRuns(testRuns, opts)) >= opts.limit {
+			break
+		}
+		args.ContinuationToken = &res.ContinuationToken
+	}
+	return testRuns, nil
+}
+
+// filterTestRuns returns up to limit test runs in the requested state, most recent first.
+func filterTestRuns(testRuns []test.TestRun, opts *listOptions) []test.TestRun {
+	if states, ok := runStates[opts.state]; ok {
+		testRuns = lo.Filter(testRuns, func(tr test.TestRun, _ int) bool {
+			return lo.ContainsBy(states, func(s test.TestRunState) bool { return strings.EqualFold(lo.FromPtr(tr.State), string(s)) })
+		})
+	}
+	sort.SliceStable(testRuns, func(i, j int) bool {
+		return lo.FromPtr(testRuns[i].Id) > lo.FromPtr(testRuns[j].Id)
+	})
+	if len(testRuns) > opts.limit {
+		testRuns = testRuns[:opts.limit]
+	}
+	return testRuns
+}
@@ -0,0 +1,44 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterTestRuns(t *testing.T) {
+	run := func(id int, state string) test.TestRun {
+		return test.TestRun{Id: lo.ToPtr(id), State: lo.ToPtr(state)}
+	}
+	testRuns := []test.TestRun{
+		run(1, "Completed"),
+		run(4, "InProgress"),
+		run(2, "NotStarted"),
+		run(5, "Completed"),
+		run(3, "Waiting"),
+	}
+	ids := func(runs []test.TestRun) []int {
+		return lo.Map(runs, func(tr test.TestRun, _ int) int { return *tr.Id })
+	}
+
+	tests := []struct {
+		state string
+		limit int
+		want  []int
+	}{
+		{state: "all", limit: 10, want: []int{5, 4, 3, 2, 1}},
+		{state: "all", limit: 2, want: []int{5, 4}},
+		{state: "pending", limit: 10, want: []int{3, 2}},
+		{state: "running", limit: 10, want: []int{4}},
+		{state: "completed", limit: 1, want: []int{5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			runs := append([]test.TestRun(nil), testRuns...)
+			got := filterTestRuns(runs, &listOptions{state: tt.state, limit: tt.limit})
+			assert.Equal(t, tt.want, ids(got))
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package run
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/test/run/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdRun(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run <command>",
+		Short: "Work with test runs",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	return cmd
+}
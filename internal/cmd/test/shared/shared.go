@@ -0,0 +1,43 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/samber/lo"
+)
+
+// FailedOutcomes are the outcomes of test results which count as failed.
+var FailedOutcomes = []test.TestOutcome{
+	test.TestOutcomeValues.Failed,
+	test.TestOutcomeValues.Aborted,
+	test.TestOutcomeValues.Timeout,
+	test.TestOutcomeValues.Error,
+}
+
+// BuildURI returns the URI of a pipeline run which test runs refer to.
+func BuildURI(runID int) string {
+	return fmt.Sprintf("vstfs:///Build/Build/%d", runID)
+}
+
+// CountTests returns the number of total, passed and failed tests of a test run. The counts are
+// taken from the statistics by outcome of the run, or from its totals if the run has no statistics.
+func CountTests(tr *test.TestRun) (total, passed, failed int) {
+	stats := lo.FromPtr(tr.RunStatistics)
+	if len(stats) == 0 {
+		return lo.FromPtr(tr.TotalTests), lo.FromPtr(tr.PassedTests), lo.FromPtr(tr.UnanalyzedTests)
+	}
+	for _, s := range stats {
+		count := lo.FromPtr(s.Count)
+		total += count
+		outcome := lo.FromPtr(s.Outcome)
+		switch {
+		case strings.EqualFold(outcome, string(test.TestOutcomeValues.Passed)):
+			passed += count
+		case lo.ContainsBy(FailedOutcomes, func(o test.TestOutcome) bool { return strings.EqualFold(outcome, string(o)) }):
+			failed += count
+		}
+	}
+	return
+}
@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/test"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountTests(t *testing.T) {
+	stat := func(outcome string, count int) test.RunStatistic {
+		return test.RunStatistic{Outcome: lo.ToPtr(outcome), Count: lo.ToPtr(count)}
+	}
+	tests := []struct {
+		name       string
+		run        test.TestRun
+		wantTotal  int
+		wantPassed int
+		wantFailed int
+	}{
+		{
+			name: "statistics",
+			run: test.TestRun{
+				TotalTests: lo.ToPtr(99),
+				RunStatistics: &[]test.RunStatistic{
+					stat("Passed", 40),
+					stat("Failed", 3),
+					stat("Timeout", 1),
+					stat("NotExecuted", 2),
+				},
+			},
+			wantTotal:  46,
+			wantPassed: 40,
+			wantFailed: 4,
+		},
+		{
+			name: "totals",
+			run: test.TestRun{
+				TotalTests:      lo.ToPtr(10),
+				PassedTests:     lo.ToPtr(8),
+				UnanalyzedTests: lo.ToPtr(1),
+			},
+			wantTotal:  10,
+			wantPassed: 8,
+			wantFailed: 1,
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, passed, failed := CountTests(&tt.run)
+			assert.Equal(t, tt.wantTotal, total)
+			assert.Equal(t, tt.wantPassed, passed)
+			assert.Equal(t, tt.wantFailed, failed)
+		})
+	}
+}
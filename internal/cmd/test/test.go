@@ -0,0 +1,23 @@
+package test
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/test/run"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+)
+
+func NewCmdTest(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "test <command>",
+		Short: "Work with test results",
+		Long:  `Work with Azure DevOps test runs and their results.`,
+		Example: heredoc.Doc(`
+			$ azdo test run list myproject --build-id 1234
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(run.NewCmdRun(ctx))
+	return cmd
+}
@@ -34,6 +34,21 @@ func ExactArgs(n int, msg string) cobra.PositionalArgs {
 	}
 }
 
+// RangeArgs returns an error if the number of arguments is not between min and max.
+func RangeArgs(min, max int, msg string) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > max {
+			return FlagErrorf("too many arguments")
+		}
+
+		if len(args) < min {
+			return FlagErrorf("%s", msg)
+		}
+
+		return nil
+	}
+}
+
 func NoArgsQuoteReminder(cmd *cobra.Command, args []string) error {
 	if len(args) < 1 {
 		return nil
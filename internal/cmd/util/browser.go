@@ -0,0 +1,58 @@
+package util
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/cli/safeexec"
+	"github.com/google/shlex"
+	"github.com/tmeckel/azdo-cli/internal/config"
+	"github.com/tmeckel/azdo-cli/internal/run"
+)
+
+// OpenInBrowser opens the URL in a web browser. The browser is determined in the following order:
+//  1. AZDO_BROWSER
+//  2. browser from config
+//  3. BROWSER
+//  4. the default browser of the operating system
+func OpenInBrowser(ctx CmdContext, url string) error {
+	browser := os.Getenv("AZDO_BROWSER")
+	if browser == "" {
+		cfg, err := ctx.Config()
+		if err != nil {
+			return err
+		}
+		browser, _ = cfg.Get([]string{config.Organizations, "", "browser"})
+	}
+	if browser == "" {
+		browser = os.Getenv("BROWSER")
+	}
+
+	var args []string
+	if browser != "" {
+		var err error
+		args, err = shlex.Split(browser)
+		if err != nil {
+			return fmt.Errorf("invalid browser command %q: %w", browser, err)
+		}
+	} else {
+		switch runtime.GOOS {
+		case "darwin":
+			args = []string{"open"}
+		case "windows":
+			args = []string{"cmd", "/c", "start", ""}
+		default:
+			args = []string{"xdg-open"}
+		}
+	}
+
+	exe, err := safeexec.LookPath(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to find browser %s: %w", args[0], err)
+	}
+	cmd := exec.Command(exe, append(args[1:], url)...)
+	cmd.Stderr = os.Stderr
+	return run.PrepareCmd(cmd).Run()
+}
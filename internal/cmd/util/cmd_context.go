@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/tmeckel/azdo-cli/internal/azdo"
 	"github.com/tmeckel/azdo-cli/internal/config"
 	"github.com/tmeckel/azdo-cli/internal/git"
 	"github.com/tmeckel/azdo-cli/internal/iostreams"
@@ -21,6 +22,7 @@ type CmdContext interface {
 	IOStreams() (*iostreams.IOStreams, error)
 	Printer(string) (printer.Printer, error)
 	GitClient() (*git.Client, error)
+	RepoContext() (azdo.RepoContext, error)
 }
 
 type cmdContext struct {
@@ -99,15 +101,7 @@ func (c *cmdContext) IOStreams() (*iostreams.IOStreams, error) {
 }
 
 func (c *cmdContext) Printer(t string) (p printer.Printer, err error) {
-	switch t {
-	case "table":
-		p, err = newTablePrinter(c.ioStreams)
-	case "json":
-		p, err = printer.NewJSONPrinter(c.ioStreams.Out)
-	default:
-		return nil, printer.NewUnsupportedPrinterError(t)
-	}
-	return
+	return NewPrinter(c.ioStreams, t)
 }
 
 func (c *cmdContext) GitClient() (client *git.Client, err error) {
@@ -115,6 +109,15 @@ func (c *cmdContext) GitClient() (client *git.Client, err error) {
 	return
 }
 
+func (c *cmdContext) RepoContext() (repoCtx azdo.RepoContext, err error) {
+	client, err := newGitClient(c.ioStreams)
+	if err != nil {
+		return
+	}
+	repoCtx = azdo.NewRepoContext(c.ctx, client)
+	return
+}
+
 func newGitClient(io *iostreams.IOStreams) (client *git.Client, err error) {
 	azdoPath, err := os.Executable()
 	if err != nil {
@@ -159,12 +162,3 @@ func newPrompter(cfg config.Config, io *iostreams.IOStreams) (p prompter.Prompte
 	p = prompter.New(editor, io.In, io.Out, io.ErrOut)
 	return
 }
-
-func newTablePrinter(ios *iostreams.IOStreams) (printer.TablePrinter, error) {
-	maxWidth := 80
-	isTTY := ios.IsStdoutTTY()
-	if isTTY {
-		maxWidth = ios.TerminalWidth()
-	}
-	return printer.NewTablePrinter(ios.Out, isTTY, maxWidth)
-}
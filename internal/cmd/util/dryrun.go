@@ -0,0 +1,16 @@
+package util
+
+import (
+	"fmt"
+	"io"
+)
+
+// DryRunWrap calls fn which performs a mutating operation. If dryRun is set, fn is not called;
+// instead "Would <action>" is written to w.
+func DryRunWrap(dryRun bool, w io.Writer, action string, fn func() error) error {
+	if dryRun {
+		_, err := fmt.Fprintf(w, "Would %s\n", action)
+		return err
+	}
+	return fn()
+}
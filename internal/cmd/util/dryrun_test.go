@@ -0,0 +1,30 @@
+package util
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRunWrap(t *testing.T) {
+	var out bytes.Buffer
+	called := false
+	err := DryRunWrap(true, &out, "delete branch refs/heads/feature", func() error {
+		called = true
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.False(t, called)
+	assert.Equal(t, "Would delete branch refs/heads/feature\n", out.String())
+
+	out.Reset()
+	err = DryRunWrap(false, &out, "delete branch refs/heads/feature", func() error {
+		called = true
+		return errors.New("failed")
+	})
+	assert.EqualError(t, err, "failed")
+	assert.True(t, called)
+	assert.Empty(t, out.String())
+}
@@ -0,0 +1,18 @@
+package util
+
+import "strings"
+
+const branchRefPrefix = "refs/heads/"
+
+// NormalizeBranchRef returns the fully qualified ref name of a branch.
+func NormalizeBranchRef(branch string) string {
+	if strings.HasPrefix(branch, "refs/") {
+		return branch
+	}
+	return branchRefPrefix + branch
+}
+
+// ShortBranchName returns the name of a branch without the "refs/heads/" prefix.
+func ShortBranchName(ref string) string {
+	return strings.TrimPrefix(ref, branchRefPrefix)
+}
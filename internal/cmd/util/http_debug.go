@@ -0,0 +1,59 @@
+package util
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var enableHTTPDebugLogging sync.Once
+
+// EnableHTTPDebugLogging logs all HTTP requests to Azure DevOps, with their response status and
+// duration, at debug level. Connections don't allow to set a transport, but the clients created
+// by them use the default transport, so the logging transport wraps http.DefaultTransport.
+func EnableHTTPDebugLogging() {
+	enableHTTPDebugLogging.Do(func() {
+		http.DefaultTransport = &debugTransport{next: http.DefaultTransport}
+	})
+}
+
+// debugTransport is a http.RoundTripper which logs requests and responses.
+type debugTransport struct {
+	next http.RoundTripper
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	logger := zap.L().Sugar()
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+	if err != nil {
+		logger.Debugw("HTTP request failed",
+			"method", req.Method,
+			"url", req.URL.String(),
+			"headers", redactHeaders(req.Header),
+			"duration", duration,
+			"error", err)
+		return resp, err
+	}
+	logger.Debugw("HTTP request",
+		"method", req.Method,
+		"url", req.URL.String(),
+		"headers", redactHeaders(req.Header),
+		"status", resp.StatusCode,
+		"duration", duration)
+	return resp, nil
+}
+
+// redactHeaders returns a copy of the headers without the values of headers holding credentials.
+func redactHeaders(h http.Header) http.Header {
+	redacted := h.Clone()
+	for _, name := range []string{"Authorization", "Proxy-Authorization", "Cookie"} {
+		if redacted.Get(name) != "" {
+			redacted.Set(name, "REDACTED")
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,56 @@
+package util
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestDebugTransport(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	defer zap.ReplaceGlobals(zap.New(core))()
+
+	transport := &debugTransport{next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.Method == http.MethodDelete {
+			return nil, errors.New("connection reset")
+		}
+		return &http.Response{StatusCode: http.StatusNotFound}, nil
+	})}
+
+	req, err := http.NewRequest(http.MethodGet, "https://dev.azure.com/myorg/_apis/projects", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Basic c2VjcmV0")
+	req.Header.Set("Accept", "application/json")
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	req.Method = http.MethodDelete
+	_, err = transport.RoundTrip(req)
+	assert.EqualError(t, err, "connection reset")
+
+	entries := logs.All()
+	require.Len(t, entries, 2)
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "HTTP request", entries[0].Message)
+	assert.Equal(t, "https://dev.azure.com/myorg/_apis/projects", fields["url"])
+	assert.EqualValues(t, http.StatusNotFound, fields["status"])
+	assert.Equal(t, http.Header{
+		"Authorization": {"REDACTED"},
+		"Accept":        {"application/json"},
+	}, fields["headers"])
+	assert.Equal(t, "HTTP request failed", entries[1].Message)
+	assert.Equal(t, "Basic c2VjcmV0", req.Header.Get("Authorization"))
+}
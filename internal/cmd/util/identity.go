@@ -0,0 +1,66 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/location"
+	"github.com/samber/lo"
+)
+
+// GetAuthenticatedUser returns the identity of the user the connection is authenticated with.
+func GetAuthenticatedUser(ctx context.Context, conn *azuredevops.Connection) (*identity.Identity, error) {
+	client := location.NewClient(ctx, conn)
+	data, err := client.GetConnectionData(ctx, location.GetConnectionDataArgs{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get connection data: %w", err)
+	}
+	if data.AuthenticatedUser == nil || data.AuthenticatedUser.Id == nil {
+		return nil, fmt.Errorf("failed to determine authenticated user")
+	}
+	return data.AuthenticatedUser, nil
+}
+
+// subjectDescriptorPrefixes are the prefixes of the subject descriptors of users and groups, e.g.
+// aad.ZjM1Y2E4..., which can be given instead of an email address.
+var subjectDescriptorPrefixes = []string{"aad.", "msa.", "svc.", "aadgp.", "vssgp.", "win.", "bnd."}
+
+// IsSubjectDescriptor reports whether s is a subject descriptor rather than an email address or
+// account name.
+func IsSubjectDescriptor(s string) bool {
+	return !strings.Contains(s, "@") && lo.SomeBy(subjectDescriptorPrefixes, func(p string) bool {
+		return strings.HasPrefix(strings.ToLower(s), p)
+	})
+}
+
+// ResolveIdentity returns the single active identity of the member, which is given as email
+// address, account name or subject descriptor.
+func ResolveIdentity(ctx context.Context, client identity.Client, member string) (*identity.Identity, error) {
+	args := identity.ReadIdentitiesArgs{}
+	if IsSubjectDescriptor(member) {
+		args.SubjectDescriptors = &member
+	} else {
+		args.SearchFilter = lo.ToPtr("General")
+		args.FilterValue = &member
+	}
+	res, err := client.ReadIdentities(ctx, args)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s: %w", member, err)
+	}
+	// inactive identities are users which were removed from the organization
+	ids := lo.Filter(lo.FromPtr(res), func(id identity.Identity, _ int) bool { return id.IsActive == nil || *id.IsActive })
+	switch len(ids) {
+	case 0:
+		return nil, fmt.Errorf("no user or group found for %s", member)
+	case 1:
+		if ids[0].SubjectDescriptor == nil {
+			return nil, fmt.Errorf("%s has no subject descriptor", member)
+		}
+		return &ids[0], nil
+	default:
+		return nil, fmt.Errorf("%s matches %d users or groups; use the email address or descriptor instead", member, len(ids))
+	}
+}
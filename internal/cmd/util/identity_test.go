@@ -0,0 +1,26 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSubjectDescriptor(t *testing.T) {
+	tests := []struct {
+		member string
+		want   bool
+	}{
+		{"aad.ZjM1Y2E4ZDMtNjU4Ny03ZjM4LWI5MDMtNzQ2YTc1ZDg3NWFj", true},
+		{"vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5", true},
+		{"MSA.MDBkNjJkNzMtYjQyOS03ZmRiLWE4NDctYjVhNjJkNjY1ZjM1", true},
+		{"jdoe@example.com", false},
+		{"aad.jdoe@example.com", false},
+		{"CONTOSO\\jdoe", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.member, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsSubjectDescriptor(tt.member))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package util
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+// OutputFormats are the formats of commands which list resources. The table format is
+// column-formatted on a terminal and tab-separated otherwise; the tsv format is always
+// tab-separated.
+var OutputFormats = []string{"json", "table", "tsv"}
+
+// AddOutputFormatFlag adds the --format flag with the formats of commands which list resources.
+func AddOutputFormatFlag(cmd *cobra.Command, p *string) {
+	StringEnumFlag(cmd, p, "format", "", "table", OutputFormats, "Output format")
+}
+
+// NewPrinter returns the printer for the output format.
+func NewPrinter(ios *iostreams.IOStreams, format string) (printer.Printer, error) {
+	switch format {
+	case "table":
+		maxWidth := 80
+		isTTY := ios.IsStdoutTTY()
+		if isTTY {
+			maxWidth = ios.TerminalWidth()
+		}
+		return printer.NewTablePrinter(ios.Out, isTTY, maxWidth)
+	case "tsv":
+		return printer.NewTSVPrinter(ios.Out)
+	case "json":
+		return printer.NewJSONPrinter(ios.Out)
+	default:
+		return nil, printer.NewUnsupportedPrinterError(format)
+	}
+}
@@ -0,0 +1,29 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+func TestNewPrinter(t *testing.T) {
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	ios.SetColorEnabled(true)
+	cs := ios.ColorScheme()
+
+	p, err := NewPrinter(ios, "tsv")
+	require.NoError(t, err)
+	p.AddColumns("ID", "Name")
+	p.AddField("1")
+	p.AddField("main", printer.WithColor(cs.Green))
+	p.EndRow()
+	require.NoError(t, p.Render())
+	assert.Equal(t, "1\tmain\n", stdout.String())
+
+	_, err = NewPrinter(ios, "yaml")
+	assert.EqualError(t, err, "unsupported printer type yaml")
+}
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+	"github.com/tmeckel/azdo-cli/internal/text"
+)
+
+// ProgressWriter counts the bytes written to it and shows the count as label of the progress
+// indicator of the IOStreams.
+type ProgressWriter struct {
+	io      *iostreams.IOStreams
+	label   string
+	written int64
+}
+
+// NewProgressWriter returns a ProgressWriter which shows label followed by the number of bytes written.
+func NewProgressWriter(io *iostreams.IOStreams, label string) *ProgressWriter {
+	return &ProgressWriter{io: io, label: label}
+}
+
+func (w *ProgressWriter) Write(p []byte) (int, error) {
+	w.written += int64(len(p))
+	w.io.StartProgressIndicatorWithLabel(fmt.Sprintf("%s (%s)", w.label, text.FormatBytes(w.written)))
+	return len(p), nil
+}
+
+// Written returns the number of bytes written so far.
+func (w *ProgressWriter) Written() int64 {
+	return w.written
+}
+
+// Copy copies src to dst and reports the progress via the progress writer.
+func (w *ProgressWriter) Copy(dst io.Writer, src io.Reader) (int64, error) {
+	return io.Copy(io.MultiWriter(dst, w), src)
+}
@@ -0,0 +1,82 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseOrganizationArg returns the passed organization name or, if it is empty, the organization of the
+// Azure DevOps git remote of the current directory or the default organization configured for azdo.
+func ParseOrganizationArg(ctx CmdContext, organizationName string) (string, error) {
+	if organizationName != "" {
+		return organizationName, nil
+	}
+	if repoCtx, err := ctx.RepoContext(); err == nil {
+		if repo, err := repoCtx.Repo(); err == nil {
+			return repo.Organization(), nil
+		}
+	}
+	cfg, err := ctx.Config()
+	if err != nil {
+		return "", fmt.Errorf("error getting io configuration: %w", err)
+	}
+	organizationName, _ = cfg.Authentication().GetDefaultOrganization()
+	if organizationName == "" {
+		return "", FlagErrorf("no organization specified")
+	}
+	return organizationName, nil
+}
+
+// RepositoryFromRemote returns the name of the Azure DevOps repository of the git remotes of the
+// current directory. Commands use it when their optional repository argument is omitted.
+func RepositoryFromRemote(ctx CmdContext) (string, error) {
+	repoCtx, err := ctx.RepoContext()
+	if err != nil {
+		return "", err
+	}
+	repo, err := repoCtx.Repo()
+	if err != nil {
+		return "", FlagErrorf("no repository specified and %s", err)
+	}
+	return repo.Name(), nil
+}
+
+// ParseProjectScope parses an argument in the form [ORGANIZATION/]PROJECT. If the organization
+// is omitted, it is determined by ParseOrganizationArg.
+func ParseProjectScope(ctx CmdContext, scope string) (organizationName string, project string, err error) {
+	scope = strings.Trim(strings.TrimSpace(scope), "/")
+	if scope == "" {
+		return "", "", FlagErrorf("no project specified")
+	}
+	parts := strings.Split(scope, "/")
+	switch len(parts) {
+	case 1:
+		project = parts[0]
+	case 2:
+		organizationName = parts[0]
+		project = parts[1]
+	default:
+		return "", "", FlagErrorf("invalid project argument %q; expected [ORGANIZATION/]PROJECT", scope)
+	}
+	organizationName, err = ParseOrganizationArg(ctx, organizationName)
+	if err != nil {
+		return "", "", err
+	}
+	return organizationName, project, nil
+}
+
+// ParseRepositoryScope parses an argument in the form [ORGANIZATION/]PROJECT/REPOSITORY. If the organization
+// is omitted, it is determined by ParseOrganizationArg.
+func ParseRepositoryScope(ctx CmdContext, scope string) (organizationName string, project string, repository string, err error) {
+	scope = strings.Trim(strings.TrimSpace(scope), "/")
+	idx := strings.LastIndex(scope, "/")
+	if idx < 0 {
+		return "", "", "", FlagErrorf("invalid repository argument %q; expected [ORGANIZATION/]PROJECT/REPOSITORY", scope)
+	}
+	repository = scope[idx+1:]
+	organizationName, project, err = ParseProjectScope(ctx, scope[:idx])
+	if err != nil {
+		return "", "", "", err
+	}
+	return organizationName, project, repository, nil
+}
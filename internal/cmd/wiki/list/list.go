@@ -0,0 +1,146 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/printer"
+)
+
+type listOptions struct {
+	scope     string
+	listPages string
+	format    string
+}
+
+func NewCmdList(ctx util.CmdContext) *cobra.Command {
+	opts := &listOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [organization/]project",
+		Short: "List the wikis of a project",
+		Long: heredoc.Doc(`
+			List the wikis of a project.
+
+			With --list-pages the pages of the given wiki are listed as a tree instead.
+		`),
+		Example: heredoc.Doc(`
+			# list the wikis of a project
+			azdo wiki list myproject
+
+			# show the page tree of a wiki
+			azdo wiki list myorg/myproject --list-pages myproject.wiki
+		`),
+		Aliases: []string{"ls"},
+		Args:    util.ExactArgs(1, "cannot list wikis: project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.scope = args[0]
+
+			return runList(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.listPages, "list-pages", "", "List the pages of the wiki with this name or ID")
+	util.AddOutputFormatFlag(cmd, &opts.format)
+
+	return cmd
+}
+
+func runList(ctx util.CmdContext, opts *listOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := wiki.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	if opts.listPages != "" {
+		iostrms.StartProgressIndicator()
+		res, err := client.GetPage(rctx, wiki.GetPageArgs{
+			Project:        &project,
+			WikiIdentifier: &opts.listPages,
+			Path:           lo.ToPtr("/"),
+			RecursionLevel: &git.VersionControlRecursionTypeValues.Full,
+		})
+		iostrms.StopProgressIndicator()
+		if err != nil {
+			return fmt.Errorf("failed to get pages of wiki %s: %w", opts.listPages, err)
+		}
+		if opts.format == "json" {
+			return json.NewEncoder(iostrms.Out).Encode(res.Page)
+		}
+		printPageTree(iostrms.Out, res.Page)
+		return nil
+	}
+
+	iostrms.StartProgressIndicator()
+	res, err := client.GetAllWikis(rctx, wiki.GetAllWikisArgs{
+		Project: &project,
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get wikis of project %s: %w", project, err)
+	}
+	if len(lo.FromPtr(res)) == 0 {
+		return util.NewNoResultsError(fmt.Sprintf("No wikis found in project %s", project))
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(res)
+	}
+
+	tp, err := ctx.Printer(opts.format)
+	if err != nil {
+		return
+	}
+	tp.AddColumns("ID", "Name", "Type", "Project", "URL")
+	for _, w := range *res {
+		tp.AddField(lo.FromPtr(w.Id).String(), printer.WithTruncate(nil))
+		tp.AddField(lo.FromPtr(w.Name))
+		tp.AddField(string(lo.FromPtr(w.Type)))
+		tp.AddField(project)
+		tp.AddField(lo.FromPtr(w.RemoteUrl), printer.WithTruncate(nil))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
+
+// printPageTree prints the subpages of the page, each indented by its depth below the page.
+func printPageTree(out io.Writer, page *wiki.WikiPage) {
+	if page == nil {
+		return
+	}
+	var walk func(pages []wiki.WikiPage, depth int)
+	walk = func(pages []wiki.WikiPage, depth int) {
+		for _, p := range pages {
+			path := lo.FromPtr(p.Path)
+			name := path[strings.LastIndex(path, "/")+1:]
+			fmt.Fprintf(out, "%s%s\n", strings.Repeat("  ", depth), name)
+			walk(lo.FromPtr(p.SubPages), depth+1)
+		}
+	}
+	walk(lo.FromPtr(page.SubPages), 0)
+}
@@ -0,0 +1,41 @@
+package list
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintPageTree(t *testing.T) {
+	page := func(path string, subPages ...wiki.WikiPage) wiki.WikiPage {
+		p := wiki.WikiPage{Path: lo.ToPtr(path)}
+		if len(subPages) > 0 {
+			p.SubPages = &subPages
+		}
+		return p
+	}
+	root := page("/",
+		page("/Home"),
+		page("/Guides",
+			page("/Guides/Setup"),
+			page("/Guides/Release",
+				page("/Guides/Release/Checklist"),
+			),
+		),
+	)
+
+	var out bytes.Buffer
+	printPageTree(&out, &root)
+
+	assert.Equal(t, heredoc.Doc(`
+		Home
+		Guides
+		  Setup
+		  Release
+		    Checklist
+	`), out.String())
+}
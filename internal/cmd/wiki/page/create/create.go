@@ -0,0 +1,122 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki/shared"
+)
+
+type createOptions struct {
+	wiki    string
+	path    string
+	scope   string
+	content string
+	file    string
+	comment string
+	open    bool
+}
+
+func NewCmdCreate(ctx util.CmdContext) *cobra.Command {
+	opts := &createOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create <wiki> <path> [organization/]project",
+		Short: "Create a wiki page",
+		Long: heredoc.Doc(`
+			Create a wiki page with markdown content.
+
+			The content is given with --content or read from a file with --file. Use "-" to read the
+			content from standard input. Creating a page which already exists fails.
+		`),
+		Example: heredoc.Doc(`
+			# create a page from a markdown file
+			azdo wiki page create myproject.wiki /Guides/Setup myproject --file setup.md
+
+			# create a page and open it in the browser
+			azdo wiki page create myproject.wiki /Notes myorg/myproject --content "# Notes" --open
+		`),
+		Args: util.ExactArgs(3, "cannot create wiki page: wiki, path and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.wiki = args[0]
+			opts.path = args[1]
+			opts.scope = args[2]
+
+			return runCreate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.content, "content", "", "Markdown content of the page")
+	cmd.Flags().StringVarP(&opts.file, "file", "F", "", "Read the content of the page from file")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment of the change")
+	cmd.Flags().BoolVar(&opts.open, "open", false, "Open the page in the browser after it has been created")
+	cmd.MarkFlagsMutuallyExclusive("content", "file")
+
+	return cmd
+}
+
+func runCreate(ctx util.CmdContext, opts *createOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	content, err := shared.ReadContent(iostrms, opts.content, opts.file)
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := wiki.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	path := shared.NormalizePagePath(opts.path)
+	args := wiki.CreateOrUpdatePageArgs{
+		Parameters:     &wiki.WikiPageCreateOrUpdateParameters{Content: &content},
+		Project:        &project,
+		WikiIdentifier: &opts.wiki,
+		Path:           &path,
+	}
+	if opts.comment != "" {
+		args.Comment = &opts.comment
+	}
+
+	iostrms.StartProgressIndicator()
+	// Without a version the page is created; the request fails if the page already exists.
+	res, err := client.CreateOrUpdatePage(rctx, args)
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to create page %s in wiki %s: %w", path, opts.wiki, err)
+	}
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Created page %s in wiki %s (version %s)\n", cs.SuccessIcon(), cs.Bold(path), opts.wiki, shared.PageVersion(res))
+	} else {
+		fmt.Fprintln(iostrms.Out, shared.PageVersion(res))
+	}
+
+	if opts.open {
+		if res.Page == nil || lo.FromPtr(res.Page.RemoteUrl) == "" {
+			return fmt.Errorf("page %s was created, but its URL is unknown and cannot be opened", path)
+		}
+		return util.OpenInBrowser(ctx, *res.Page.RemoteUrl)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package page
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki/page/create"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki/page/show"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki/page/update"
+)
+
+func NewCmdPage(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "page <command>",
+		Short: "Work with the pages of a wiki",
+	}
+
+	cmd.AddCommand(create.NewCmdCreate(ctx))
+	cmd.AddCommand(show.NewCmdShow(ctx))
+	cmd.AddCommand(update.NewCmdUpdate(ctx))
+	return cmd
+}
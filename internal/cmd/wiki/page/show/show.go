@@ -0,0 +1,123 @@
+package show
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki/shared"
+)
+
+type showOptions struct {
+	wiki   string
+	path   string
+	scope  string
+	web    bool
+	format string
+}
+
+func NewCmdShow(ctx util.CmdContext) *cobra.Command {
+	opts := &showOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "show <wiki> <path> [organization/]project",
+		Short: "Show a wiki page",
+		Long: heredoc.Doc(`
+			Print the markdown content of a wiki page.
+
+			The wiki is specified by its name or ID, the page by its path like /Guides/Setup.
+		`),
+		Example: heredoc.Doc(`
+			# print the home page of the project wiki
+			azdo wiki page show myproject.wiki /Home myproject
+
+			# open a page in the browser
+			azdo wiki page show myproject.wiki /Guides/Setup myorg/myproject --web
+		`),
+		Aliases: []string{"view"},
+		Args:    util.ExactArgs(3, "cannot show wiki page: wiki, path and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.wiki = args[0]
+			opts.path = args[1]
+			opts.scope = args[2]
+
+			return runShow(ctx, opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open the page in the browser")
+	util.StringEnumFlag(cmd, &opts.format, "format", "", "", []string{"json"}, "Output format")
+	cmd.MarkFlagsMutuallyExclusive("web", "format")
+
+	return cmd
+}
+
+func runShow(ctx util.CmdContext, opts *showOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := wiki.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	path := shared.NormalizePagePath(opts.path)
+	iostrms.StartProgressIndicator()
+	res, err := client.GetPage(rctx, wiki.GetPageArgs{
+		Project:        &project,
+		WikiIdentifier: &opts.wiki,
+		Path:           &path,
+		RecursionLevel: &git.VersionControlRecursionTypeValues.OneLevel,
+		IncludeContent: lo.ToPtr(!opts.web),
+	})
+	iostrms.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("failed to get page %s of wiki %s: %w", path, opts.wiki, err)
+	}
+	page := res.Page
+	if page == nil {
+		return fmt.Errorf("page %s of wiki %s not found", path, opts.wiki)
+	}
+
+	if opts.web {
+		url := lo.FromPtr(page.RemoteUrl)
+		if url == "" {
+			return fmt.Errorf("page %s of wiki %s has no web URL", path, opts.wiki)
+		}
+		if iostrms.IsStdoutTTY() {
+			fmt.Fprintf(iostrms.ErrOut, "Opening %s in your browser.\n", url)
+		}
+		return util.OpenInBrowser(ctx, url)
+	}
+
+	if opts.format == "json" {
+		return json.NewEncoder(iostrms.Out).Encode(page)
+	}
+
+	content := lo.FromPtr(page.Content)
+	fmt.Fprint(iostrms.Out, content)
+	if content != "" && !strings.HasSuffix(content, "\n") {
+		fmt.Fprintln(iostrms.Out)
+	}
+	return nil
+}
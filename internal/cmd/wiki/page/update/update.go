@@ -0,0 +1,126 @@
+package update
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki/shared"
+)
+
+type updateOptions struct {
+	wiki    string
+	path    string
+	scope   string
+	content string
+	file    string
+	comment string
+}
+
+func NewCmdUpdate(ctx util.CmdContext) *cobra.Command {
+	opts := &updateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "update <wiki> <path> [organization/]project",
+		Short: "Update the content of a wiki page",
+		Long: heredoc.Doc(`
+			Replace the markdown content of an existing wiki page.
+
+			The content is given with --content or read from a file with --file. Use "-" to read the
+			content from standard input.
+		`),
+		Example: heredoc.Doc(`
+			# update a page from a markdown file
+			azdo wiki page update myproject.wiki /Guides/Setup myproject --file setup.md --comment "Add proxy settings"
+
+			# update a page with content generated by another command
+			generate-notes | azdo wiki page update myproject.wiki /Notes myorg/myproject --file -
+		`),
+		Args: util.ExactArgs(3, "cannot update wiki page: wiki, path and project required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.wiki = args[0]
+			opts.path = args[1]
+			opts.scope = args[2]
+
+			return runUpdate(ctx, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.content, "content", "", "Markdown content of the page")
+	cmd.Flags().StringVarP(&opts.file, "file", "F", "", "Read the content of the page from file")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment of the change")
+	cmd.MarkFlagsMutuallyExclusive("content", "file")
+
+	return cmd
+}
+
+func runUpdate(ctx util.CmdContext, opts *updateOptions) (err error) {
+	iostrms, err := ctx.IOStreams()
+	if err != nil {
+		return
+	}
+	content, err := shared.ReadContent(iostrms, opts.content, opts.file)
+	if err != nil {
+		return
+	}
+	organizationName, project, err := util.ParseProjectScope(ctx, opts.scope)
+	if err != nil {
+		return
+	}
+	conn, err := ctx.Connection(organizationName)
+	if err != nil {
+		return
+	}
+	rctx, err := ctx.Context()
+	if err != nil {
+		return
+	}
+
+	client, err := wiki.NewClient(rctx, conn)
+	if err != nil {
+		return
+	}
+
+	iostrms.StartProgressIndicator()
+	defer iostrms.StopProgressIndicator()
+
+	path := shared.NormalizePagePath(opts.path)
+	current, err := client.GetPage(rctx, wiki.GetPageArgs{
+		Project:        &project,
+		WikiIdentifier: &opts.wiki,
+		Path:           &path,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get page %s of wiki %s: %w", path, opts.wiki, err)
+	}
+	version := shared.PageVersion(current)
+	if version == "" {
+		return fmt.Errorf("failed to get the version of page %s of wiki %s", path, opts.wiki)
+	}
+
+	args := wiki.CreateOrUpdatePageArgs{
+		Parameters:     &wiki.WikiPageCreateOrUpdateParameters{Content: &content},
+		Project:        &project,
+		WikiIdentifier: &opts.wiki,
+		Path:           &path,
+		Version:        &version,
+	}
+	if opts.comment != "" {
+		args.Comment = &opts.comment
+	}
+	res, err := client.CreateOrUpdatePage(rctx, args)
+	if err != nil {
+		return fmt.Errorf("failed to update page %s of wiki %s: %w", path, opts.wiki, err)
+	}
+	iostrms.StopProgressIndicator()
+
+	if iostrms.IsStdoutTTY() {
+		cs := iostrms.ColorScheme()
+		fmt.Fprintf(iostrms.Out, "%s Updated page %s of wiki %s (version %s)\n", cs.SuccessIcon(), cs.Bold(path), opts.wiki, shared.PageVersion(res))
+	} else {
+		fmt.Fprintln(iostrms.Out, shared.PageVersion(res))
+	}
+	return nil
+}
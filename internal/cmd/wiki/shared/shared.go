@@ -0,0 +1,41 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/samber/lo"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/iostreams"
+)
+
+// NormalizePagePath returns the path of a wiki page with a leading slash.
+func NormalizePagePath(path string) string {
+	return "/" + strings.TrimPrefix(path, "/")
+}
+
+// ReadContent returns the content of a page given either as text or, with file, read from a file.
+// The file "-" is read from standard input.
+func ReadContent(iostrms *iostreams.IOStreams, content, file string) (string, error) {
+	if file == "" {
+		if content == "" {
+			return "", util.FlagErrorf("one of --content or --file is required")
+		}
+		return content, nil
+	}
+	b, err := iostrms.ReadUserFile(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", file, err)
+	}
+	return string(b), nil
+}
+
+// PageVersion returns the version of a page, which is the ETag of the response without quotes.
+func PageVersion(res *wiki.WikiPageResponse) string {
+	etags := lo.FromPtr(res.ETag)
+	if len(etags) == 0 {
+		return ""
+	}
+	return strings.Trim(etags[0], `"`)
+}
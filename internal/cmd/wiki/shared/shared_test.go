@@ -0,0 +1,18 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v7/wiki"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePagePath(t *testing.T) {
+	assert.Equal(t, "/Guides/Setup", NormalizePagePath("Guides/Setup"))
+	assert.Equal(t, "/Guides/Setup", NormalizePagePath("/Guides/Setup"))
+}
+
+func TestPageVersion(t *testing.T) {
+	assert.Equal(t, "7a8b9c", PageVersion(&wiki.WikiPageResponse{ETag: &[]string{`"7a8b9c"`}}))
+	assert.Equal(t, "", PageVersion(&wiki.WikiPageResponse{}))
+}
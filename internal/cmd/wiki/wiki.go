@@ -0,0 +1,26 @@
+package wiki
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	"github.com/spf13/cobra"
+	"github.com/tmeckel/azdo-cli/internal/cmd/util"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki/list"
+	"github.com/tmeckel/azdo-cli/internal/cmd/wiki/page"
+)
+
+func NewCmdWiki(ctx util.CmdContext) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wiki <command>",
+		Short: "Work with wikis",
+		Long:  `Work with Azure DevOps project and code wikis.`,
+		Example: heredoc.Doc(`
+			$ azdo wiki list myorg/myproject
+			$ azdo wiki page show myproject.wiki /Home myproject
+		`),
+		GroupID: "core",
+	}
+
+	cmd.AddCommand(list.NewCmdList(ctx))
+	cmd.AddCommand(page.NewCmdPage(ctx))
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"time"
 	"unsafe"
 
 	"github.com/emirpasic/gods/sets/hashset"
@@ -26,10 +27,23 @@ type AuthConfig interface {
 	SetDefaultOrganization(organizationName string) error
 	GetOrganizations() []string
 	GetToken(organizationName string) (string, error)
+	GetTokenInfo(organizationName string) (TokenInfo, error)
+	GetTokenName(organizationName string) string
+	SetTokenInfo(organizationName string, info TokenInfo)
 	Login(organizationName, organizationURL, token, gitProtocol string, secureStorage bool) error
 	Logout(organizationName string) error
 }
 
+// TokenInfo describes the personal access token of an organization as given at login. Azure DevOps
+// does not allow to look up the name or expiry of a PAT with the PAT itself.
+type TokenInfo struct {
+	Name    string
+	Expires *time.Time
+}
+
+// tokenExpiresLayout is the layout of the expiry date of a token in the config file
+const tokenExpiresLayout = "2006-01-02"
+
 // https://stackoverflow.com/a/53286786/874043
 var nativeEndian unicode.Endianness
 
@@ -184,6 +198,49 @@ func (c *authConfig) GetOrganizations() []string {
 	return items
 }
 
+// GetTokenInfo returns the name and expiry of the token of the organization. If the token is taken
+// from the environment, the stored information does not apply and an empty TokenInfo is returned.
+func (c *authConfig) GetTokenInfo(organizationName string) (info TokenInfo, err error) {
+	if _, ok := os.LookupEnv(azdoToken); ok {
+		return
+	}
+	organizationName = strings.ToLower(organizationName)
+
+	info.Name = c.GetTokenName(organizationName)
+	if expires, err := c.cfg.Get([]string{Organizations, organizationName, "token_expires"}); err == nil && expires != "" {
+		t, err := time.ParseInLocation(tokenExpiresLayout, expires, time.Local)
+		if err != nil {
+			return info, fmt.Errorf("invalid token expiry %q of organization %s: %w", expires, organizationName, err)
+		}
+		info.Expires = &t
+	}
+	return
+}
+
+// GetTokenName returns the name of the token stored for the organization. Unlike GetTokenInfo, it
+// also returns the name if a token is given in the environment.
+func (c *authConfig) GetTokenName(organizationName string) string {
+	name, _ := c.cfg.Get([]string{Organizations, strings.ToLower(organizationName), "token_name"})
+	return name
+}
+
+// SetTokenInfo sets the name and expiry of the token of the organization. Empty values are removed.
+// The config file is written by Login, so SetTokenInfo must be called before Login.
+func (c *authConfig) SetTokenInfo(organizationName string, info TokenInfo) {
+	organizationName = strings.ToLower(organizationName)
+
+	if info.Name != "" {
+		c.cfg.Set([]string{Organizations, organizationName, "token_name"}, info.Name)
+	} else {
+		_ = c.cfg.Remove([]string{Organizations, organizationName, "token_name"})
+	}
+	if info.Expires != nil {
+		c.cfg.Set([]string{Organizations, organizationName, "token_expires"}, info.Expires.Format(tokenExpiresLayout))
+	} else {
+		_ = c.cfg.Remove([]string{Organizations, organizationName, "token_expires"})
+	}
+}
+
 // Login will set user, git protocol, and auth token for the given organizationName.
 // If the encrypt option is specified it will first try to store the auth token
 // in encrypted storage and will fall back to the plain text config file.
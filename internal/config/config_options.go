@@ -50,6 +50,12 @@ var configOptions = []Option{
 		Description:  "the default Azure DevOps organization to use, if no organization is specified",
 		DefaultValue: "",
 	},
+	{
+		Key:           "pr.merge.strategy",
+		Description:   "the default strategy to merge pull requests with",
+		DefaultValue:  "noFastForward",
+		AllowedValues: []string{"noFastForward", "squash", "rebase", "rebaseMerge"},
+	},
 }
 
 func Options() []Option {
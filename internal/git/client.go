@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -132,6 +133,24 @@ func (c *Client) Remotes(ctx context.Context) (RemoteSet, error) {
 	return remotes, nil
 }
 
+// GetRemoteURL returns the fetch URL of the remote, or its push URL if pushURL is true.
+func (c *Client) GetRemoteURL(ctx context.Context, name string, pushURL bool) (string, error) {
+	args := []string{"remote", "get-url"}
+	if pushURL {
+		args = append(args, "--push")
+	}
+	args = append(args, name)
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return "", err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return firstLine(out), nil
+}
+
 func (c *Client) UpdateRemoteURL(ctx context.Context, name, url string) error {
 	args := []string{"remote", "set-url", name, url}
 	cmd, err := c.Command(ctx, args...)
@@ -311,6 +330,71 @@ func (c *Client) CommitBody(ctx context.Context, sha string) (string, error) {
 	return string(output), err
 }
 
+// MergeBase returns the SHA of the best common ancestor of the two refs.
+func (c *Client) MergeBase(ctx context.Context, ref1, ref2 string) (string, error) {
+	args := []string{"merge-base", ref1, ref2}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return "", err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return firstLine(out), nil
+}
+
+// CountCommitsBetween returns the number of commits reachable from head but not from base.
+func (c *Client) CountCommitsBetween(ctx context.Context, base, head string) (int, error) {
+	args := []string{"rev-list", "--count", fmt.Sprintf("%s..%s", base, head)}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return 0, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, err
+	}
+	count, err := strconv.Atoi(strings.TrimSpace(firstLine(out)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse commit count %q: %w", firstLine(out), err)
+	}
+	return count, nil
+}
+
+// IsAncestor reports whether the ancestor ref is an ancestor of the descendant ref. As for git, a
+// commit is considered an ancestor of itself.
+func (c *Client) IsAncestor(ctx context.Context, ancestor, descendant string) (bool, error) {
+	args := []string{"merge-base", "--is-ancestor", ancestor, descendant}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return false, err
+	}
+	err = cmd.Run()
+	if err != nil {
+		var gitErr *Error
+		if ok := errors.As(err, &gitErr); ok && gitErr.ExitCode == 1 {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// ResolveCommit returns the full SHA of the commit the ref points to.
+func (c *Client) ResolveCommit(ctx context.Context, ref string) (string, error) {
+	args := []string{"rev-parse", "--verify", ref + "^{commit}"}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return "", err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return "", err
+	}
+	return firstLine(out), nil
+}
+
 func (c *Client) lookupCommit(ctx context.Context, sha, format string) ([]byte, error) {
 	args := []string{"-c", "log.ShowSignature=false", "show", "-s", "--pretty=format:" + format, sha}
 	cmd, err := c.Command(ctx, args...)
@@ -400,11 +484,54 @@ func (c *Client) CheckoutNewBranch(ctx context.Context, remoteName, branch strin
 	return nil
 }
 
+// CreateBranch creates a local branch at the start point without checking it out. If startPoint is
+// empty, the branch is created at HEAD.
+func (c *Client) CreateBranch(ctx context.Context, name, startPoint string) error {
+	args := []string{"branch", name}
+	if startPoint != "" {
+		args = append(args, startPoint)
+	}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *Client) HasLocalBranch(ctx context.Context, branch string) bool {
 	_, err := c.revParse(ctx, "--verify", "refs/heads/"+branch)
 	return err == nil
 }
 
+// ListBranches returns the short names of the local branches matching the pattern. If pattern is
+// empty, all local branches are returned.
+func (c *Client) ListBranches(ctx context.Context, pattern string) ([]string, error) {
+	args := []string{"branch", "--list"}
+	if pattern != "" {
+		args = append(args, pattern)
+	}
+	args = append(args, "--format=%(refname:short)")
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	var branches []string
+	for _, line := range outputLines(out) {
+		if line != "" {
+			branches = append(branches, line)
+		}
+	}
+	return branches, nil
+}
+
 func (c *Client) TrackingBranchNames(ctx context.Context, prefix string) []string {
 	args := []string{"branch", "-r", "--format", "%(refname:strip=3)"}
 	if prefix != "" {
@@ -225,6 +225,57 @@ func TestParseRemotes(t *testing.T) {
 	assert.Equal(t, "/koke/grit.git", r[4].PushURL.Path)
 }
 
+func TestClientGetRemoteURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		pushURL       bool
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+		wantURL       string
+	}{
+		{
+			name:        "fetch URL",
+			cmdStdout:   "https://dev.azure.com/org/project/_git/repo\n",
+			wantCmdArgs: `path/to/git remote get-url origin`,
+			wantURL:     "https://dev.azure.com/org/project/_git/repo",
+		},
+		{
+			name:        "push URL",
+			pushURL:     true,
+			cmdStdout:   "git@ssh.dev.azure.com:v3/org/project/repo\n",
+			wantCmdArgs: `path/to/git remote get-url --push origin`,
+			wantURL:     "git@ssh.dev.azure.com:v3/org/project/repo",
+		},
+		{
+			name:          "unknown remote",
+			cmdExitStatus: 2,
+			cmdStderr:     "error: No such remote 'origin'",
+			wantCmdArgs:   `path/to/git remote get-url origin`,
+			wantErrorMsg:  "failed to run git (exit code 2): error: No such remote 'origin'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			url, err := client.GetRemoteURL(context.Background(), "origin", tt.pushURL)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantURL, url)
+		})
+	}
+}
+
 func TestClientUpdateRemoteURL(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -243,7 +294,7 @@ func TestClientUpdateRemoteURL(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git remote set-url test https://test.com`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -282,7 +333,7 @@ func TestClientSetRemoteResolution(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git config --add remote.origin.azdo-resolved base`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -335,7 +386,7 @@ func TestClientCurrentBranch(t *testing.T) {
 			name:          "detached head",
 			cmdExitStatus: 1,
 			wantCmdArgs:   `path/to/git symbolic-ref --quiet HEAD`,
-			wantErrorMsg:  "failed to run git: not on any branch",
+			wantErrorMsg:  "failed to run git (exit code 1): not on any branch",
 		},
 	}
 	for _, tt := range tests {
@@ -377,7 +428,7 @@ func TestClientShowRefs(t *testing.T) {
 				Hash: "9ea76237a557015e73446d33268569a114c0649c",
 				Name: "refs/heads/valid",
 			}},
-			wantErrorMsg: "failed to run git: fatal: 'refs/heads/invalid' - not a valid ref",
+			wantErrorMsg: "failed to run git (exit code 128): fatal: 'refs/heads/invalid' - not a valid ref",
 		},
 	}
 	for _, tt := range tests {
@@ -416,14 +467,14 @@ func TestClientConfig(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git config credential.helper`,
-			wantErrorMsg:  "failed to run git: unknown config key credential.helper",
+			wantErrorMsg:  "failed to run git (exit code 1): unknown config key credential.helper",
 		},
 		{
 			name:          "git error",
 			cmdExitStatus: 2,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git config credential.helper`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 2): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -516,7 +567,7 @@ func TestClientCommits(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git -c log.ShowSignature=false log --pretty=format:%H,%s --cherry SHA1...SHA2`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -557,6 +608,108 @@ func TestClientCommitBody(t *testing.T) {
 	assert.Equal(t, "I'm starting to get the hang of things\n", body)
 }
 
+func TestClientMergeBase(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+		wantSha       string
+	}{
+		{
+			name:        "merge base",
+			cmdStdout:   "6a6872b918c601a0e730710ad8473938a7516d30\n",
+			wantCmdArgs: `path/to/git merge-base feature main`,
+			wantSha:     "6a6872b918c601a0e730710ad8473938a7516d30",
+		},
+		{
+			name:          "no common ancestor",
+			cmdExitStatus: 1,
+			wantCmdArgs:   `path/to/git merge-base feature main`,
+			wantErrorMsg:  "failed to run git (exit code 1): exit status 1",
+		},
+		{
+			name:          "unknown ref",
+			cmdExitStatus: 128,
+			cmdStderr:     "fatal: Not a valid object name feature",
+			wantCmdArgs:   `path/to/git merge-base feature main`,
+			wantErrorMsg:  "failed to run git (exit code 128): fatal: Not a valid object name feature",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			sha, err := client.MergeBase(context.Background(), "feature", "main")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantSha, sha)
+		})
+	}
+}
+
+func TestClientResolveCommit(t *testing.T) {
+	tests := []struct {
+		name          string
+		ref           string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+		wantSha       string
+	}{
+		{
+			name:        "branch",
+			ref:         "main",
+			cmdStdout:   "6a6872b918c601a0e730710ad8473938a7516d30\n",
+			wantCmdArgs: `path/to/git rev-parse --verify main^{commit}`,
+			wantSha:     "6a6872b918c601a0e730710ad8473938a7516d30",
+		},
+		{
+			name:        "HEAD",
+			ref:         "HEAD",
+			cmdStdout:   "08f4b7b6513dffc6245857e497cfd6101dc47818\n",
+			wantCmdArgs: `path/to/git rev-parse --verify HEAD^{commit}`,
+			wantSha:     "08f4b7b6513dffc6245857e497cfd6101dc47818",
+		},
+		{
+			name:          "nonexistent ref",
+			ref:           "nope",
+			cmdExitStatus: 128,
+			cmdStderr:     "fatal: Needed a single revision",
+			wantCmdArgs:   `path/to/git rev-parse --verify nope^{commit}`,
+			wantErrorMsg:  "failed to run git (exit code 128): fatal: Needed a single revision",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			sha, err := client.ResolveCommit(context.Background(), tt.ref)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantSha, sha)
+		})
+	}
+}
+
 func TestClientReadBranchConfig(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -605,7 +758,7 @@ func TestClientDeleteLocalBranch(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git branch -D trunk`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -679,7 +832,7 @@ func TestClientCheckoutBranch(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git checkout trunk`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -718,7 +871,7 @@ func TestClientCheckoutNewBranch(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git checkout -b trunk --track origin/trunk`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -739,6 +892,52 @@ func TestClientCheckoutNewBranch(t *testing.T) {
 	}
 }
 
+func TestClientCreateBranch(t *testing.T) {
+	tests := []struct {
+		name          string
+		startPoint    string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "create branch at start point",
+			startPoint:  "origin/trunk",
+			wantCmdArgs: `path/to/git branch feature origin/trunk`,
+		},
+		{
+			name:        "create branch at HEAD",
+			wantCmdArgs: `path/to/git branch feature`,
+		},
+		{
+			name:          "branch exists",
+			startPoint:    "origin/trunk",
+			cmdExitStatus: 128,
+			cmdStderr:     "fatal: a branch named 'feature' already exists",
+			wantCmdArgs:   `path/to/git branch feature origin/trunk`,
+			wantErrorMsg:  "failed to run git (exit code 128): fatal: a branch named 'feature' already exists",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.CreateBranch(context.Background(), "feature", tt.startPoint)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
 func TestClientToplevelDir(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -760,7 +959,7 @@ func TestClientToplevelDir(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git rev-parse --show-toplevel`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -803,7 +1002,7 @@ func TestClientGitDir(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git rev-parse --git-dir`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -881,7 +1080,7 @@ func TestClientUnsetRemoteResolution(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git config --unset remote.origin.azdo-resolved`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -920,7 +1119,7 @@ func TestClientSetRemoteBranches(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git remote set-branches origin trunk`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -965,7 +1164,7 @@ func TestClientFetch(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git -c credential.useHttpPath=true -c credential.helper=!"azdo" auth git-credential fetch origin trunk`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -1010,7 +1209,7 @@ func TestClientPull(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git -c credential.useHttpPath=true -c credential.helper=!"azdo" auth git-credential pull --ff-only origin trunk`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -1055,7 +1254,7 @@ func TestClientPush(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git -c credential.useHttpPath=true -c credential.helper=!"azdo" auth git-credential push --set-upstream origin trunk`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -1103,7 +1302,7 @@ func TestClientClone(t *testing.T) {
 			cmdExitStatus: 1,
 			cmdStderr:     "git error message",
 			wantCmdArgs:   `path/to/git -c credential.useHttpPath=true -c credential.helper=!"azdo" auth git-credential clone github.com/cli/cli`,
-			wantErrorMsg:  "failed to run git: git error message",
+			wantErrorMsg:  "failed to run git (exit code 1): git error message",
 		},
 	}
 	for _, tt := range tests {
@@ -1275,3 +1474,146 @@ func createCommandContext(_ *testing.T, exitStatus int, stdout, stderr string) (
 		return cmd
 	}
 }
+
+func TestClientListBranches(t *testing.T) {
+	tests := []struct {
+		name          string
+		pattern       string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+		wantBranches  []string
+	}{
+		{
+			name:        "no branches",
+			pattern:     "feature/*",
+			wantCmdArgs: `path/to/git branch --list feature/* --format=%(refname:short)`,
+		},
+		{
+			name:         "pattern matches",
+			pattern:      "feature/*",
+			cmdStdout:    "feature/login\n",
+			wantCmdArgs:  `path/to/git branch --list feature/* --format=%(refname:short)`,
+			wantBranches: []string{"feature/login"},
+		},
+		{
+			name:         "multiple branches",
+			cmdStdout:    "feature/login\nmain\ntopic\n",
+			wantCmdArgs:  `path/to/git branch --list --format=%(refname:short)`,
+			wantBranches: []string{"feature/login", "main", "topic"},
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 128,
+			cmdStderr:     "fatal: not a git repository",
+			wantCmdArgs:   `path/to/git branch --list --format=%(refname:short)`,
+			wantErrorMsg:  "failed to run git (exit code 128): fatal: not a git repository",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			branches, err := client.ListBranches(context.Background(), tt.pattern)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantBranches, branches)
+		})
+	}
+}
+
+func TestClientIsAncestor(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantErrorMsg  string
+		wantAncestor  bool
+	}{
+		{
+			name:         "is ancestor",
+			wantAncestor: true,
+		},
+		{
+			name:          "is not ancestor",
+			cmdExitStatus: 1,
+		},
+		{
+			name:          "unknown ref",
+			cmdExitStatus: 128,
+			cmdStderr:     "fatal: Not a valid object name main",
+			wantErrorMsg:  "failed to run git (exit code 128): fatal: Not a valid object name main",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			isAncestor, err := client.IsAncestor(context.Background(), "main", "feature")
+			assert.Equal(t, `path/to/git merge-base --is-ancestor main feature`, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantAncestor, isAncestor)
+		})
+	}
+}
+
+func TestClientCountCommitsBetween(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantErrorMsg  string
+		wantCount     int
+	}{
+		{
+			name:      "no commits",
+			cmdStdout: "0\n",
+		},
+		{
+			name:      "commits",
+			cmdStdout: "3\n",
+			wantCount: 3,
+		},
+		{
+			name:          "unknown ref",
+			cmdExitStatus: 128,
+			cmdStderr:     "fatal: ambiguous argument 'main..feature'",
+			wantErrorMsg:  "failed to run git (exit code 128): fatal: ambiguous argument 'main..feature'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			count, err := client.CountCommitsBetween(context.Background(), "main", "feature")
+			assert.Equal(t, `path/to/git rev-list --count main..feature`, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantCount, count)
+		})
+	}
+}
@@ -76,6 +76,10 @@ type IOStreams struct {
 	pagerCommand string
 	pagerProcess *os.Process
 
+	outputFilter   func(io.Reader, io.Writer) error
+	filteredOut    fileWriter
+	filteredOutBuf *bytes.Buffer
+
 	neverPrompt bool
 
 	TempFileOverride *os.File
@@ -247,6 +251,41 @@ func (s *IOStreams) StopPager() {
 	s.pagerProcess = nil
 }
 
+// StartOutputFilter buffers everything written to Out until StopOutputFilter is called.
+func (s *IOStreams) StartOutputFilter(filter func(io.Reader, io.Writer) error) {
+	s.outputFilter = filter
+	s.filteredOut = s.Out
+	s.filteredOutBuf = &bytes.Buffer{}
+	s.Out = &fdWriter{
+		fd:     s.Out.Fd(),
+		Writer: s.filteredOutBuf,
+	}
+}
+
+// StopOutputFilter restores Out and writes the output buffered since StartOutputFilter to it,
+// transformed by the filter.
+func (s *IOStreams) StopOutputFilter() error {
+	if s.outputFilter == nil {
+		return nil
+	}
+	filter, buf := s.outputFilter, s.filteredOutBuf
+	s.Out = s.filteredOut
+	s.outputFilter, s.filteredOut, s.filteredOutBuf = nil, nil, nil
+	return filter(buf, s.Out)
+}
+
+// CancelOutputFilter restores Out and writes the output buffered since StartOutputFilter to it
+// unchanged, so the output of a failed command is not lost.
+func (s *IOStreams) CancelOutputFilter() {
+	if s.outputFilter == nil {
+		return
+	}
+	buf := s.filteredOutBuf
+	s.Out = s.filteredOut
+	s.outputFilter, s.filteredOut, s.filteredOutBuf = nil, nil, nil
+	_, _ = buf.WriteTo(s.Out)
+}
+
 func (s *IOStreams) CanPrompt() bool {
 	if s.neverPrompt {
 		return false
@@ -268,7 +307,7 @@ func (s *IOStreams) StartProgressIndicator() {
 }
 
 func (s *IOStreams) StartProgressIndicatorWithLabel(label string) {
-	if !s.progressIndicatorEnabled {
+	if !s.progressIndicatorEnabled || !s.IsStdoutTTY() {
 		return
 	}
 
@@ -305,6 +344,13 @@ func (s *IOStreams) StopProgressIndicator() {
 	s.progressIndicator = nil
 }
 
+// DisableProgress stops a running progress indicator and turns the progress indicator
+// into a no-op for the remaining lifetime of the IOStreams.
+func (s *IOStreams) DisableProgress() {
+	s.StopProgressIndicator()
+	s.progressIndicatorEnabled = false
+}
+
 func (s *IOStreams) RunWithProgress(label string, run func() error) error {
 	s.StartProgressIndicatorWithLabel(label)
 	defer s.StopProgressIndicator()
@@ -2,7 +2,9 @@ package iostreams
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 )
@@ -24,6 +26,55 @@ func TestStopAlternateScreenBuffer(t *testing.T) {
 	}
 }
 
+func TestDisableProgress(t *testing.T) {
+	ios, _, _, stderr := Test()
+	ios.SetStdoutTTY(true)
+	ios.progressIndicatorEnabled = true
+
+	ios.DisableProgress()
+	ios.StartProgressIndicatorWithLabel("working")
+
+	if ios.progressIndicator != nil {
+		t.Error("expected progress indicator not to be started after IOStreams.DisableProgress()")
+	}
+	if got := stderr.String(); got != "" {
+		t.Errorf("expected no progress output, got %q", got)
+	}
+}
+
+func TestOutputFilter(t *testing.T) {
+	upper := func(r io.Reader, w io.Writer) error {
+		b, err := io.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(bytes.ToUpper(b))
+		return err
+	}
+
+	ios, _, stdout, _ := Test()
+	ios.StartOutputFilter(upper)
+	fmt.Fprint(ios.Out, "filtered")
+	if err := ios.StopOutputFilter(); err != nil {
+		t.Fatal(err)
+	}
+	if got := stdout.String(); got != "FILTERED" {
+		t.Errorf("after IOStreams.StopOutputFilter() got %q, want %q", got, "FILTERED")
+	}
+
+	ios, _, stdout, _ = Test()
+	ios.StartOutputFilter(upper)
+	fmt.Fprint(ios.Out, "partial")
+	ios.CancelOutputFilter()
+	// Stopping after a cancel should no-op.
+	if err := ios.StopOutputFilter(); err != nil {
+		t.Fatal(err)
+	}
+	if got := stdout.String(); got != "partial" {
+		t.Errorf("after IOStreams.CancelOutputFilter() got %q, want %q", got, "partial")
+	}
+}
+
 func TestIOStreams_pager(t *testing.T) {
 	t.Skip("TODO: fix this test in race detection mode")
 	ios, _, stdout, _ := Test()
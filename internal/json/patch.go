@@ -0,0 +1,24 @@
+package json
+
+// MergePatch applies the JSON merge patch to the target as described in RFC 7386 and returns the
+// result. Both are values as decoded by encoding/json. Members of the patch with a null value are
+// removed from the target; objects are merged recursively and all other values replace the value
+// of the target. The target may be modified.
+func MergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = map[string]any{}
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = MergePatch(t[k], v)
+	}
+	return t
+}
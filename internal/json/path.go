@@ -0,0 +1,148 @@
+// Package json selects parts of JSON documents with simple paths.
+//
+// A path consists of keys separated by dots and array indexes in brackets, e.g.
+// "value[0].name". The index "[*]" selects all elements of an array. Keys may contain dots,
+// like the reference names of work item fields in "fields.System.Title"; the longest key of an
+// object which matches the following path elements is used.
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// segment is an element of a path. It is either a key of an object or an index of an array.
+type segment struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// Path is a parsed path which selects a part of a JSON document.
+type Path struct {
+	expr     string
+	segments []segment
+}
+
+// ParsePath parses a path expression.
+func ParsePath(expr string) (*Path, error) {
+	p := &Path{expr: expr}
+	rest := strings.TrimPrefix(expr, ".")
+	for rest != "" {
+		switch {
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %q: missing ]", expr)
+			}
+			idx := rest[1:end]
+			if idx == "*" {
+				p.segments = append(p.segments, segment{isIndex: true, wildcard: true})
+			} else {
+				i, err := strconv.Atoi(idx)
+				if err != nil {
+					return nil, fmt.Errorf("invalid path %q: invalid index %q", expr, idx)
+				}
+				p.segments = append(p.segments, segment{isIndex: true, index: i})
+			}
+			rest = rest[end+1:]
+		case rest[0] == '.':
+			rest = rest[1:]
+			if rest == "" || rest[0] == '.' || rest[0] == '[' {
+				return nil, fmt.Errorf("invalid path %q: empty key", expr)
+			}
+		default:
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			p.segments = append(p.segments, segment{key: rest[:end]})
+			rest = rest[end:]
+		}
+	}
+	return p, nil
+}
+
+func (p *Path) String() string {
+	return p.expr
+}
+
+// Query returns the part of v selected by the path. v is a value as decoded by encoding/json. The
+// result is nil if the path does not exist in v.
+func (p *Path) Query(v any) any {
+	return query(v, p.segments)
+}
+
+func query(v any, segments []segment) any {
+	if len(segments) == 0 {
+		return v
+	}
+	s := segments[0]
+	if s.isIndex {
+		a, ok := v.([]any)
+		if !ok {
+			return nil
+		}
+		if s.wildcard {
+			result := make([]any, 0, len(a))
+			for _, e := range a {
+				result = append(result, query(e, segments[1:]))
+			}
+			return result
+		}
+		i := s.index
+		if i < 0 {
+			i += len(a)
+		}
+		if i < 0 || i >= len(a) {
+			return nil
+		}
+		return query(a[i], segments[1:])
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	// use the longest key made of the following keys of the path, so that keys may contain dots
+	n := 1
+	for n < len(segments) && !segments[n].isIndex {
+		n++
+	}
+	for ; n > 0; n-- {
+		keys := make([]string, n)
+		for i := range keys {
+			keys[i] = segments[i].key
+		}
+		if e, ok := m[strings.Join(keys, ".")]; ok {
+			return query(e, segments[n:])
+		}
+	}
+	return nil
+}
+
+// Filter reads the JSON values from r and writes the part of each value selected by the path to w.
+func (p *Path) Filter(r io.Reader, w io.Writer) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for {
+		var v any
+		err := dec.Decode(&v)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to parse JSON output: %w", err)
+		}
+		if err := enc.Encode(p.Query(v)); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package json
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const document = `{
+	"id": 42,
+	"fields": {
+		"System.Title": "Login fails",
+		"System.Tags": "ui"
+	},
+	"relations": [
+		{"rel": "parent", "url": "https://dev.azure.com/_apis/wit/workItems/7"},
+		{"rel": "child", "url": "https://dev.azure.com/_apis/wit/workItems/8"}
+	]
+}`
+
+func TestPathFilter(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "id", want: "42"},
+		{path: "fields.System.Title", want: `"Login fails"`},
+		{path: ".fields", want: `{"System.Tags":"ui","System.Title":"Login fails"}`},
+		{path: "relations[1].rel", want: `"child"`},
+		{path: "relations[-1].rel", want: `"child"`},
+		{path: "relations[*].rel", want: `["parent","child"]`},
+		{path: "relations[5].rel", want: "null"},
+		{path: "missing", want: "null"},
+		{path: "id.value", want: "null"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			p, err := ParsePath(tt.path)
+			require.NoError(t, err)
+
+			var out bytes.Buffer
+			require.NoError(t, p.Filter(strings.NewReader(document), &out))
+			assert.Equal(t, tt.want+"\n", out.String())
+		})
+	}
+}
+
+func TestPathFilterArray(t *testing.T) {
+	p, err := ParsePath("[*].name")
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, p.Filter(strings.NewReader(`[{"name":"a"},{"name":"b"}]`), &out))
+	assert.Equal(t, "[\"a\",\"b\"]\n", out.String())
+}
+
+func TestParsePathErrors(t *testing.T) {
+	for _, expr := range []string{"value[0", "value[x]", "fields..title", "value.[0]"} {
+		_, err := ParsePath(expr)
+		assert.Error(t, err, expr)
+	}
+}
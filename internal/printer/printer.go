@@ -39,7 +39,8 @@ func WithTruncate(fn func(int, string) string) FieldOption {
 }
 
 // WithColor sets the color function for the field. The function should transform a string value by wrapping
-// it in ANSI escape codes. The color function will not be used if the table was initialized in non-terminal mode.
+// it in ANSI escape codes. In non-terminal mode the color function is still applied, so it must honor the
+// color setting of the output, as the functions of the IOStreams color scheme do.
 func WithColor(fn func(string) string) FieldOption {
 	return func(f *tableField) {
 		f.colorFunc = fn
@@ -206,9 +206,20 @@ func (t *ttyTablePrinter) calculateColumnWidths(delimSize int) []int {
 	return colWidths
 }
 
+// NewTSVPrinter initializes a table printer which writes tab-separated values without color, even
+// when writing to a terminal.
+func NewTSVPrinter(w io.Writer) (tp TablePrinter, err error) {
+	tp = &tsvTablePrinter{
+		out:     w,
+		noColor: true,
+	}
+	return
+}
+
 type tsvTablePrinter struct {
 	out        io.Writer
 	currentCol int
+	noColor    bool
 }
 
 var _ TablePrinter = &tsvTablePrinter{}
@@ -222,6 +233,16 @@ func (t *tsvTablePrinter) AddField(text string, opts ...FieldOption) {
 	if t.currentCol > 0 {
 		fmt.Fprint(t.out, "\t")
 	}
+	field := tableField{
+		text: text,
+	}
+	for _, opt := range opts {
+		opt(&field)
+	}
+	// color functions of the color scheme are no-ops unless color output has been forced
+	if field.colorFunc != nil && !t.noColor {
+		text = field.colorFunc(text)
+	}
 	fmt.Fprint(t.out, text)
 	t.currentCol++
 }
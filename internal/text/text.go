@@ -107,3 +107,17 @@ func RelativeTimeAgo(a, b time.Time) string {
 
 	return fmtDuration(int(ago.Hours()/24/365), "year")
 }
+
+// FormatBytes returns a human readable representation of a number of bytes using binary prefixes.
+func FormatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}